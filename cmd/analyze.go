@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+type AnalyzeRunner struct {
+	// outputFormat is the format of the report, either text or json. Value
+	// assigned via --output/-o flag
+	outputFormat string
+
+	// The path to the input yaml config file. Value assigned via --input-file
+	// flag
+	inputFile string
+
+	// The path to the overrides file. Value assigned via --overrides-file flag
+	overridesFile string
+
+	// The namespace analyzed. Value assigned via --namespace/-n flag
+	namespace string
+
+	// allNamespaces indicates whether all namespaces are analyzed. Value
+	// assigned via --all-namespaces/-A flag
+	allNamespaces bool
+
+	// providers indicates which providers are used to execute convert action.
+	providers []string
+
+	// Provider specific flags --<provider>-<flag>.
+	providerSpecificFlags map[string]*string
+
+	// The path to the file configuring the providers. Value assigned via
+	// --config flag
+	configFile string
+}
+
+// analysis is the report of the analyze command.
+type analysis struct {
+	// Sources counts the source objects per provider, kind and disposition.
+	Sources []sourceCount `json:"sources"`
+	// Coverage lists the annotations of the providers used by the source
+	// objects, for the providers reporting them.
+	Coverage []i2gw.AnnotationUsage `json:"coverage"`
+	// Blockers are the distinct messages of the migration blockers.
+	Blockers []string `json:"blockers"`
+	// Notifications counts the notifications per type.
+	Notifications map[notifications.MessageType]int `json:"notifications"`
+	// Output estimates the resources the conversion would generate.
+	Output outputEstimate `json:"output"`
+}
+
+// sourceCount is the number of source objects of a kind with a disposition.
+type sourceCount struct {
+	Provider    string           `json:"provider"`
+	Kind        string           `json:"kind"`
+	Disposition i2gw.Disposition `json:"disposition"`
+	Objects     int              `json:"objects"`
+}
+
+// outputEstimate is the number of generated resources per kind, and the size
+// of their YAML output.
+type outputEstimate struct {
+	Resources map[string]int `json:"resources"`
+	Bytes     int            `json:"bytes"`
+}
+
+// Analyze converts the resources in memory and prints the analysis of the
+// conversion instead of the generated resources.
+func (ar *AnalyzeRunner) Analyze(cmd *cobra.Command, _ []string) error {
+	pr := &PrintRunner{namespace: ar.namespace, allNamespaces: ar.allNamespaces, inputFile: ar.inputFile}
+	if err := pr.initializeNamespaceFilter(); err != nil {
+		return fmt.Errorf("failed to initialize namespace filter: %w", err)
+	}
+	if err := applyProviderConfigFile(cmd, ar.providers, ar.configFile); err != nil {
+		return err
+	}
+
+	gatewayResources, _, err := i2gw.ToGatewayAPIResources(cmd.Context(), i2gw.ConversionOptions{
+		Namespace:             pr.namespaceFilter,
+		InputFile:             ar.inputFile,
+		OverridesFile:         ar.overridesFile,
+		Providers:             ar.providers,
+		ProviderSpecificFlags: providerSpecificFlagValues(ar.providers, ar.providerSpecificFlags),
+	})
+	if err != nil {
+		return err
+	}
+	return ar.printAnalysis(analyze(gatewayResources), os.Stdout)
+}
+
+// analyze returns the analysis of the generated resources and of the
+// notifications of the conversion.
+func analyze(gatewayResources []i2gw.GatewayResources) analysis {
+	a := analysis{
+		Sources:       []sourceCount{},
+		Coverage:      []i2gw.AnnotationUsage{},
+		Blockers:      append([]string{}, blockerMessages()...),
+		Notifications: countNotifications(),
+		Output:        outputEstimate{Resources: map[string]int{}},
+	}
+
+	counts := map[sourceCount]int{}
+	for _, r := range gatewayResources {
+		for _, entry := range r.Inventory {
+			counts[sourceCount{Provider: entry.Provider, Kind: entry.Kind, Disposition: entry.Disposition}]++
+		}
+		a.Coverage = append(a.Coverage, r.AnnotationUsage...)
+	}
+	for count, objects := range counts {
+		count.Objects = objects
+		a.Sources = append(a.Sources, count)
+	}
+	slices.SortFunc(a.Sources, func(x, y sourceCount) int {
+		return cmp.Or(cmp.Compare(x.Provider, y.Provider), cmp.Compare(x.Kind, y.Kind), cmp.Compare(x.Disposition, y.Disposition))
+	})
+	slices.SortStableFunc(a.Coverage, func(x, y i2gw.AnnotationUsage) int {
+		return cmp.Compare(x.Provider, y.Provider)
+	})
+
+	for _, r := range gatewayResources {
+		for kind, n := range map[string]int{
+			"GatewayClass":     len(r.GatewayClasses),
+			"Gateway":          len(r.Gateways),
+			"HTTPRoute":        len(r.HTTPRoutes),
+			"GRPCRoute":        len(r.GRPCRoutes),
+			"TLSRoute":         len(r.TLSRoutes),
+			"TCPRoute":         len(r.TCPRoutes),
+			"UDPRoute":         len(r.UDPRoutes),
+			"BackendTLSPolicy": len(r.BackendTLSPolicies),
+			"ReferenceGrant":   len(r.ReferenceGrants),
+		} {
+			if n > 0 {
+				a.Output.Resources[kind] += n
+			}
+		}
+		for _, extension := range r.GatewayExtensions {
+			a.Output.Resources[extension.GetKind()]++
+		}
+	}
+	var output bytes.Buffer
+	(&PrintRunner{resourcePrinter: &printers.YAMLPrinter{}}).outputResult(gatewayResources, &output)
+	a.Output.Bytes = output.Len()
+	return a
+}
+
+// printAnalysis prints the analysis in the output format.
+func (ar *AnalyzeRunner) printAnalysis(a analysis, w io.Writer) error {
+	if ar.outputFormat == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(a)
+	}
+	_, err := io.WriteString(w, a.String())
+	return err
+}
+
+// String returns the text report of the analysis.
+func (a analysis) String() string {
+	var b strings.Builder
+	b.WriteString("Source objects:\n")
+	if len(a.Sources) == 0 {
+		b.WriteString("  not reported by the providers\n")
+	}
+	for _, count := range a.Sources {
+		fmt.Fprintf(&b, "  %s %s %s: %d\n", count.Provider, count.Kind, count.Disposition, count.Objects)
+	}
+
+	b.WriteString("\nFeature coverage:\n")
+	if len(a.Coverage) == 0 {
+		b.WriteString("  not reported by the providers\n")
+	}
+	for _, usage := range a.Coverage {
+		fmt.Fprintf(&b, "  %s %s (%s): %d\n", usage.Provider, usage.Annotation, usage.Support, usage.Objects)
+	}
+
+	fmt.Fprintf(&b, "\nMigration blockers: %d\n", len(a.Blockers))
+	for _, blocker := range a.Blockers {
+		fmt.Fprintf(&b, "  - %s\n", blocker)
+	}
+
+	fmt.Fprintf(&b, "\nNotifications: %d blockers, %d errors, %d warnings, %d infos\n",
+		a.Notifications[notifications.BlockerNotification], a.Notifications[notifications.ErrorNotification],
+		a.Notifications[notifications.WarningNotification], a.Notifications[notifications.InfoNotification])
+
+	kinds := make([]string, 0, len(a.Output.Resources))
+	total := 0
+	for kind, n := range a.Output.Resources {
+		kinds = append(kinds, kind)
+		total += n
+	}
+	slices.Sort(kinds)
+	fmt.Fprintf(&b, "\nEstimated output: %d resources, %d bytes of YAML\n", total, a.Output.Bytes)
+	for _, kind := range kinds {
+		fmt.Fprintf(&b, "  %s: %d\n", kind, a.Output.Resources[kind])
+	}
+	return b.String()
+}
+
+func newAnalyzeCommand() *cobra.Command {
+	ar := &AnalyzeRunner{}
+
+	// analyzeCmd represents the analyze command. It reports what the
+	// conversion would do without printing the generated resources.
+	var cmd = &cobra.Command{
+		Use:   "analyze",
+		Short: "Reports the source objects, feature coverage, migration blockers and estimated output of the conversion, without generating any resource.",
+		RunE:  ar.Analyze,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if ar.outputFormat != "text" && ar.outputFormat != "json" {
+				return fmt.Errorf("%s is not a supported output format, use text or json", ar.outputFormat)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&ar.outputFormat, "output", "o", "text",
+		`Output format of the report, either text or json.`)
+
+	cmd.Flags().StringVar(&ar.inputFile, "input-file", "",
+		`Path to the manifest file. When set, the tool will read ingresses from the file instead of reading from the cluster. Supported files are yaml and json.`)
+
+	cmd.Flags().StringVar(&ar.overridesFile, "overrides-file", "",
+		`Path to a YAML file declaring per-source-resource overrides (gateway name, route name, extra hostnames, listener port) applied to the converted resources.`)
+
+	cmd.Flags().StringVarP(&ar.namespace, "namespace", "n", "",
+		`If present, the namespace scope for this CLI request.`)
+
+	cmd.Flags().BoolVarP(&ar.allNamespaces, "all-namespaces", "A", false,
+		`If present, analyze the resources across all namespaces. Namespace in current context is ignored even if specified with --namespace.`)
+
+	cmd.Flags().StringSliceVar(&ar.providers, "providers", []string{},
+		fmt.Sprintf("The providers whose resources are analyzed, supported values are %v.", i2gw.GetSupportedProviders()))
+
+	cmd.Flags().StringVar(&ar.configFile, "config", "",
+		`Path to a YAML file with a section per provider setting its provider-specific flags. Flags set on the command line take precedence.`)
+
+	ar.providerSpecificFlags = registerProviderSpecificFlags(cmd)
+
+	_ = cmd.MarkFlagRequired("providers")
+	return cmd
+}
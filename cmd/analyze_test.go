@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_analyze(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{
+		"nginx": {
+			{Type: notifications.BlockerNotification, Message: "snippets are not supported"},
+			{Type: notifications.BlockerNotification, Message: "snippets are not supported"},
+			{Type: notifications.WarningNotification, Message: "warning"},
+		},
+	}
+	defer func() {
+		notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	}()
+
+	key := types.NamespacedName{Namespace: "default", Name: "cafe"}
+	gatewayResources := []i2gw.GatewayResources{{
+		Gateways:   map[types.NamespacedName]gatewayv1.Gateway{key: {TypeMeta: metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "Gateway"}, ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"}}},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{key: {TypeMeta: metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"}, ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"}}},
+		Inventory: []i2gw.InventoryEntry{
+			{Provider: "nginx", Kind: "Ingress", Namespace: "default", Name: "cafe", Disposition: i2gw.DispositionConverted},
+			{Provider: "nginx", Kind: "Ingress", Namespace: "default", Name: "tea", Disposition: i2gw.DispositionBlocked},
+			{Provider: "nginx", Kind: "Ingress", Namespace: "default", Name: "coffee", Disposition: i2gw.DispositionConverted},
+		},
+		AnnotationUsage: []i2gw.AnnotationUsage{
+			{Provider: "nginx", Annotation: "nginx.org/server-snippets", Support: "unsupported", Objects: 1},
+		},
+	}}
+
+	a := analyze(gatewayResources)
+	if a.Output.Bytes == 0 {
+		t.Errorf("expected the output size to be estimated")
+	}
+	a.Output.Bytes = 0
+	want := analysis{
+		Sources: []sourceCount{
+			{Provider: "nginx", Kind: "Ingress", Disposition: i2gw.DispositionBlocked, Objects: 1},
+			{Provider: "nginx", Kind: "Ingress", Disposition: i2gw.DispositionConverted, Objects: 2},
+		},
+		Coverage: []i2gw.AnnotationUsage{
+			{Provider: "nginx", Annotation: "nginx.org/server-snippets", Support: "unsupported", Objects: 1},
+		},
+		Blockers:      []string{"snippets are not supported"},
+		Notifications: map[notifications.MessageType]int{notifications.BlockerNotification: 2, notifications.WarningNotification: 1},
+		Output:        outputEstimate{Resources: map[string]int{"Gateway": 1, "HTTPRoute": 1}},
+	}
+	if diff := cmp.Diff(want, a); diff != "" {
+		t.Errorf("unexpected analysis (-want +got):\n%s", diff)
+	}
+
+	report := a.String()
+	for _, line := range []string{
+		"  nginx Ingress converted: 2\n",
+		"  nginx nginx.org/server-snippets (unsupported): 1\n",
+		"Migration blockers: 1\n  - snippets are not supported\n",
+		"Notifications: 2 blockers, 0 errors, 1 warnings, 0 infos\n",
+		"Estimated output: 2 resources, 0 bytes of YAML\n  Gateway: 1\n  HTTPRoute: 1\n",
+	} {
+		if !strings.Contains(report, line) {
+			t.Errorf("expected the report to contain %q, got:\n%s", line, report)
+		}
+	}
+}
+
+func Test_analyzeEmpty(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	var out bytes.Buffer
+	ar := &AnalyzeRunner{outputFormat: "json"}
+	if err := ar.printAnalysis(analyze(nil), &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var report map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		t.Fatalf("invalid JSON report: %v", err)
+	}
+	for _, field := range []string{"sources", "coverage", "blockers"} {
+		if list, ok := report[field].([]interface{}); !ok || len(list) != 0 {
+			t.Errorf("expected %s to be an empty list, got %v", field, report[field])
+		}
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// appliedByLabel marks the objects created by print --apply. They do not get the
+// managed-by label of the sync command, so that neither command deletes the
+// objects written by the other.
+const (
+	appliedByLabel = "ingress2gateway.kubernetes.io/applied-by"
+	appliedByValue = "print"
+)
+
+// appliedKinds are the kinds created by print --apply, in dependency order:
+// GatewayClasses, Gateways, ReferenceGrants, routes, then policies. They are
+// deleted in the reverse order.
+var appliedKinds = []schema.GroupVersionKind{
+	gatewayClassKind,
+	gatewayKind,
+	referenceGrantKind,
+	httpRouteKind,
+	grpcRouteKind,
+	tlsRouteKind,
+	tcpRouteKind,
+	udpRouteKind,
+	backendTLSPolicyKind,
+}
+
+var gatewayClassKind = gatewayv1.SchemeGroupVersion.WithKind("GatewayClass")
+
+// appliedObjects returns the generated resources of the applied kinds in
+// dependency order, labeled as created by print --apply.
+func appliedObjects(gatewayResources []i2gw.GatewayResources) ([]unstructured.Unstructured, error) {
+	var generated []client.Object
+	for _, r := range gatewayResources {
+		generated = append(generated, sortedObjects(r.GatewayClasses)...)
+	}
+	for _, r := range gatewayResources {
+		generated = append(generated, sortedObjects(r.Gateways)...)
+	}
+	for _, r := range gatewayResources {
+		generated = append(generated, sortedObjects(r.ReferenceGrants)...)
+	}
+	for _, r := range gatewayResources {
+		generated = append(generated, sortedObjects(r.HTTPRoutes)...)
+		generated = append(generated, sortedObjects(r.GRPCRoutes)...)
+		generated = append(generated, sortedObjects(r.TLSRoutes)...)
+		generated = append(generated, sortedObjects(r.TCPRoutes)...)
+		generated = append(generated, sortedObjects(r.UDPRoutes)...)
+	}
+	for _, r := range gatewayResources {
+		generated = append(generated, sortedObjects(r.BackendTLSPolicies)...)
+	}
+
+	objects := make([]unstructured.Unstructured, 0, len(generated))
+	for _, obj := range generated {
+		u, err := i2gw.CastToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+		if _, ok := obj.(*gatewayv1.GatewayClass); ok {
+			u.SetGroupVersionKind(gatewayClassKind)
+		} else {
+			u.SetGroupVersionKind(syncedKind(obj))
+		}
+
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
+		u.SetAnnotations(annotations)
+
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[appliedByLabel] = appliedByValue
+		u.SetLabels(labels)
+
+		unstructured.RemoveNestedField(u.Object, "status")
+		unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+		objects = append(objects, *u)
+	}
+	return objects, nil
+}
+
+// appliedChange is an object written by print --apply, with its state before
+// the run when it was updated, nil when it was created.
+type appliedChange struct {
+	object   unstructured.Unstructured
+	previous *unstructured.Unstructured
+}
+
+// applyObjects creates the objects in order, and updates the ones created by a
+// previous run. Existing objects not created by print --apply are left alone.
+// When an object cannot be written, the changes of this run are reverted in the
+// reverse order: the created objects are deleted, and the updated ones restored.
+func applyObjects(ctx context.Context, cl client.Client, objects []unstructured.Unstructured, out io.Writer) error {
+	var changes []appliedChange
+	for _, obj := range objects {
+		obj := obj
+		change, err := applyObject(ctx, cl, &obj, out)
+		if err == nil {
+			if change != nil {
+				changes = append(changes, *change)
+			}
+			continue
+		}
+
+		fmt.Fprintf(out, "Rolling back the %d objects written by this run\n", len(changes))
+		slices.Reverse(changes)
+		for _, c := range changes {
+			revertChange(ctx, cl, c, out)
+		}
+		return err
+	}
+	return nil
+}
+
+// applyObject creates or updates a single object, and returns the change, nil
+// when the object was skipped.
+func applyObject(ctx context.Context, cl client.Client, obj *unstructured.Unstructured, out io.Writer) (*appliedChange, error) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err := cl.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		if err = cl.Create(ctx, obj); err != nil {
+			return nil, fmt.Errorf("failed to create %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+		}
+		fmt.Fprintf(out, "Created %s %s\n", obj.GetKind(), client.ObjectKeyFromObject(obj))
+		return &appliedChange{object: *obj}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+	case existing.GetLabels()[appliedByLabel] != appliedByValue:
+		fmt.Fprintf(out, "Skipping %s %s, it exists and was not created by ingress2gateway\n", obj.GetKind(), client.ObjectKeyFromObject(obj))
+		return nil, nil
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	if err = cl.Update(ctx, obj); err != nil {
+		return nil, fmt.Errorf("failed to update %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+	}
+	fmt.Fprintf(out, "Updated %s %s\n", obj.GetKind(), client.ObjectKeyFromObject(obj))
+	return &appliedChange{object: *obj, previous: existing}, nil
+}
+
+// revertChange deletes the object created by the run, or restores the state of
+// the object updated by the run. Failures are reported, so that the other
+// changes are still reverted.
+func revertChange(ctx context.Context, cl client.Client, change appliedChange, out io.Writer) {
+	obj := change.object
+	key := client.ObjectKeyFromObject(&obj)
+	if change.previous == nil {
+		if err := cl.Delete(ctx, &obj); err != nil && !apierrors.IsNotFound(err) {
+			fmt.Fprintf(out, "Failed to delete %s %s: %v\n", obj.GetKind(), key, err)
+			return
+		}
+		fmt.Fprintf(out, "Deleted %s %s\n", obj.GetKind(), key)
+		return
+	}
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := cl.Get(ctx, key, current); err != nil {
+		fmt.Fprintf(out, "Failed to restore %s %s: %v\n", obj.GetKind(), key, err)
+		return
+	}
+	previous := change.previous.DeepCopy()
+	previous.SetResourceVersion(current.GetResourceVersion())
+	previous.SetManagedFields(nil)
+	if err := cl.Update(ctx, previous); err != nil {
+		fmt.Fprintf(out, "Failed to restore %s %s: %v\n", obj.GetKind(), key, err)
+		return
+	}
+	fmt.Fprintf(out, "Restored %s %s\n", obj.GetKind(), key)
+}
+
+// reportSkippedExtensions warns about the provider-specific extensions and TLS
+// placeholders of the generated resources, which print --apply does not write.
+func reportSkippedExtensions(gatewayResources []i2gw.GatewayResources, out io.Writer) {
+	for _, r := range gatewayResources {
+		for _, extension := range r.GatewayExtensions {
+			fmt.Fprintf(out, "Skipping %s %s, provider-specific extensions are not applied\n", extension.GetKind(), client.ObjectKeyFromObject(&extension))
+		}
+	}
+}
+
+// rollbackObjects deletes the objects created by print --apply, routes and
+// policies first, in the namespace or in all namespaces when empty. The
+// GatewayClasses are cluster-scoped and may be used by the Gateways of other
+// namespaces, so they are only deleted with all the namespaces.
+func rollbackObjects(ctx context.Context, cl client.Client, namespace string, out io.Writer) error {
+	kinds := slices.Clone(appliedKinds)
+	slices.Reverse(kinds)
+	for _, gvk := range kinds {
+		if gvk.Kind == "GatewayClass" && namespace != "" {
+			fmt.Fprintf(out, "Keeping the GatewayClasses, they are only deleted when rolling back all namespaces\n")
+			continue
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		err := cl.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{appliedByLabel: appliedByValue})
+		if meta.IsNoMatchError(err) {
+			// The CRD of the kind is not installed, so nothing was created.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list %s objects: %w", gvk.Kind, err)
+		}
+		for _, obj := range list.Items {
+			obj := obj
+			if err = cl.Delete(ctx, &obj); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(&obj), err)
+			}
+			fmt.Fprintf(out, "Deleted %s %s\n", gvk.Kind, client.ObjectKeyFromObject(&obj))
+		}
+	}
+	return nil
+}
+
+// newClusterClient returns a client of the cluster of the kubeconfig.
+func newClusterClient() (client.Client, error) {
+	conf, err := config.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client config: %w", err)
+	}
+	cl, err := client.New(conf, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return cl, nil
+}
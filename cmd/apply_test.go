@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_applyObjects(t *testing.T) {
+	gatewayResources := []i2gw.GatewayResources{{
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "default", Name: "web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}},
+		},
+		ReferenceGrants: map[types.NamespacedName]gatewayv1beta1.ReferenceGrant{
+			{Namespace: "backend", Name: "web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "backend", Name: "web"}},
+		},
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			{Namespace: "default", Name: "nginx"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec:       gatewayv1.GatewaySpec{GatewayClassName: "nginx"},
+			},
+			{Namespace: "default", Name: "user"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "user"}},
+		},
+		BackendTLSPolicies: map[types.NamespacedName]gatewayv1alpha3.BackendTLSPolicy{
+			{Namespace: "default", Name: "web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}},
+		},
+	}}
+
+	objects, err := appliedObjects(gatewayResources)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, obj := range objects {
+		if _, ok := obj.GetLabels()[syncManagedByLabel]; ok {
+			t.Errorf("Expected %s %s not to be labeled for the sync command", obj.GetKind(), client.ObjectKeyFromObject(&obj))
+		}
+	}
+
+	ctx := context.Background()
+	cl := fake.NewClientBuilder().WithScheme(applyTestScheme(t)).WithObjects(
+		// Created by a user, must not be modified nor deleted.
+		&gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "user"}},
+		// Written by the sync command, must not be deleted by a rollback.
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "dry-run", Name: "web", Labels: map[string]string{syncManagedByLabel: syncManagedByValue}}},
+	).Build()
+
+	var out bytes.Buffer
+	if err = applyObjects(ctx, cl, objects, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := `Created Gateway default/nginx
+Skipping Gateway default/user, it exists and was not created by ingress2gateway
+Created ReferenceGrant backend/web
+Created HTTPRoute default/web
+Created BackendTLSPolicy default/web
+`
+	if out.String() != want {
+		t.Errorf("Unexpected apply output:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err = applyObjects(ctx, cl, objects, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Updated HTTPRoute default/web") {
+		t.Errorf("Expected the second run to update the HTTPRoute, got:\n%s", out.String())
+	}
+
+	out.Reset()
+	if err = rollbackObjects(ctx, cl, "", &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want = `Deleted BackendTLSPolicy default/web
+Deleted HTTPRoute default/web
+Deleted ReferenceGrant backend/web
+Deleted Gateway default/nginx
+`
+	if out.String() != want {
+		t.Errorf("Unexpected rollback output:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "default", Name: "user"}, &gatewayv1.Gateway{}); err != nil {
+		t.Errorf("Expected the user Gateway to be kept: %v", err)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "web"}, &gatewayv1.HTTPRoute{}); err != nil {
+		t.Errorf("Expected the synced HTTPRoute to be kept: %v", err)
+	}
+}
+
+func Test_rollbackObjectsInNamespace(t *testing.T) {
+	gatewayResources := []i2gw.GatewayResources{{
+		GatewayClasses: map[types.NamespacedName]gatewayv1.GatewayClass{
+			{Name: "nginx"}: {ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+		},
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			{Namespace: "team-a", Name: "nginx"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "nginx"}},
+			{Namespace: "team-b", Name: "nginx"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "nginx"}},
+		},
+	}}
+	objects, err := appliedObjects(gatewayResources)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	cl := fake.NewClientBuilder().WithScheme(applyTestScheme(t)).Build()
+	var out bytes.Buffer
+	if err = applyObjects(ctx, cl, objects, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out.Reset()
+	if err = rollbackObjects(ctx, cl, "team-a", &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := `Deleted Gateway team-a/nginx
+Keeping the GatewayClasses, they are only deleted when rolling back all namespaces
+`
+	if out.String() != want {
+		t.Errorf("Unexpected rollback output:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Name: "nginx"}, &gatewayv1.GatewayClass{}); err != nil {
+		t.Errorf("Expected the GatewayClass to be kept: %v", err)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "team-b", Name: "nginx"}, &gatewayv1.Gateway{}); err != nil {
+		t.Errorf("Expected the Gateway of the other namespace to be kept: %v", err)
+	}
+}
+
+func Test_applyObjectsRollbackUpdatedOnFailure(t *testing.T) {
+	gatewayResources := []i2gw.GatewayResources{{
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			{Namespace: "default", Name: "nginx"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"}},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "default", Name: "web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}},
+		},
+	}}
+	objects, err := appliedObjects(gatewayResources)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The Gateway was created by a previous run, and is updated by this one.
+	previous := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx", Labels: map[string]string{appliedByLabel: appliedByValue}},
+		Spec:       gatewayv1.GatewaySpec{GatewayClassName: "previous"},
+	}
+	cl := fake.NewClientBuilder().WithScheme(applyTestScheme(t)).WithObjects(previous).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if obj.GetObjectKind().GroupVersionKind().Kind == "HTTPRoute" {
+				return fmt.Errorf("admission webhook denied the request")
+			}
+			return cl.Create(ctx, obj, opts...)
+		},
+	}).Build()
+
+	ctx := context.Background()
+	var out bytes.Buffer
+	if err = applyObjects(ctx, cl, objects, &out); err == nil {
+		t.Fatalf("Expected the HTTPRoute creation to fail")
+	}
+	gateway := &gatewayv1.Gateway{}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "default", Name: "nginx"}, gateway); err != nil {
+		t.Fatalf("Expected the Gateway updated by the failed run to be restored, got %v", err)
+	}
+	if gateway.Spec.GatewayClassName != "previous" {
+		t.Errorf("Expected the Gateway to be restored with GatewayClass previous, got %q", gateway.Spec.GatewayClassName)
+	}
+	if !strings.Contains(out.String(), "Restored Gateway default/nginx") {
+		t.Errorf("Expected the Gateway restoration to be reported, got %q", out.String())
+	}
+}
+
+func Test_applyObjectsRollbackCreatedOnFailure(t *testing.T) {
+	gatewayResources := []i2gw.GatewayResources{{
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			{Namespace: "default", Name: "nginx"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"}},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "default", Name: "web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}},
+		},
+	}}
+	objects, err := appliedObjects(gatewayResources)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(applyTestScheme(t)).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if obj.GetObjectKind().GroupVersionKind().Kind == "HTTPRoute" {
+				return fmt.Errorf("admission webhook denied the request")
+			}
+			return cl.Create(ctx, obj, opts...)
+		},
+	}).Build()
+
+	ctx := context.Background()
+	var out bytes.Buffer
+	if err = applyObjects(ctx, cl, objects, &out); err == nil {
+		t.Fatalf("Expected the HTTPRoute creation to fail")
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "default", Name: "nginx"}, &gatewayv1.Gateway{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the Gateway created by the failed run to be deleted, got %v", err)
+	}
+}
+
+func Test_reportSkippedExtensions(t *testing.T) {
+	secret := unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetNamespace("default")
+	secret.SetName("foo-tls")
+
+	var out bytes.Buffer
+	reportSkippedExtensions([]i2gw.GatewayResources{{GatewayExtensions: []unstructured.Unstructured{secret}}}, &out)
+	if expected := "Skipping Secret default/foo-tls, provider-specific extensions are not applied\n"; out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
+
+func applyTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{gatewayv1.Install, gatewayv1alpha2.Install, gatewayv1alpha3.Install, gatewayv1beta1.Install} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatalf("Failed to build scheme: %v", err)
+		}
+	}
+	return scheme
+}
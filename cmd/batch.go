@@ -0,0 +1,251 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type BatchRunner struct {
+	// outputFormat is the format of the files written per namespace. Value
+	// assigned via --output/-o flag
+	outputFormat string
+
+	// The path to the input yaml config file. Value assigned via --input-file
+	// flag
+	inputFile string
+
+	// The path to the overrides file. Value assigned via --overrides-file flag
+	overridesFile string
+
+	// The namespaces converted, every namespace of the cluster or of the input
+	// file when empty. Value assigned via --namespaces flag
+	namespaces []string
+
+	// The directory the file of each namespace is written to. Value assigned
+	// via --output-dir flag
+	outputDir string
+
+	// providers indicates which providers are used to execute convert action.
+	providers []string
+
+	// Provider specific flags --<provider>-<flag>.
+	providerSpecificFlags map[string]*string
+
+	// The path to the file configuring the providers. Value assigned via
+	// --config flag
+	configFile string
+}
+
+// namespaceConverter converts the resources of a namespace, returning the
+// generated resources and the notification tables of the conversion.
+type namespaceConverter func(ctx context.Context, namespace string) ([]i2gw.GatewayResources, map[string]string, error)
+
+// ConvertNamespaces converts the namespaces one after the other, writing the
+// generated resources and the notifications of each to their own files.
+func (br *BatchRunner) ConvertNamespaces(cmd *cobra.Command, _ []string) error {
+	pr := &PrintRunner{outputFormat: br.outputFormat}
+	if err := pr.initializeResourcePrinter(); err != nil {
+		return err
+	}
+	if err := applyProviderConfigFile(cmd, br.providers, br.configFile); err != nil {
+		return err
+	}
+
+	namespaces := br.namespaces
+	if len(namespaces) == 0 {
+		var err error
+		if namespaces, err = br.listNamespaces(cmd.Context()); err != nil {
+			return err
+		}
+	}
+	return br.convertNamespaces(cmd.Context(), namespaces, pr, br.convertNamespace, cmd.OutOrStdout())
+}
+
+// listNamespaces returns the namespaces of the objects of the input file, or the
+// namespaces of the cluster.
+func (br *BatchRunner) listNamespaces(ctx context.Context) ([]string, error) {
+	namespaces := sets.New[string]()
+	if br.inputFile != "" {
+		objects, err := common.ExtractObjectsFromPath(br.inputFile, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objects {
+			if obj.GetNamespace() != "" {
+				namespaces.Insert(obj.GetNamespace())
+			}
+		}
+		return sets.List(namespaces), nil
+	}
+
+	cl, err := newClusterClient()
+	if err != nil {
+		return nil, err
+	}
+	var namespaceList corev1.NamespaceList
+	if err = cl.List(ctx, &namespaceList); err != nil {
+		return nil, fmt.Errorf("failed to list the namespaces: %w", err)
+	}
+	for _, namespace := range namespaceList.Items {
+		namespaces.Insert(namespace.Name)
+	}
+	return sets.List(namespaces), nil
+}
+
+// convertNamespace converts the resources of the namespace with the flags of
+// the batch command.
+func (br *BatchRunner) convertNamespace(ctx context.Context, namespace string) ([]i2gw.GatewayResources, map[string]string, error) {
+	notifications.NotificationAggr.Reset()
+	return i2gw.ToGatewayAPIResources(ctx, i2gw.ConversionOptions{
+		Namespace:             namespace,
+		InputFile:             br.inputFile,
+		OverridesFile:         br.overridesFile,
+		Providers:             br.providers,
+		ProviderSpecificFlags: providerSpecificFlagValues(br.providers, br.providerSpecificFlags),
+	})
+}
+
+// convertNamespaces converts every namespace, writing its generated resources to
+// <namespace>.<format> and its notification tables to <namespace>.notifications.txt
+// in the output directory. A namespace failing to convert, or to be written, is
+// reported and does not stop the others, the command failing once all of them
+// were converted.
+func (br *BatchRunner) convertNamespaces(ctx context.Context, namespaces []string, pr *PrintRunner, convert namespaceConverter, out io.Writer) error {
+	if err := os.MkdirAll(br.outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", br.outputDir, err)
+	}
+
+	var failed []string
+	for _, namespace := range namespaces {
+		report := contextReport{context: namespace}
+		gatewayResources, notificationTables, err := convertRecovering(ctx, convert, namespace)
+		if err == nil {
+			countResources(&report, gatewayResources)
+			err = br.writeNamespaceOutput(namespace, pr, report, gatewayResources, notificationTables)
+		}
+		if err != nil {
+			failed = append(failed, namespace)
+			fmt.Fprintf(out, "%s: failed: %s\n", namespace, strings.ReplaceAll(strings.TrimSpace(err.Error()), "\n", " "))
+			continue
+		}
+		fmt.Fprintf(out, "%s: converted, %d Gateways, %d HTTPRoutes, %d GRPCRoutes, %d other resources\n",
+			namespace, report.gateways, report.httpRoutes, report.grpcRoutes, report.otherResources)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("the conversion of %d of %d namespaces failed: %s", len(failed), len(namespaces), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// convertRecovering converts the namespace, returning the panics of the
+// conversion as errors so that they do not stop the conversion of the other
+// namespaces.
+func convertRecovering(ctx context.Context, convert namespaceConverter, namespace string) (gatewayResources []i2gw.GatewayResources, notificationTables map[string]string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the conversion panicked: %v", r)
+		}
+	}()
+	return convert(ctx, namespace)
+}
+
+// writeNamespaceOutput writes the generated resources and the notification
+// tables of the namespace to the output directory. No resource file is written
+// for the namespaces without generated resources.
+func (br *BatchRunner) writeNamespaceOutput(namespace string, pr *PrintRunner, report contextReport, gatewayResources []i2gw.GatewayResources, notificationTables map[string]string) error {
+	if report.gateways+report.httpRoutes+report.grpcRoutes+report.otherResources > 0 {
+		if err := writeNamespaceResources(filepath.Join(br.outputDir, namespace+"."+cmp.Or(br.outputFormat, "yaml")), pr, gatewayResources); err != nil {
+			return fmt.Errorf("failed to write the output file of namespace %s: %w", namespace, err)
+		}
+	}
+
+	if len(notificationTables) == 0 {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(br.outputDir, namespace+".notifications.txt"), []byte(joinNotificationTables(notificationTables)), 0o644)
+}
+
+// writeNamespaceResources prints the generated resources to the file.
+func writeNamespaceResources(path string, pr *PrintRunner, gatewayResources []i2gw.GatewayResources) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	pr.outputResult(gatewayResources, f)
+	return f.Close()
+}
+
+func newBatchCommand() *cobra.Command {
+	br := &BatchRunner{}
+
+	// batchCmd represents the batch command. It converts large clusters one
+	// namespace at a time.
+	var cmd = &cobra.Command{
+		Use:   "batch",
+		Short: "Converts the resources of every namespace independently, writing a file per namespace.",
+		RunE:  br.ConvertNamespaces,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if slices.Contains(br.providers, "openapi3") {
+				return fmt.Errorf("openapi3 resources are not namespaced and cannot be converted per namespace")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&br.outputFormat, "output", "o", "yaml",
+		`Output format of the file of each namespace, either yaml or json.`)
+
+	cmd.Flags().StringVar(&br.inputFile, "input-file", "",
+		`Path to the manifest file. When set, the namespaces of the file are converted instead of the namespaces of the cluster.`)
+
+	cmd.Flags().StringVar(&br.overridesFile, "overrides-file", "",
+		`Path to a YAML file declaring per-source-resource overrides (gateway name, route name, extra hostnames, listener port) applied to the converted resources.`)
+
+	cmd.Flags().StringSliceVar(&br.namespaces, "namespaces", []string{},
+		`If present, the namespaces to convert. Every namespace of the cluster, or of the input file, is converted otherwise.`)
+
+	cmd.Flags().StringVar(&br.outputDir, "output-dir", "",
+		`The directory the generated resources and the notifications of each namespace are written to, as <namespace>.yaml and <namespace>.notifications.txt.`)
+
+	cmd.Flags().StringSliceVar(&br.providers, "providers", []string{},
+		fmt.Sprintf("The providers whose resources are converted, supported values are %v.", i2gw.GetSupportedProviders()))
+
+	cmd.Flags().StringVar(&br.configFile, "config", "",
+		`Path to a YAML file with a section per provider setting its provider-specific flags. Flags set on the command line take precedence.`)
+
+	br.providerSpecificFlags = registerProviderSpecificFlags(cmd)
+
+	_ = cmd.MarkFlagRequired("output-dir")
+	_ = cmd.MarkFlagRequired("providers")
+	return cmd
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/printers"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_convertNamespaces(t *testing.T) {
+	convert := func(_ context.Context, namespace string) ([]i2gw.GatewayResources, map[string]string, error) {
+		switch namespace {
+		case "broken":
+			return nil, nil, errors.New("invalid Ingress")
+		case "panicking":
+			panic("nil map")
+		case "empty":
+			return nil, map[string]string{"nginx": "empty notifications"}, nil
+		}
+		key := types.NamespacedName{Namespace: namespace, Name: "cafe"}
+		return []i2gw.GatewayResources{{
+			HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{key: {TypeMeta: metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"}, ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cafe"}}},
+		}}, map[string]string{"nginx": namespace + " notifications"}, nil
+	}
+
+	br := &BatchRunner{outputDir: t.TempDir()}
+	pr := &PrintRunner{resourcePrinter: &printers.YAMLPrinter{}}
+	var out bytes.Buffer
+	err := br.convertNamespaces(context.Background(), []string{"broken", "cafe", "empty", "panicking", "tea"}, pr, convert, &out)
+	if err == nil || err.Error() != "the conversion of 2 of 5 namespaces failed: broken, panicking" {
+		t.Fatalf("Expected the failed namespaces to be reported, got: %v", err)
+	}
+
+	for _, namespace := range []string{"cafe", "tea"} {
+		resources, err := os.ReadFile(filepath.Join(br.outputDir, namespace+".yaml"))
+		if err != nil {
+			t.Fatalf("Failed to read the resources of %s: %v", namespace, err)
+		}
+		if !strings.Contains(string(resources), "namespace: "+namespace) {
+			t.Errorf("Expected the HTTPRoute of %s, got:\n%s", namespace, resources)
+		}
+		notificationsFile, err := os.ReadFile(filepath.Join(br.outputDir, namespace+".notifications.txt"))
+		if err != nil || !strings.Contains(string(notificationsFile), namespace+" notifications") {
+			t.Errorf("Expected the notifications of %s, got %q: %v", namespace, notificationsFile, err)
+		}
+	}
+	for _, file := range []string{"broken.yaml", "panicking.yaml", "empty.yaml"} {
+		if _, err := os.Stat(filepath.Join(br.outputDir, file)); !os.IsNotExist(err) {
+			t.Errorf("Expected no %s, got: %v", file, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(br.outputDir, "empty.notifications.txt")); err != nil {
+		t.Errorf("Expected the notifications of the namespace without resources: %v", err)
+	}
+
+	for _, want := range []string{
+		"broken: failed: invalid Ingress\n",
+		"cafe: converted, 0 Gateways, 1 HTTPRoutes, 0 GRPCRoutes, 0 other resources\n",
+		"panicking: failed: the conversion panicked: nil map\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func Test_listNamespacesFromFile(t *testing.T) {
+	input := `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: cafe
+  namespace: tea
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: coffee
+  namespace: coffee
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: nginx
+`
+	path := filepath.Join(t.TempDir(), "input.yaml")
+	if err := os.WriteFile(path, []byte(input), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	br := &BatchRunner{inputFile: path}
+	namespaces, err := br.listNamespaces(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(namespaces, ",") != "coffee,tea" {
+		t.Errorf("Expected namespaces coffee and tea, got %v", namespaces)
+	}
+}
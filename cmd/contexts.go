@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// contextConversion is the result of the conversion of the resources of a
+// kubeconfig context.
+type contextConversion struct {
+	namespace          string
+	gatewayResources   []i2gw.GatewayResources
+	notificationTables map[string]string
+}
+
+// contextConverter converts the resources of the cluster of a kubeconfig context.
+type contextConverter func(ctx context.Context, kubeContext string) (*contextConversion, error)
+
+// contextReport summarizes the conversion of a kubeconfig context in the combined
+// report.
+type contextReport struct {
+	context            string
+	namespace          string
+	gateways           int
+	httpRoutes         int
+	grpcRoutes         int
+	otherResources     int
+	notifications      map[notifications.MessageType]int
+	blockers           []string
+	notificationTables map[string]string
+	err                error
+}
+
+// defaultContextsOutputDir is the directory the output of --contexts is written to
+// when --output-dir is not set.
+const defaultContextsOutputDir = "ingress2gateway-output"
+
+// unsafeDirNameCharacters matches the characters of kubeconfig context names, e.g.
+// the ARNs of EKS clusters, replaced in the name of their output directory.
+var unsafeDirNameCharacters = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// contextDirName returns the name of the output directory of a kubeconfig context.
+func contextDirName(kubeContext string) string {
+	return unsafeDirNameCharacters.ReplaceAllString(kubeContext, "_")
+}
+
+// convertContext returns the converter of the resources of a kubeconfig context
+// with the flags of the print command. The namespace is the one of the context,
+// unless --namespace or --all-namespaces is set.
+func (pr *PrintRunner) convertContext() contextConverter {
+	return func(ctx context.Context, kubeContext string) (*contextConversion, error) {
+		namespace := pr.namespace
+		if pr.allNamespaces {
+			namespace = ""
+		} else if namespace == "" {
+			var err error
+			if namespace, err = getNamespaceInContext(kubeContext); err != nil {
+				return nil, err
+			}
+		}
+
+		notifications.NotificationAggr.Reset()
+		gatewayResources, notificationTables, err := i2gw.ToGatewayAPIResourcesInContext(ctx, kubeContext, pr.conversionOptions(namespace, ""))
+		return &contextConversion{namespace: namespace, gatewayResources: gatewayResources, notificationTables: notificationTables}, err
+	}
+}
+
+// printContexts converts the resources of every kubeconfig context, writes the
+// generated resources and the notifications of each to its own directory under
+// the output directory, then writes the combined report of the conversions. The
+// contexts failing to convert are reported and do not stop the others.
+func (pr *PrintRunner) printContexts(ctx context.Context, contexts []string, outputDir string, convert contextConverter, out io.Writer) error {
+	reports := make([]contextReport, 0, len(contexts))
+	for _, kubeContext := range contexts {
+		report := contextReport{context: kubeContext}
+		conversion, err := convert(ctx, kubeContext)
+		if conversion != nil {
+			report.namespace = conversion.namespace
+			report.notificationTables = conversion.notificationTables
+			report.notifications = countNotifications()
+			report.blockers = blockerMessages()
+		}
+		if err == nil {
+			err = pr.writeContextOutput(filepath.Join(outputDir, contextDirName(kubeContext)), conversion)
+		}
+		if err != nil {
+			report.err = err
+		} else {
+			countResources(&report, conversion.gatewayResources)
+		}
+		fmt.Fprintf(out, "%s: %s\n", kubeContext, report.result())
+		reports = append(reports, report)
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", outputDir, err)
+	}
+	reportFile := filepath.Join(outputDir, "report.md")
+	if err := os.WriteFile(reportFile, []byte(combinedReport(reports)), 0o644); err != nil {
+		return fmt.Errorf("failed to write the report: %w", err)
+	}
+	fmt.Fprintf(out, "Report written to %s\n", reportFile)
+
+	failed := slices.ContainsFunc(reports, func(report contextReport) bool { return report.err != nil })
+	if failed {
+		return fmt.Errorf("the conversion of some contexts failed, see %s", reportFile)
+	}
+	strictCount := 0
+	for _, report := range reports {
+		for mType, count := range report.notifications {
+			if pr.strictSeverity != "" && mType.AtLeast(pr.strictSeverity) {
+				strictCount += count
+			}
+		}
+	}
+	return pr.checkStrict(strictCount)
+}
+
+// writeContextOutput writes the generated resources, laid out by writeResourceTree,
+// the inventory of the source objects and the notification tables of a context
+// conversion to the directory.
+func (pr *PrintRunner) writeContextOutput(dir string, conversion *contextConversion) error {
+	if err := pr.writeResourceTree(dir, conversion.gatewayResources); err != nil {
+		return err
+	}
+
+	if err := i2gw.WriteInventory(filepath.Join(dir, "inventory.json"), i2gw.Inventory(conversion.gatewayResources)); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "notifications.txt"), []byte(joinNotificationTables(conversion.notificationTables)), 0o644)
+}
+
+// countNotifications counts the notifications of the conversion per type.
+func countNotifications() map[notifications.MessageType]int {
+	counts := map[notifications.MessageType]int{}
+	for _, providerNotifications := range notifications.NotificationAggr.Notifications {
+		for _, notification := range providerNotifications {
+			counts[notification.Type]++
+		}
+	}
+	return counts
+}
+
+// blockerMessages returns the distinct messages of the blockers of the
+// conversion, ordered by provider.
+func blockerMessages() []string {
+	providers := make([]string, 0, len(notifications.NotificationAggr.Notifications))
+	for provider := range notifications.NotificationAggr.Notifications {
+		providers = append(providers, provider)
+	}
+	slices.Sort(providers)
+
+	var messages []string
+	for _, provider := range providers {
+		for _, notification := range notifications.NotificationAggr.Notifications[provider] {
+			if notification.Type == notifications.BlockerNotification && !slices.Contains(messages, notification.Message) {
+				messages = append(messages, notification.Message)
+			}
+		}
+	}
+	return messages
+}
+
+// countResources counts the generated resources of the report.
+func countResources(report *contextReport, gatewayResources []i2gw.GatewayResources) {
+	for _, r := range gatewayResources {
+		report.gateways += len(r.Gateways)
+		report.httpRoutes += len(r.HTTPRoutes)
+		report.grpcRoutes += len(r.GRPCRoutes)
+		report.otherResources += len(r.GatewayClasses) + len(r.TLSRoutes) + len(r.TCPRoutes) + len(r.UDPRoutes) +
+			len(r.BackendTLSPolicies) + len(r.ReferenceGrants) + len(r.GatewayExtensions)
+	}
+}
+
+// result returns "converted" or the error of the conversion.
+func (r contextReport) result() string {
+	if r.err != nil {
+		return "failed: " + strings.ReplaceAll(strings.TrimSpace(r.err.Error()), "\n", " ")
+	}
+	if r.notifications[notifications.BlockerNotification] > 0 {
+		return "converted, migration blocked"
+	}
+	return "converted"
+}
+
+// joinNotificationTables returns the notification tables ordered by provider.
+func joinNotificationTables(tables map[string]string) string {
+	providers := make([]string, 0, len(tables))
+	for provider := range tables {
+		providers = append(providers, provider)
+	}
+	slices.Sort(providers)
+
+	var b strings.Builder
+	for _, provider := range providers {
+		b.WriteString(tables[provider])
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// combinedReport returns the Markdown report of the conversions: a summary table
+// with a row per context, followed by the migration blockers of every context,
+// then by the notifications of each context.
+func combinedReport(reports []contextReport) string {
+	var b strings.Builder
+	b.WriteString("# Conversion report\n\n")
+	b.WriteString("| Context | Namespace | Gateways | HTTPRoutes | GRPCRoutes | Other resources | Blockers | Errors | Warnings | Infos | Result |\n")
+	b.WriteString("|---------|-----------|----------|------------|------------|-----------------|----------|--------|----------|-------|--------|\n")
+	for _, r := range reports {
+		namespace := r.namespace
+		if namespace == "" {
+			namespace = "(all)"
+		}
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %d | %d | %d | %d | %d | %d | %s |\n", r.context, namespace, r.gateways, r.httpRoutes, r.grpcRoutes, r.otherResources,
+			r.notifications[notifications.BlockerNotification], r.notifications[notifications.ErrorNotification], r.notifications[notifications.WarningNotification], r.notifications[notifications.InfoNotification],
+			strings.ReplaceAll(r.result(), "|", `\|`))
+	}
+
+	if slices.ContainsFunc(reports, func(r contextReport) bool { return len(r.blockers) > 0 }) {
+		b.WriteString("\n## Migration blockers\n\n")
+		for _, r := range reports {
+			for _, blocker := range r.blockers {
+				fmt.Fprintf(&b, "- **%s**: %s\n", r.context, blocker)
+			}
+		}
+	}
+
+	for _, r := range reports {
+		if len(r.notificationTables) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n```\n%s```\n", r.context, joinNotificationTables(r.notificationTables))
+	}
+	return b.String()
+}
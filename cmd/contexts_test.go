@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/printers"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_printContexts(t *testing.T) {
+	convert := func(_ context.Context, kubeContext string) (*contextConversion, error) {
+		if kubeContext == "staging" {
+			return nil, errors.New("context \"staging\" does not exist")
+		}
+		notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{
+			"nginx": {{Type: notifications.WarningNotification, Message: "warning"}, {Type: notifications.InfoNotification, Message: "info"}},
+		}
+		key := types.NamespacedName{Namespace: "default", Name: "cafe"}
+		return &contextConversion{
+			namespace: "default",
+			gatewayResources: []i2gw.GatewayResources{{
+				Gateways:   map[types.NamespacedName]gatewayv1.Gateway{key: {TypeMeta: metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "Gateway"}, ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"}}},
+				HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{key: {TypeMeta: metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"}, ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"}}},
+			}},
+			notificationTables: map[string]string{"nginx": "nginx notifications"},
+		}, nil
+	}
+
+	pr := &PrintRunner{resourcePrinter: &printers.YAMLPrinter{}}
+	outputDir := t.TempDir()
+	var out bytes.Buffer
+	err := pr.printContexts(context.Background(), []string{"arn:aws:eks:eu-west-1:1234:cluster/prod", "staging"}, outputDir, convert, &out)
+	if err == nil {
+		t.Fatal("Expected an error for the failed context")
+	}
+
+	prodDir := filepath.Join(outputDir, "arn_aws_eks_eu-west-1_1234_cluster_prod")
+	for file, want := range map[string]string{"gateway.yaml": "kind: Gateway", "httproute.yaml": "kind: HTTPRoute"} {
+		resources, err := os.ReadFile(filepath.Join(prodDir, "default", file))
+		if err != nil {
+			t.Fatalf("Failed to read the resources: %v", err)
+		}
+		if !strings.Contains(string(resources), want) {
+			t.Errorf("Expected %s to contain %q, got:\n%s", file, want, resources)
+		}
+	}
+	notificationsFile, err := os.ReadFile(filepath.Join(prodDir, "notifications.txt"))
+	if err != nil {
+		t.Fatalf("Failed to read the notifications: %v", err)
+	}
+	if !strings.Contains(string(notificationsFile), "nginx notifications") {
+		t.Errorf("Expected the notification tables, got:\n%s", notificationsFile)
+	}
+	if _, err = os.Stat(filepath.Join(outputDir, "staging")); !os.IsNotExist(err) {
+		t.Errorf("Expected no output directory for the failed context, got: %v", err)
+	}
+
+	report, err := os.ReadFile(filepath.Join(outputDir, "report.md"))
+	if err != nil {
+		t.Fatalf("Failed to read the report: %v", err)
+	}
+	for _, want := range []string{
+		"| arn:aws:eks:eu-west-1:1234:cluster/prod | default | 1 | 1 | 0 | 0 | 0 | 0 | 1 | 1 | converted |",
+		`| staging | (all) | 0 | 0 | 0 | 0 | 0 | 0 | 0 | 0 | failed: context "staging" does not exist |`,
+		"## arn:aws:eks:eu-west-1:1234:cluster/prod",
+	} {
+		if !strings.Contains(string(report), want) {
+			t.Errorf("Expected the report to contain %q, got:\n%s", want, report)
+		}
+	}
+	if !strings.Contains(out.String(), "Report written to") {
+		t.Errorf("Expected the path of the report, got:\n%s", out.String())
+	}
+}
+
+func Test_printContextsStrict(t *testing.T) {
+	convert := func(_ context.Context, kubeContext string) (*contextConversion, error) {
+		notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{
+			"nginx": {{Type: notifications.WarningNotification, Message: "warning"}},
+		}
+		if kubeContext == "prod" {
+			notifications.NotificationAggr.Notifications["nginx"] = append(notifications.NotificationAggr.Notifications["nginx"],
+				notifications.Notification{Type: notifications.BlockerNotification, Message: "NTLM is not supported"})
+		}
+		return &contextConversion{namespace: "default"}, nil
+	}
+
+	testCases := []struct {
+		name           string
+		strictSeverity notifications.MessageType
+		expectedError  string
+	}{
+		{name: "not strict"},
+		{name: "strict on blockers", strictSeverity: notifications.BlockerNotification, expectedError: "raised 1 notifications of type BLOCKER"},
+		{name: "strict on warnings", strictSeverity: notifications.WarningNotification, expectedError: "raised 3 notifications of type WARNING"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &PrintRunner{resourcePrinter: &printers.YAMLPrinter{}, strict: strings.ToLower(string(tc.strictSeverity)), strictSeverity: tc.strictSeverity}
+			outputDir := t.TempDir()
+			err := pr.printContexts(context.Background(), []string{"prod", "staging"}, outputDir, convert, io.Discard)
+			if tc.expectedError == "" && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedError)) {
+				t.Fatalf("Expected an error containing %q, got: %v", tc.expectedError, err)
+			}
+
+			report, err := os.ReadFile(filepath.Join(outputDir, "report.md"))
+			if err != nil {
+				t.Fatalf("Failed to read the report: %v", err)
+			}
+			if !strings.Contains(string(report), "## Migration blockers\n\n- **prod**: NTLM is not supported\n") {
+				t.Errorf("Expected the report to list the blockers, got:\n%s", report)
+			}
+			if !strings.Contains(string(report), "| prod | default | 0 | 0 | 0 | 0 | 1 | 0 | 1 | 0 | converted, migration blocked |") {
+				t.Errorf("Expected the summary row of prod, got:\n%s", report)
+			}
+		})
+	}
+}
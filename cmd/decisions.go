@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/spf13/cobra"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// conversionDecisions are the answers to the conversion choices, recorded by
+// print --interactive in the decisions file for subsequent runs.
+type conversionDecisions struct {
+	// ProviderSpecificFlags maps --<provider>-<flag> flag names to their value.
+	ProviderSpecificFlags map[string]string `json:"providerSpecificFlags"`
+}
+
+// readDecisionsFile returns the decisions recorded in the file, and empty
+// decisions when the file does not exist yet.
+func readDecisionsFile(path string) (*conversionDecisions, error) {
+	decisions := &conversionDecisions{ProviderSpecificFlags: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return decisions, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read decisions file %s: %w", path, err)
+	}
+	if err = kubeyaml.UnmarshalStrict(data, decisions); err != nil {
+		return nil, fmt.Errorf("failed to parse decisions file %s: %w", path, err)
+	}
+	if decisions.ProviderSpecificFlags == nil {
+		decisions.ProviderSpecificFlags = map[string]string{}
+	}
+	return decisions, nil
+}
+
+// writeDecisionsFile writes the decisions as JSON, which is also valid YAML.
+func writeDecisionsFile(path string, decisions *conversionDecisions) error {
+	data, err := json.MarshalIndent(decisions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write decisions file %s: %w", path, err)
+	}
+	return nil
+}
+
+// resolveDecisions sets the provider-specific flags of the providers which were
+// not set on the command line: from the decisions file, then from the answers
+// to the prompts when interactive. An empty answer keeps the current value.
+// The resolved values are recorded in the decisions file.
+func resolveDecisions(cmd *cobra.Command, providers []string, decisionsFile string, interactive bool, in io.Reader, out io.Writer) error {
+	decisions := &conversionDecisions{ProviderSpecificFlags: map[string]string{}}
+	if decisionsFile != "" {
+		var err error
+		if decisions, err = readDecisionsFile(decisionsFile); err != nil {
+			return err
+		}
+	}
+
+	definitions := i2gw.GetProviderSpecificFlagDefinitions()
+	reader := bufio.NewReader(in)
+	providers = slices.Clone(providers)
+	slices.Sort(providers)
+	for _, provider := range providers {
+		flagDefinitions := definitions[i2gw.ProviderName(provider)]
+		names := make([]string, 0, len(flagDefinitions))
+		for name := range flagDefinitions {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			flagName := fmt.Sprintf("%s-%s", provider, name)
+			flag := cmd.Flags().Lookup(flagName)
+			if flag == nil || flag.Changed {
+				continue
+			}
+			value, recorded := decisions.ProviderSpecificFlags[flagName]
+			if !recorded {
+				value = flag.Value.String()
+			}
+
+			if interactive {
+				fmt.Fprintf(out, "%s\n%s [%s]: ", flagDefinitions[name].Description, flagName, value)
+				answer, err := reader.ReadString('\n')
+				if err != nil && !errors.Is(err, io.EOF) {
+					return fmt.Errorf("failed to read the answer to %s: %w", flagName, err)
+				}
+				if answer = strings.TrimSpace(answer); answer != "" {
+					value = answer
+				}
+				if errors.Is(err, io.EOF) {
+					fmt.Fprintln(out)
+				}
+			}
+
+			if err := cmd.Flags().Set(flagName, value); err != nil {
+				return fmt.Errorf("invalid value %q for --%s: %w", value, flagName, err)
+			}
+			if interactive || recorded {
+				decisions.ProviderSpecificFlags[flagName] = value
+			}
+		}
+	}
+
+	if interactive && decisionsFile != "" {
+		return writeDecisionsFile(decisionsFile, decisions)
+	}
+	return nil
+}
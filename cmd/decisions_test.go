@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/spf13/cobra"
+)
+
+func Test_resolveDecisions(t *testing.T) {
+	i2gw.RegisterProviderSpecificFlag("decisions-test", i2gw.ProviderSpecificFlag{
+		Name:         "listener-strategy",
+		Description:  "How listeners are generated.",
+		DefaultValue: "per-host",
+	})
+	i2gw.RegisterProviderSpecificFlag("decisions-test", i2gw.ProviderSpecificFlag{
+		Name:        "default-certificate",
+		Description: "The default certificate.",
+	})
+	i2gw.RegisterProviderSpecificFlag("decisions-test", i2gw.ProviderSpecificFlag{
+		Name:         "snippet-redirects",
+		Description:  "Convert snippet redirects.",
+		DefaultValue: "false",
+	})
+	decisionsFile := filepath.Join(t.TempDir(), "decisions.yaml")
+
+	newCommand := func(args ...string) (*cobra.Command, map[string]*string) {
+		cmd := &cobra.Command{}
+		flags := registerProviderSpecificFlags(cmd)
+		if err := cmd.Flags().Parse(args); err != nil {
+			t.Fatalf("Failed to parse flags: %v", err)
+		}
+		return cmd, flags
+	}
+
+	// The first run prompts for the flags not set on the command line, in
+	// alphabetical order, and records the answers.
+	cmd, flags := newCommand("--decisions-test-snippet-redirects=true")
+	var out bytes.Buffer
+	in := strings.NewReader("default/tls\n\n")
+	if err := resolveDecisions(cmd, []string{"decisions-test"}, decisionsFile, true, in, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "The default certificate.\ndecisions-test-default-certificate []: How listeners are generated.\ndecisions-test-listener-strategy [per-host]: "
+	if out.String() != want {
+		t.Errorf("Unexpected prompts:\n%q\nwant:\n%q", out.String(), want)
+	}
+	if got := *flags["decisions-test-default-certificate"]; got != "default/tls" {
+		t.Errorf("Expected the default certificate to be default/tls, got %q", got)
+	}
+
+	decisions, err := readDecisionsFile(decisionsFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantDecisions := map[string]string{
+		"decisions-test-default-certificate": "default/tls",
+		"decisions-test-listener-strategy":   "per-host",
+	}
+	if len(decisions.ProviderSpecificFlags) != len(wantDecisions) {
+		t.Errorf("Expected decisions %v, got %v", wantDecisions, decisions.ProviderSpecificFlags)
+	}
+	for name, value := range wantDecisions {
+		if decisions.ProviderSpecificFlags[name] != value {
+			t.Errorf("Expected decision %s=%s, got %q", name, value, decisions.ProviderSpecificFlags[name])
+		}
+	}
+
+	// A subsequent run reads the decisions without prompting, the command
+	// line taking precedence.
+	cmd, flags = newCommand("--decisions-test-listener-strategy=wildcard")
+	out.Reset()
+	if err = resolveDecisions(cmd, []string{"decisions-test"}, decisionsFile, false, strings.NewReader(""), &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Expected no prompts, got %q", out.String())
+	}
+	if got := *flags["decisions-test-default-certificate"]; got != "default/tls" {
+		t.Errorf("Expected the default certificate to be read from the decisions file, got %q", got)
+	}
+	if got := *flags["decisions-test-listener-strategy"]; got != "wildcard" {
+		t.Errorf("Expected the listener strategy of the command line, got %q", got)
+	}
+}
+
+func Test_applyProviderConfigFile(t *testing.T) {
+	i2gw.RegisterProviderSpecificFlag("config-test", i2gw.ProviderSpecificFlag{Name: "listener-strategy", DefaultValue: "per-host"})
+	i2gw.RegisterProviderSpecificFlag("config-test", i2gw.ProviderSpecificFlag{Name: "target", DefaultValue: "gateway-api"})
+	i2gw.ProviderConfigParserByName["config-test"] = func(data []byte) (map[string]string, error) {
+		config := map[string]string{}
+		return config, json.Unmarshal(data, &config)
+	}
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configFile, []byte("config-test:\n  listener-strategy: wildcard\n  target: nginx-gateway-fabric\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	cmd := &cobra.Command{}
+	flags := registerProviderSpecificFlags(cmd)
+	if err := cmd.Flags().Parse([]string{"--config-test-target=gateway-api"}); err != nil {
+		t.Fatalf("Failed to parse flags: %v", err)
+	}
+	if err := applyProviderConfigFile(cmd, []string{"config-test"}, configFile); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := *flags["config-test-listener-strategy"]; got != "wildcard" {
+		t.Errorf("Expected the listener strategy of the config file, got %q", got)
+	}
+	if got := *flags["config-test-target"]; got != "gateway-api" {
+		t.Errorf("Expected the target of the command line, got %q", got)
+	}
+	if cmd.Flags().Lookup("config-test-listener-strategy").Changed {
+		t.Errorf("Expected the flag set from the config file not to be marked as changed")
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// clusterScopedDir is the directory of the cluster-scoped resources, such as the
+// GatewayClasses. Namespace names cannot contain an underscore, so it does not
+// collide with the directory of a namespace.
+const clusterScopedDir = "_cluster"
+
+// indexFile is the name of the file listing the resources of the output directory.
+const indexFile = "index.json"
+
+// indexEntry is a generated resource listed in the index of the output directory.
+type indexEntry struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	File      string `json:"file"`
+}
+
+// resourceTree groups the generated resources by the file of the output directory
+// they are written to.
+type resourceTree struct {
+	extension string
+	files     map[string]*i2gw.GatewayResources
+	index     []indexEntry
+}
+
+// add returns the resources of the file of the kind in the namespace, and lists
+// the resource in the index.
+func (t *resourceTree) add(kind, namespace, name string, sources i2gw.Provenance) *i2gw.GatewayResources {
+	dir := namespace
+	if dir == "" {
+		dir = clusterScopedDir
+	}
+	extension := t.extension
+	if !isGatewayAPIKind(kind) {
+		// The provider-specific extensions are always printed as YAML.
+		extension = "yaml"
+	}
+	file := filepath.ToSlash(filepath.Join(dir, strings.ToLower(kind)+"."+extension))
+
+	resources, ok := t.files[file]
+	if !ok {
+		resources = &i2gw.GatewayResources{
+			Gateways:           map[types.NamespacedName]gatewayv1.Gateway{},
+			GatewayClasses:     map[types.NamespacedName]gatewayv1.GatewayClass{},
+			HTTPRoutes:         map[types.NamespacedName]gatewayv1.HTTPRoute{},
+			GRPCRoutes:         map[types.NamespacedName]gatewayv1.GRPCRoute{},
+			TLSRoutes:          map[types.NamespacedName]gatewayv1alpha2.TLSRoute{},
+			TCPRoutes:          map[types.NamespacedName]gatewayv1alpha2.TCPRoute{},
+			UDPRoutes:          map[types.NamespacedName]gatewayv1alpha2.UDPRoute{},
+			BackendTLSPolicies: map[types.NamespacedName]gatewayv1alpha3.BackendTLSPolicy{},
+			ReferenceGrants:    map[types.NamespacedName]gatewayv1beta1.ReferenceGrant{},
+			Sources:            sources,
+		}
+		t.files[file] = resources
+	}
+	t.index = append(t.index, indexEntry{Kind: kind, Namespace: namespace, Name: name, File: file})
+	return resources
+}
+
+// isGatewayAPIKind tells whether the kind is one of the Gateway API resources
+// printed with the resource printer of the output format.
+func isGatewayAPIKind(kind string) bool {
+	switch kind {
+	case "GatewayClass", "Gateway", "HTTPRoute", "GRPCRoute", "TLSRoute", "TCPRoute", "UDPRoute", "BackendTLSPolicy", "ReferenceGrant":
+		return true
+	}
+	return false
+}
+
+// newResourceTree groups the generated resources by namespace and kind.
+func newResourceTree(gatewayResources []i2gw.GatewayResources, extension string) *resourceTree {
+	t := &resourceTree{extension: extension, files: map[string]*i2gw.GatewayResources{}, index: []indexEntry{}}
+	for _, r := range gatewayResources {
+		for key, gatewayClass := range r.GatewayClasses {
+			t.add("GatewayClass", "", key.Name, r.Sources).GatewayClasses[key] = gatewayClass
+		}
+		for key, gateway := range r.Gateways {
+			t.add("Gateway", key.Namespace, key.Name, r.Sources).Gateways[key] = gateway
+		}
+		for key, httpRoute := range r.HTTPRoutes {
+			t.add("HTTPRoute", key.Namespace, key.Name, r.Sources).HTTPRoutes[key] = httpRoute
+		}
+		for key, grpcRoute := range r.GRPCRoutes {
+			t.add("GRPCRoute", key.Namespace, key.Name, r.Sources).GRPCRoutes[key] = grpcRoute
+		}
+		for key, tlsRoute := range r.TLSRoutes {
+			t.add("TLSRoute", key.Namespace, key.Name, r.Sources).TLSRoutes[key] = tlsRoute
+		}
+		for key, tcpRoute := range r.TCPRoutes {
+			t.add("TCPRoute", key.Namespace, key.Name, r.Sources).TCPRoutes[key] = tcpRoute
+		}
+		for key, udpRoute := range r.UDPRoutes {
+			t.add("UDPRoute", key.Namespace, key.Name, r.Sources).UDPRoutes[key] = udpRoute
+		}
+		for key, backendTLSPolicy := range r.BackendTLSPolicies {
+			t.add("BackendTLSPolicy", key.Namespace, key.Name, r.Sources).BackendTLSPolicies[key] = backendTLSPolicy
+		}
+		for key, referenceGrant := range r.ReferenceGrants {
+			t.add("ReferenceGrant", key.Namespace, key.Name, r.Sources).ReferenceGrants[key] = referenceGrant
+		}
+		for _, gatewayExtension := range r.GatewayExtensions {
+			resources := t.add(gatewayExtension.GetKind(), gatewayExtension.GetNamespace(), gatewayExtension.GetName(), r.Sources)
+			resources.GatewayExtensions = append(resources.GatewayExtensions, *gatewayExtension.DeepCopy())
+		}
+	}
+
+	slices.SortFunc(t.index, func(a, b indexEntry) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Name, b.Name))
+	})
+	for _, resources := range t.files {
+		slices.SortFunc(resources.GatewayExtensions, func(a, b unstructured.Unstructured) int {
+			return cmp.Compare(a.GetName(), b.GetName())
+		})
+	}
+	return t
+}
+
+// writeResourceTree writes the generated resources to the directory, a directory
+// per namespace holding a file per kind, e.g. default/httproute.yaml, and the
+// index of the resources to index.json, so that the namespaces can be copied to
+// the repositories of the teams owning them.
+func (pr *PrintRunner) writeResourceTree(dir string, gatewayResources []i2gw.GatewayResources) error {
+	extension := "yaml"
+	if pr.outputFormat == "json" {
+		extension = "json"
+	}
+	tree := newResourceTree(gatewayResources, extension)
+
+	for file, resources := range tree.files {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(path), err)
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		// Every file is printed with its own printer, so that it does not start
+		// with the YAML separator of the objects printed before.
+		filePrinter := *pr
+		if err = filePrinter.initializeResourcePrinter(); err != nil {
+			f.Close()
+			return err
+		}
+		filePrinter.outputResult([]i2gw.GatewayResources{*resources}, f)
+		if err = f.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	index, err := json.MarshalIndent(tree.index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, indexFile), append(index, '\n'), 0o644)
+}
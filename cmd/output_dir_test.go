@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_writeResourceTree(t *testing.T) {
+	objectMeta := func(namespace, name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: namespace, Name: name}
+	}
+	typeMeta := func(kind string) metav1.TypeMeta {
+		return metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: kind}
+	}
+	gatewayResources := []i2gw.GatewayResources{{
+		GatewayClasses: map[types.NamespacedName]gatewayv1.GatewayClass{
+			{Name: "nginx"}: {TypeMeta: typeMeta("GatewayClass"), ObjectMeta: objectMeta("", "nginx")},
+		},
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			{Namespace: "cafe", Name: "nginx"}: {TypeMeta: typeMeta("Gateway"), ObjectMeta: objectMeta("cafe", "nginx")},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "cafe", Name: "tea"}:    {TypeMeta: typeMeta("HTTPRoute"), ObjectMeta: objectMeta("cafe", "tea")},
+			{Namespace: "cafe", Name: "coffee"}: {TypeMeta: typeMeta("HTTPRoute"), ObjectMeta: objectMeta("cafe", "coffee")},
+			{Namespace: "shop", Name: "cart"}:   {TypeMeta: typeMeta("HTTPRoute"), ObjectMeta: objectMeta("shop", "cart")},
+		},
+		GatewayExtensions: []unstructured.Unstructured{{Object: map[string]interface{}{
+			"apiVersion": "gateway.nginx.org/v1alpha1",
+			"kind":       "ClientSettingsPolicy",
+			"metadata":   map[string]interface{}{"namespace": "cafe", "name": "body-size"},
+		}}},
+	}}
+
+	testCases := []struct {
+		name          string
+		outputFormat  string
+		expectedIndex []indexEntry
+	}{
+		{
+			name:         "yaml",
+			outputFormat: "yaml",
+			expectedIndex: []indexEntry{
+				{Kind: "GatewayClass", Name: "nginx", File: "_cluster/gatewayclass.yaml"},
+				{Kind: "ClientSettingsPolicy", Namespace: "cafe", Name: "body-size", File: "cafe/clientsettingspolicy.yaml"},
+				{Kind: "Gateway", Namespace: "cafe", Name: "nginx", File: "cafe/gateway.yaml"},
+				{Kind: "HTTPRoute", Namespace: "cafe", Name: "coffee", File: "cafe/httproute.yaml"},
+				{Kind: "HTTPRoute", Namespace: "cafe", Name: "tea", File: "cafe/httproute.yaml"},
+				{Kind: "HTTPRoute", Namespace: "shop", Name: "cart", File: "shop/httproute.yaml"},
+			},
+		},
+		{
+			name:         "json, extensions are printed as yaml",
+			outputFormat: "json",
+			expectedIndex: []indexEntry{
+				{Kind: "GatewayClass", Name: "nginx", File: "_cluster/gatewayclass.json"},
+				{Kind: "ClientSettingsPolicy", Namespace: "cafe", Name: "body-size", File: "cafe/clientsettingspolicy.yaml"},
+				{Kind: "Gateway", Namespace: "cafe", Name: "nginx", File: "cafe/gateway.json"},
+				{Kind: "HTTPRoute", Namespace: "cafe", Name: "coffee", File: "cafe/httproute.json"},
+				{Kind: "HTTPRoute", Namespace: "cafe", Name: "tea", File: "cafe/httproute.json"},
+				{Kind: "HTTPRoute", Namespace: "shop", Name: "cart", File: "shop/httproute.json"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			pr := &PrintRunner{outputFormat: tc.outputFormat}
+			if err := pr.writeResourceTree(dir, gatewayResources); err != nil {
+				t.Fatalf("Failed to write the resources: %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, indexFile))
+			if err != nil {
+				t.Fatalf("Failed to read the index: %v", err)
+			}
+			var index []indexEntry
+			if err = json.Unmarshal(data, &index); err != nil {
+				t.Fatalf("Failed to parse the index: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectedIndex, index); diff != "" {
+				t.Errorf("Unexpected index (-want +got):\n%s", diff)
+			}
+
+			for _, entry := range index {
+				data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(entry.File)))
+				if err != nil {
+					t.Fatalf("Failed to read %s: %v", entry.File, err)
+				}
+				if !strings.Contains(string(data), entry.Name) || !strings.Contains(string(data), entry.Kind) {
+					t.Errorf("Expected %s to contain %s %s, got:\n%s", entry.File, entry.Kind, entry.Name, data)
+				}
+				if strings.Contains(string(data), "shop") != (entry.Namespace == "shop") {
+					t.Errorf("Expected %s to contain only the resources of its namespace, got:\n%s", entry.File, data)
+				}
+			}
+		})
+	}
+}
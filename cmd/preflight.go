@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// The annotations set by Gateway API on its CRDs.
+	gatewayAPIBundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
+	gatewayAPIChannelAnnotation       = "gateway.networking.k8s.io/channel"
+)
+
+// crdGVK is the kind of CustomResourceDefinitions, read as unstructured
+// objects.
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// preflightBaseKinds are the kinds every conversion requires.
+var preflightBaseKinds = []string{"GatewayClass", "Gateway", "HTTPRoute"}
+
+// preflightKinds are the kinds checked by the preflight command, with the
+// version generated by the conversion.
+var preflightKinds = append([]schema.GroupVersionKind{gatewayv1.SchemeGroupVersion.WithKind("GatewayClass")}, syncedKinds...)
+
+type PreflightRunner struct {
+	// The GatewayClass the generated Gateways use. Value assigned via
+	// --gateway-class flag
+	gatewayClass string
+
+	// The kinds required in addition to GatewayClass, Gateway and HTTPRoute.
+	// Value assigned via --required-kinds flag
+	requiredKinds []string
+}
+
+// preflightCheck is a line of the checklist printed by the preflight command.
+type preflightCheck struct {
+	ok       bool
+	required bool
+	message  string
+}
+
+func (c preflightCheck) String() string {
+	switch {
+	case c.ok:
+		return "[ok]       " + c.message
+	case c.required:
+		return "[missing]  " + c.message
+	default:
+		return "[optional] " + c.message
+	}
+}
+
+// CheckPrerequisites checks the cluster for the CRDs and the GatewayClass the
+// generated resources require, and prints the checklist.
+func (pr *PreflightRunner) CheckPrerequisites(cmd *cobra.Command, _ []string) error {
+	cl, err := newClusterClient()
+	if err != nil {
+		return err
+	}
+
+	checks, err := pr.check(cmd.Context(), cl)
+	if err != nil {
+		return err
+	}
+	return printChecklist(checks, cmd.OutOrStdout())
+}
+
+// check returns the checklist of the CRDs of the checked kinds, followed by
+// the GatewayClass.
+func (pr *PreflightRunner) check(ctx context.Context, cl client.Client) ([]preflightCheck, error) {
+	required := append(slices.Clone(preflightBaseKinds), pr.requiredKinds...)
+
+	var checks []preflightCheck
+	gatewayClassCRD := false
+	for _, gvk := range preflightKinds {
+		check, err := checkCRD(ctx, cl, gvk)
+		if err != nil {
+			return nil, err
+		}
+		check.required = slices.Contains(required, gvk.Kind)
+		if gvk.Kind == "GatewayClass" {
+			gatewayClassCRD = check.ok
+		}
+		checks = append(checks, check)
+	}
+
+	if pr.gatewayClass != "" {
+		check, err := checkGatewayClass(ctx, cl, pr.gatewayClass, gatewayClassCRD)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// checkCRD checks the CRD of the kind is installed and serves the version
+// generated by the conversion.
+func checkCRD(ctx context.Context, cl client.Client, gvk schema.GroupVersionKind) (preflightCheck, error) {
+	name := crdName(gvk)
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	err := cl.Get(ctx, types.NamespacedName{Name: name}, crd)
+	if apierrors.IsNotFound(err) {
+		return preflightCheck{message: fmt.Sprintf("CRD %s: not installed", name)}, nil
+	}
+	if err != nil {
+		return preflightCheck{}, fmt.Errorf("failed to get CRD %s: %w", name, err)
+	}
+
+	bundle := fmt.Sprintf("bundle %s, %s channel", valueOrUnknown(crd.GetAnnotations()[gatewayAPIBundleVersionAnnotation]),
+		valueOrUnknown(crd.GetAnnotations()[gatewayAPIChannelAnnotation]))
+	served := servedVersions(crd)
+	if !slices.Contains(served, gvk.Version) {
+		return preflightCheck{message: fmt.Sprintf("CRD %s (%s): version %s not served, served versions are %v", name, bundle, gvk.Version, served)}, nil
+	}
+	return preflightCheck{ok: true, message: fmt.Sprintf("CRD %s (%s)", name, bundle)}, nil
+}
+
+// checkGatewayClass checks the GatewayClass exists and was accepted by its
+// controller.
+func checkGatewayClass(ctx context.Context, cl client.Client, name string, crdInstalled bool) (preflightCheck, error) {
+	check := preflightCheck{required: true}
+	if !crdInstalled {
+		check.message = fmt.Sprintf("GatewayClass %s: the GatewayClass CRD is not installed", name)
+		return check, nil
+	}
+
+	gatewayClass := &gatewayv1.GatewayClass{}
+	err := cl.Get(ctx, types.NamespacedName{Name: name}, gatewayClass)
+	switch {
+	case apierrors.IsNotFound(err):
+		check.message = fmt.Sprintf("GatewayClass %s: not found", name)
+		return check, nil
+	case meta.IsNoMatchError(err):
+		check.message = fmt.Sprintf("GatewayClass %s: the GatewayClass CRD does not serve %s", name, gatewayv1.GroupVersion)
+		return check, nil
+	case err != nil:
+		return preflightCheck{}, fmt.Errorf("failed to get GatewayClass %s: %w", name, err)
+	}
+
+	accepted := meta.FindStatusCondition(gatewayClass.Status.Conditions, string(gatewayv1.GatewayClassConditionStatusAccepted))
+	if accepted == nil || accepted.Status != metav1.ConditionTrue {
+		check.message = fmt.Sprintf("GatewayClass %s (controller %s): not accepted", name, gatewayClass.Spec.ControllerName)
+		if accepted != nil && accepted.Message != "" {
+			check.message += ": " + accepted.Message
+		}
+		return check, nil
+	}
+	check.ok = true
+	check.message = fmt.Sprintf("GatewayClass %s (controller %s): accepted", name, gatewayClass.Spec.ControllerName)
+	return check, nil
+}
+
+// printChecklist prints the checks, and returns an error when a required one
+// failed.
+func printChecklist(checks []preflightCheck, out io.Writer) error {
+	missing := 0
+	for _, check := range checks {
+		fmt.Fprintln(out, check)
+		if check.required && !check.ok {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return fmt.Errorf("%d prerequisites missing", missing)
+	}
+	return nil
+}
+
+// crdName returns the name of the CRD of the Gateway API kind.
+func crdName(gvk schema.GroupVersionKind) string {
+	plural := strings.ToLower(gvk.Kind)
+	switch {
+	case strings.HasSuffix(plural, "y") && !strings.HasSuffix(plural, "ay"):
+		plural = strings.TrimSuffix(plural, "y") + "ies"
+	case strings.HasSuffix(plural, "s"):
+		plural += "es"
+	default:
+		plural += "s"
+	}
+	return plural + "." + gvk.Group
+}
+
+// servedVersions returns the versions served by the CRD.
+func servedVersions(crd *unstructured.Unstructured) []string {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	var served []string
+	for _, version := range versions {
+		version, ok := version.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if isServed, _, _ := unstructured.NestedBool(version, "served"); !isServed {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(version, "name"); name != "" {
+			served = append(served, name)
+		}
+	}
+	return served
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
+
+func newPreflightCommand() *cobra.Command {
+	pr := &PreflightRunner{}
+
+	// preflightCmd represents the preflight command. It checks the cluster
+	// the generated resources are applied to.
+	var cmd = &cobra.Command{
+		Use:   "preflight",
+		Short: "Checks the cluster for the Gateway API CRDs and the GatewayClass the generated resources require.",
+		RunE:  pr.CheckPrerequisites,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			for _, kind := range pr.requiredKinds {
+				if !slices.ContainsFunc(preflightKinds, func(gvk schema.GroupVersionKind) bool { return gvk.Kind == kind }) {
+					return fmt.Errorf("unsupported kind %s in --required-kinds", kind)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pr.gatewayClass, "gateway-class", "",
+		`If present, the GatewayClass the generated Gateways use, which must exist and be accepted by its controller.`)
+
+	cmd.Flags().StringSliceVar(&pr.requiredKinds, "required-kinds", []string{},
+		`The kinds whose CRDs are required in addition to GatewayClass, Gateway and HTTPRoute, e.g. BackendTLSPolicy,TCPRoute when the conversion generates them.`)
+
+	return cmd
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_preflightCheck(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := gatewayv1.Install(scheme); err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+	scheme.AddKnownTypeWithName(crdGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(crdGVK.GroupVersion().WithKind("CustomResourceDefinitionList"), &unstructured.UnstructuredList{})
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		testCRD("gatewayclasses.gateway.networking.k8s.io", "standard", "v1"),
+		testCRD("gateways.gateway.networking.k8s.io", "standard", "v1"),
+		testCRD("httproutes.gateway.networking.k8s.io", "standard", "v1"),
+		// Installed from an older bundle, v1alpha3 is not served.
+		testCRD("backendtlspolicies.gateway.networking.k8s.io", "experimental", "v1alpha2"),
+		&gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+			Spec:       gatewayv1.GatewayClassSpec{ControllerName: "gateway.nginx.org/nginx-gateway-controller"},
+			Status: gatewayv1.GatewayClassStatus{Conditions: []metav1.Condition{{
+				Type:   string(gatewayv1.GatewayClassConditionStatusAccepted),
+				Status: metav1.ConditionTrue,
+			}}},
+		},
+	).Build()
+
+	pr := &PreflightRunner{gatewayClass: "nginx", requiredKinds: []string{"BackendTLSPolicy", "TCPRoute"}}
+	checks, err := pr.check(context.Background(), cl)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out := &bytes.Buffer{}
+	err = printChecklist(checks, out)
+	if err == nil || err.Error() != "2 prerequisites missing" {
+		t.Errorf("Expected 2 prerequisites missing, got %v", err)
+	}
+
+	want := []string{
+		"[ok]       CRD gatewayclasses.gateway.networking.k8s.io (bundle v1.1.0, standard channel)",
+		"[ok]       CRD gateways.gateway.networking.k8s.io (bundle v1.1.0, standard channel)",
+		"[ok]       CRD httproutes.gateway.networking.k8s.io (bundle v1.1.0, standard channel)",
+		"[optional] CRD grpcroutes.gateway.networking.k8s.io: not installed",
+		"[optional] CRD tlsroutes.gateway.networking.k8s.io: not installed",
+		"[missing]  CRD tcproutes.gateway.networking.k8s.io: not installed",
+		"[optional] CRD udproutes.gateway.networking.k8s.io: not installed",
+		"[missing]  CRD backendtlspolicies.gateway.networking.k8s.io (bundle v1.1.0, experimental channel): version v1alpha3 not served, served versions are [v1alpha2]",
+		"[optional] CRD referencegrants.gateway.networking.k8s.io: not installed",
+		"[ok]       GatewayClass nginx (controller gateway.nginx.org/nginx-gateway-controller): accepted",
+	}
+	if got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected checklist:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func Test_checkGatewayClassNotAccepted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := gatewayv1.Install(scheme); err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+		Spec:       gatewayv1.GatewayClassSpec{ControllerName: "example.com/controller"},
+		Status: gatewayv1.GatewayClassStatus{Conditions: []metav1.Condition{{
+			Type:    string(gatewayv1.GatewayClassConditionStatusAccepted),
+			Status:  metav1.ConditionFalse,
+			Message: "unsupported version",
+		}}},
+	}).Build()
+
+	testCases := []struct {
+		name         string
+		gatewayClass string
+		crdInstalled bool
+		want         string
+	}{{
+		name:         "not accepted",
+		gatewayClass: "nginx",
+		crdInstalled: true,
+		want:         "[missing]  GatewayClass nginx (controller example.com/controller): not accepted: unsupported version",
+	}, {
+		name:         "not found",
+		gatewayClass: "istio",
+		crdInstalled: true,
+		want:         "[missing]  GatewayClass istio: not found",
+	}, {
+		name:         "CRD not installed",
+		gatewayClass: "nginx",
+		want:         "[missing]  GatewayClass nginx: the GatewayClass CRD is not installed",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			check, err := checkGatewayClass(context.Background(), cl, tc.gatewayClass, tc.crdInstalled)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if check.String() != tc.want {
+				t.Errorf("Expected %q, got %q", tc.want, check.String())
+			}
+		})
+	}
+}
+
+func Test_crdName(t *testing.T) {
+	testCases := map[string]string{
+		"Gateway":          "gateways.gateway.networking.k8s.io",
+		"GatewayClass":     "gatewayclasses.gateway.networking.k8s.io",
+		"HTTPRoute":        "httproutes.gateway.networking.k8s.io",
+		"BackendTLSPolicy": "backendtlspolicies.gateway.networking.k8s.io",
+	}
+	for kind, want := range testCases {
+		if got := crdName(schema.GroupVersionKind{Group: gatewayv1.GroupName, Version: "v1", Kind: kind}); got != want {
+			t.Errorf("crdName(%s) = %s, want %s", kind, got, want)
+		}
+	}
+}
+
+func testCRD(name, channel string, versions ...string) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	crd.SetName(name)
+	crd.SetAnnotations(map[string]string{
+		gatewayAPIBundleVersionAnnotation: "v1.1.0",
+		gatewayAPIChannelAnnotation:       channel,
+	})
+	var specVersions []interface{}
+	for _, version := range versions {
+		specVersions = append(specVersions, map[string]interface{}{"name": version, "served": true})
+	}
+	_ = unstructured.SetNestedSlice(crd.Object, specVersions, "spec", "versions")
+	return crd
+}
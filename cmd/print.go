@@ -17,15 +17,20 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"slices"
 	"strings"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -40,9 +45,6 @@ import (
 	_ "github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/kong"
 	_ "github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx"
 	_ "github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/openapi3"
-
-	// Call init for notifications
-	_ "github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 )
 
 type PrintRunner struct {
@@ -53,6 +55,31 @@ type PrintRunner struct {
 	// The path to the input yaml config file. Value assigned via --input-file flag
 	inputFile string
 
+	// The path to the overrides file. Value assigned via --overrides-file flag
+	overridesFile string
+
+	// attachToGateways lists the pre-existing Gateways, as
+	// <namespace>/<name>[:<listener>], the generated routes attach to instead
+	// of generated Gateways. Value assigned via --attach-to-gateway flag
+	attachToGateways []string
+
+	// gatewayNameTemplate is the Go template naming the generated Gateways.
+	// Value assigned via --gateway-name-template flag
+	gatewayNameTemplate string
+
+	// routeNameTemplate is the Go template naming the generated routes. Value
+	// assigned via --route-name-template flag
+	routeNameTemplate string
+
+	// routesOnly indicates whether only the routes and policies are generated,
+	// attached to the Gateways of --attach-to-gateway or of the overrides file.
+	// Value assigned via --routes-only flag
+	routesOnly bool
+
+	// tlsPlaceholderMode selects the placeholder generated for missing TLS
+	// secrets. Value assigned via --tls-placeholders flag
+	tlsPlaceholderMode string
+
 	// The namespace used to query Gateway API objects. Value assigned via
 	// --namespace/-n flag.
 	// On absence, the current user active namespace is used.
@@ -73,8 +100,87 @@ type PrintRunner struct {
 
 	// Provider specific flags --<provider>-<flag>.
 	providerSpecificFlags map[string]*string
+
+	// provenanceComments indicates whether YAML comments naming the source
+	// resources are printed above each Gateway and HTTPRoute. Value assigned
+	// via --provenance-comments flag.
+	provenanceComments bool
+
+	// sourceRetention indicates whether the provenance comments also print the
+	// digest, or the digest and the manifest, of the source resources. Value
+	// assigned via --source-retention flag.
+	sourceRetention string
+
+	// The path the request fixtures derived from the generated HTTPRoutes are
+	// written to. Value assigned via --fixtures-file flag
+	fixturesFile string
+
+	// The built-in conformance profile, or the path to the profile file, of the
+	// Gateway API implementation targeted by the conversion. Value assigned via
+	// --conformance-profile flag
+	conformanceProfileName string
+	conformanceProfile     *i2gw.ConformanceProfile
+
+	// explain is the source resource, formatted as <kind>/<namespace>/<name>,
+	// whose conversion trace is printed instead of the generated resources.
+	// Value assigned via --explain flag
+	explain string
+
+	// apply indicates whether the generated resources are created in the
+	// cluster instead of printed. Value assigned via --apply flag
+	apply bool
+
+	// rollback indicates whether the resources created by previous --apply
+	// runs are deleted instead of converting. Value assigned via --rollback
+	// flag
+	rollback bool
+
+	// interactive indicates whether the provider-specific flags not set on the
+	// command line are prompted for. Value assigned via --interactive flag
+	interactive bool
+
+	// The path to the file the answers to the prompts are recorded in, and
+	// read from by subsequent runs. Value assigned via --decisions-file flag
+	decisionsFile string
+
+	// The path to the file configuring the providers. Value assigned via
+	// --config flag
+	configFile string
+
+	// The path the inventory of the source objects read by the providers is
+	// written to. Value assigned via --inventory-file flag
+	inventoryFile string
+
+	// The path the topology graph of the generated resources is written to.
+	// Value assigned via --graph-file flag
+	graphFile string
+
+	// graphFormat is the format of the topology graph, either dot or json.
+	// Value assigned via --graph-format flag
+	graphFormat string
+
+	// contexts are the kubeconfig contexts whose clusters are converted one
+	// after the other. Value assigned via --contexts flag
+	contexts []string
+
+	// The directory the generated resources are written to, a directory per
+	// namespace and a file per kind, instead of printing them. With --contexts,
+	// the directory of each context and the combined report are written to it.
+	// Value assigned via --output-dir flag
+	outputDir string
+
+	// strict is the least severe notification type failing the conversion,
+	// none when empty. Value assigned via --strict flag
+	strict         string
+	strictSeverity notifications.MessageType
+
+	// The directory the CPU and heap profiles of the run are written to. Value
+	// assigned via --profile flag
+	profile string
 }
 
+const yamlSeparator = "---\n"
+
 // PrintGatewayAPIObjects performs necessary steps to digest and print
 // converted Gateway API objects. The steps include reading from the source,
 // construct ingresses and provider-specific resources, convert them, then print
@@ -89,7 +195,30 @@ func (pr *PrintRunner) PrintGatewayAPIObjects(cmd *cobra.Command, _ []string) er
 		return fmt.Errorf("failed to initialize namespace filter: %w", err)
 	}
 
-	gatewayResources, notificationTablesMap, err := i2gw.ToGatewayAPIResources(cmd.Context(), pr.namespaceFilter, pr.inputFile, pr.providers, pr.getProviderSpecificFlags())
+	if pr.rollback {
+		cl, err := newClusterClient()
+		if err != nil {
+			return err
+		}
+		return rollbackObjects(cmd.Context(), cl, pr.namespaceFilter, os.Stdout)
+	}
+
+	if err = applyProviderConfigFile(cmd, pr.providers, pr.configFile); err != nil {
+		return err
+	}
+	if err = resolveDecisions(cmd, pr.providers, pr.decisionsFile, pr.interactive, cmd.InOrStdin(), os.Stderr); err != nil {
+		return err
+	}
+
+	if len(pr.contexts) > 0 {
+		outputDir := pr.outputDir
+		if outputDir == "" {
+			outputDir = defaultContextsOutputDir
+		}
+		return pr.printContexts(cmd.Context(), pr.contexts, outputDir, pr.convertContext(), os.Stdout)
+	}
+
+	gatewayResources, notificationTablesMap, err := i2gw.ToGatewayAPIResources(cmd.Context(), pr.conversionOptions(pr.namespaceFilter, pr.inputFile))
 	if err != nil {
 		return err
 	}
@@ -97,52 +226,104 @@ func (pr *PrintRunner) PrintGatewayAPIObjects(cmd *cobra.Command, _ []string) er
 	for _, table := range notificationTablesMap {
 		fmt.Fprintln(os.Stderr, table)
 	}
+	if err = pr.checkStrict(notifications.NotificationAggr.CountAtLeast(pr.strictSeverity)); err != nil {
+		return err
+	}
 
-	pr.outputResult(gatewayResources)
+	if pr.apply {
+		objects, err := appliedObjects(gatewayResources)
+		if err != nil {
+			return err
+		}
+		cl, err := newClusterClient()
+		if err != nil {
+			return err
+		}
+		reportSkippedExtensions(gatewayResources, os.Stdout)
+		if err = applyObjects(cmd.Context(), cl, objects, os.Stdout); err != nil {
+			return err
+		}
+	} else if pr.explain != "" {
+		source, err := i2gw.ParseSourceReference(pr.explain)
+		if err != nil {
+			return err
+		}
+		if err = i2gw.Explain(os.Stdout, source, gatewayResources, notifications.NotificationAggr.Notifications); err != nil {
+			return err
+		}
+	} else if pr.outputDir != "" {
+		if err = pr.writeResourceTree(pr.outputDir, gatewayResources); err != nil {
+			return err
+		}
+	} else {
+		pr.outputResult(gatewayResources, os.Stdout)
+	}
 
+	if pr.inventoryFile != "" {
+		if err = i2gw.WriteInventory(pr.inventoryFile, i2gw.Inventory(gatewayResources)); err != nil {
+			return err
+		}
+	}
+	if pr.fixturesFile != "" {
+		if err = i2gw.WriteRequestFixtures(pr.fixturesFile, i2gw.GenerateRequestFixtures(gatewayResources)); err != nil {
+			return err
+		}
+	}
+	if pr.graphFile != "" {
+		return i2gw.WriteTopologyGraph(pr.graphFile, pr.graphFormat, i2gw.BuildTopologyGraph(gatewayResources))
+	}
 	return nil
 }
 
-func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
+// checkStrict returns an error when --strict is set and the conversion raised
+// notifications at least as severe as its notification type.
+func (pr *PrintRunner) checkStrict(count int) error {
+	if pr.strictSeverity == "" || count == 0 {
+		return nil
+	}
+	return fmt.Errorf("the conversion raised %d notifications of type %s or more severe, failing since --strict=%s is set", count, pr.strictSeverity, pr.strict)
+}
+
+func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources, w io.Writer) {
 	resourceCount := 0
 
 	for _, r := range gatewayResources {
 		resourceCount += len(r.GatewayClasses)
 		for _, gatewayClass := range r.GatewayClasses {
 			gatewayClass := gatewayClass
-			err := pr.resourcePrinter.PrintObj(&gatewayClass, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&gatewayClass, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s GatewayClass: %v\n", gatewayClass.Name, err)
+				fmt.Fprintf(w, "# Error printing %s GatewayClass: %v\n", gatewayClass.Name, err)
 			}
 		}
 	}
 
 	for _, r := range gatewayResources {
 		resourceCount += len(r.Gateways)
-		for _, gateway := range r.Gateways {
+		for key, gateway := range r.Gateways {
 			gateway := gateway
 			if gateway.Annotations == nil {
 				gateway.Annotations = make(map[string]string)
 			}
 			gateway.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&gateway, os.Stdout)
+			err := pr.printObjWithSources(&gateway, r.Sources, r.Sources.Gateways[key], w)
 			if err != nil {
-				fmt.Printf("# Error printing %s Gateway: %v\n", gateway.Name, err)
+				fmt.Fprintf(w, "# Error printing %s Gateway: %v\n", gateway.Name, err)
 			}
 		}
 	}
 
 	for _, r := range gatewayResources {
 		resourceCount += len(r.HTTPRoutes)
-		for _, httpRoute := range r.HTTPRoutes {
+		for key, httpRoute := range r.HTTPRoutes {
 			httpRoute := httpRoute
 			if httpRoute.Annotations == nil {
 				httpRoute.Annotations = make(map[string]string)
 			}
 			httpRoute.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&httpRoute, os.Stdout)
+			err := pr.printObjWithSources(&httpRoute, r.Sources, r.Sources.HTTPRoutes[key], w)
 			if err != nil {
-				fmt.Printf("# Error printing %s HTTPRoute: %v\n", httpRoute.Name, err)
+				fmt.Fprintf(w, "# Error printing %s HTTPRoute: %v\n", httpRoute.Name, err)
 			}
 		}
 	}
@@ -155,9 +336,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 				grpcRoute.Annotations = make(map[string]string)
 			}
 			grpcRoute.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&grpcRoute, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&grpcRoute, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s GRPCRoute: %v\n", grpcRoute.Name, err)
+				fmt.Fprintf(w, "# Error printing %s GRPCRoute: %v\n", grpcRoute.Name, err)
 			}
 		}
 	}
@@ -170,9 +351,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 				tlsRoute.Annotations = make(map[string]string)
 			}
 			tlsRoute.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&tlsRoute, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&tlsRoute, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s TLSRoute: %v\n", tlsRoute.Name, err)
+				fmt.Fprintf(w, "# Error printing %s TLSRoute: %v\n", tlsRoute.Name, err)
 			}
 		}
 	}
@@ -185,9 +366,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 				tcpRoute.Annotations = make(map[string]string)
 			}
 			tcpRoute.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&tcpRoute, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&tcpRoute, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s TCPRoute: %v\n", tcpRoute.Name, err)
+				fmt.Fprintf(w, "# Error printing %s TCPRoute: %v\n", tcpRoute.Name, err)
 			}
 		}
 	}
@@ -200,9 +381,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 				udpRoute.Annotations = make(map[string]string)
 			}
 			udpRoute.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&udpRoute, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&udpRoute, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s UDPRoute: %v\n", udpRoute.Name, err)
+				fmt.Fprintf(w, "# Error printing %s UDPRoute: %v\n", udpRoute.Name, err)
 			}
 		}
 	}
@@ -215,9 +396,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 				backendTLSPolicy.Annotations = make(map[string]string)
 			}
 			backendTLSPolicy.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&backendTLSPolicy, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&backendTLSPolicy, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s BackendTLSPolicy: %v\n", backendTLSPolicy.Name, err)
+				fmt.Fprintf(w, "# Error printing %s BackendTLSPolicy: %v\n", backendTLSPolicy.Name, err)
 			}
 		}
 	}
@@ -230,9 +411,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 				referenceGrant.Annotations = make(map[string]string)
 			}
 			referenceGrant.Annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
-			err := pr.resourcePrinter.PrintObj(&referenceGrant, os.Stdout)
+			err := pr.resourcePrinter.PrintObj(&referenceGrant, w)
 			if err != nil {
-				fmt.Printf("# Error printing %s ReferenceGrant: %v\n", referenceGrant.Name, err)
+				fmt.Fprintf(w, "# Error printing %s ReferenceGrant: %v\n", referenceGrant.Name, err)
 			}
 		}
 	}
@@ -241,9 +422,9 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 		resourceCount += len(r.GatewayExtensions)
 		for _, gatewayExtension := range r.GatewayExtensions {
 			gatewayExtension := gatewayExtension
-			fmt.Println("---")
-			if err := PrintUnstructuredAsYaml(&gatewayExtension); err != nil {
-				fmt.Printf("# Error printing %s gatewayExtension: %v\n", gatewayExtension.GetName(), err)
+			fmt.Fprintln(w, "---")
+			if err := printUnstructuredAsYaml(&gatewayExtension, w); err != nil {
+				fmt.Fprintf(w, "# Error printing %s gatewayExtension: %v\n", gatewayExtension.GetName(), err)
 			}
 		}
 	}
@@ -253,8 +434,36 @@ func (pr *PrintRunner) outputResult(gatewayResources []i2gw.GatewayResources) {
 		if pr.namespaceFilter != "" {
 			msg = fmt.Sprintf("%s in %s namespace", msg, pr.namespaceFilter)
 		}
-		fmt.Println(msg)
+		fmt.Fprintln(w, msg)
+	}
+}
+
+// printObjWithSources prints the object, preceded by a comment per source
+// resource when provenance comments are enabled. The comments are written
+// after the document separator so they stay attached to the object.
+func (pr *PrintRunner) printObjWithSources(obj runtime.Object, provenance i2gw.Provenance, sources []intermediate.SourceReference, w io.Writer) error {
+	if !pr.provenanceComments || len(sources) == 0 {
+		return pr.resourcePrinter.PrintObj(obj, w)
+	}
+
+	var buf bytes.Buffer
+	if err := pr.resourcePrinter.PrintObj(obj, &buf); err != nil {
+		return err
+	}
+	out := buf.String()
+	if strings.HasPrefix(out, yamlSeparator) {
+		fmt.Fprint(w, yamlSeparator)
+		out = strings.TrimPrefix(out, yamlSeparator)
+	}
+	retention := i2gw.SourceRetention(pr.sourceRetention)
+	if retention == "" {
+		retention = i2gw.SourceRetentionNone
+	}
+	for _, comment := range provenance.Comments(sources, retention) {
+		fmt.Fprintln(w, comment)
 	}
+	_, err := fmt.Fprint(w, out)
+	return err
 }
 
 // initializeResourcePrinter assign a specific type of printers.ResourcePrinter
@@ -313,13 +522,61 @@ func newPrintCommand() *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "print",
 		Short: "Prints Gateway API objects generated from ingress and provider-specific resources.",
-		RunE:  pr.PrintGatewayAPIObjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithProfile(pr.profile, func() error { return pr.PrintGatewayAPIObjects(cmd, args) })
+		},
 		PreRunE: func(_ *cobra.Command, _ []string) error {
+			// The rollback deletes the applied objects without converting.
+			if !pr.rollback && len(pr.providers) == 0 {
+				return fmt.Errorf(`required flag(s) "providers" not set`)
+			}
 			openAPIExist := slices.Contains(pr.providers, "openapi3")
 			if openAPIExist && len(pr.providers) != 1 {
 				return fmt.Errorf("openapi3 must be the only provider when specified")
 			}
-			return nil
+			if pr.provenanceComments && pr.outputFormat == "json" {
+				return fmt.Errorf("--provenance-comments is only supported with yaml output")
+			}
+			if !slices.Contains(i2gw.SourceRetentions, i2gw.SourceRetention(pr.sourceRetention)) {
+				return fmt.Errorf("%s is not a supported source retention, one of %v", pr.sourceRetention, i2gw.SourceRetentions)
+			}
+			if i2gw.SourceRetention(pr.sourceRetention) != i2gw.SourceRetentionNone && !pr.provenanceComments {
+				return fmt.Errorf("--source-retention requires --provenance-comments")
+			}
+			if pr.conformanceProfileName != "" {
+				profile, err := i2gw.LoadConformanceProfile(pr.conformanceProfileName)
+				if err != nil {
+					return err
+				}
+				pr.conformanceProfile = profile
+			}
+			if pr.strict != "" {
+				severity, err := notifications.ParseMessageType(pr.strict)
+				if err != nil {
+					return fmt.Errorf("invalid --strict: %w", err)
+				}
+				pr.strictSeverity = severity
+			}
+			if !slices.Contains(i2gw.GraphFormats, pr.graphFormat) {
+				return fmt.Errorf("%s is not a supported graph format, one of %v", pr.graphFormat, i2gw.GraphFormats)
+			}
+			if pr.routesOnly && len(pr.attachToGateways) == 0 && pr.overridesFile == "" {
+				return fmt.Errorf("--routes-only requires --attach-to-gateway or the gatewayAttachments of --overrides-file")
+			}
+			for _, gateway := range pr.attachToGateways {
+				if err := i2gw.ValidateGatewayReference(gateway); err != nil {
+					return fmt.Errorf("invalid --attach-to-gateway: %w", err)
+				}
+			}
+			if err := i2gw.ValidateNamingOptions(pr.namingOptions()); err != nil {
+				return err
+			}
+			if pr.explain != "" {
+				if _, err := i2gw.ParseSourceReference(pr.explain); err != nil {
+					return err
+				}
+			}
+			return i2gw.ValidateTLSPlaceholderMode(pr.tlsPlaceholderMode)
 		},
 	}
 
@@ -329,6 +586,63 @@ func newPrintCommand() *cobra.Command {
 	cmd.Flags().StringVar(&pr.inputFile, "input-file", "",
 		`Path to the manifest file. When set, the tool will read ingresses from the file instead of reading from the cluster. Supported files are yaml and json.`)
 
+	cmd.Flags().StringVar(&pr.overridesFile, "overrides-file", "",
+		`Path to a YAML file declaring per-source-resource overrides (gateway name, route name, extra hostnames, listener port) applied to the converted resources.`)
+
+	cmd.Flags().StringSliceVar(&pr.attachToGateways, "attach-to-gateway", []string{},
+		`If present, the pre-existing Gateway, as <namespace>/<name>[:<listener>], the converted routes attach to instead of generated Gateways, which are not generated. Can be repeated to attach the routes to several Gateways. The gatewayAttachments of the overrides file take precedence for their namespaces.`)
+
+	cmd.Flags().StringVar(&pr.gatewayNameTemplate, "gateway-name-template", "",
+		`If present, the Go template naming the generated Gateways, with the variables .Namespace, .Class and .Name, e.g. {{.Namespace}}-{{.Class}}. The parentRefs of the converted routes reference the renamed Gateways. The gatewayName of the overrides file is not templated.`)
+
+	cmd.Flags().StringVar(&pr.routeNameTemplate, "route-name-template", "",
+		`If present, the Go template naming the generated routes, with the variables .Namespace, .Kind, .Source, .Host and .Name, e.g. {{.Source}}-{{.Host}}-route. The routeName of the overrides file is not templated.`)
+
+	cmd.Flags().BoolVar(&pr.routesOnly, "routes-only", false,
+		`If present, generate only the routes, ReferenceGrants and policies, attached to the existing Gateways given by --attach-to-gateway or the gatewayAttachments of the overrides file. The routes of namespaces without Gateway, and the routes needing listeners those Gateways do not provide, are reported as errors.`)
+
+	cmd.Flags().StringVar(&pr.tlsPlaceholderMode, "tls-placeholders", "",
+		fmt.Sprintf(`If present, generate a clearly labeled placeholder for every TLS secret referenced by an HTTPS listener which cannot be found. One of: (%s, %s).`, i2gw.TLSPlaceholderSelfSigned, i2gw.TLSPlaceholderCertManager))
+
+	cmd.Flags().StringVar(&pr.conformanceProfileName, "conformance-profile", "",
+		fmt.Sprintf(`If present, the conformance profile of the targeted Gateway API implementation, one of %v or the path to a profile file. Resources of unsupported kinds are not generated, and HTTPRoutes using unsupported extended features are reported.`, i2gw.ConformanceProfileNames()))
+
+	cmd.Flags().StringVar(&pr.fixturesFile, "fixtures-file", "",
+		`If present, write to this path the HTTP requests, derived from the generated HTTPRoutes, used to smoke-test the new Gateway with the verify command.`)
+
+	cmd.Flags().StringVar(&pr.inventoryFile, "inventory-file", "",
+		`If present, write to this path a JSON inventory of the source objects read by the providers, with their group, version, kind, namespace, name, resourceVersion and conversion disposition.`)
+
+	cmd.Flags().StringVar(&pr.graphFile, "graph-file", "",
+		`If present, write to this path the topology graph of the generated resources: the Gateways, their listeners, the routes attached to each listener and the backends of the routes.`)
+
+	cmd.Flags().StringVar(&pr.graphFormat, "graph-format", i2gw.GraphFormatDOT,
+		fmt.Sprintf(`The format of the topology graph written to --graph-file, one of %v.`, i2gw.GraphFormats))
+
+	cmd.Flags().BoolVar(&pr.provenanceComments, "provenance-comments", false,
+		`If present, print YAML comments above each Gateway and HTTPRoute naming the source resources it was generated from.`)
+
+	cmd.Flags().StringVar(&pr.sourceRetention, "source-retention", string(i2gw.SourceRetentionNone),
+		fmt.Sprintf(`How much of the source resources the provenance comments retain, one of %v: their name only, their name and the sha256 digest of their manifest, or also the manifest itself as commented JSON.`, i2gw.SourceRetentions))
+
+	cmd.Flags().StringVar(&pr.explain, "explain", "",
+		`If present, print instead of the generated resources a trace of how the given source resource, formatted as <kind>/<namespace>/<name>, was converted: the generated routes, their parent listeners, matches, filters and backends, and the notifications raised for it.`)
+
+	cmd.Flags().BoolVar(&pr.apply, "apply", false,
+		`If present, create the generated GatewayClasses, Gateways, ReferenceGrants, routes and BackendTLSPolicies in the cluster, in this order, instead of printing them. They are labeled so that --rollback can delete them. Existing objects not created by --apply are left alone. When an object cannot be written, the objects created by the run are deleted.`)
+
+	cmd.Flags().BoolVar(&pr.rollback, "rollback", false,
+		`If present, delete the objects created by previous --apply runs in the namespace scope of the request, instead of converting.`)
+
+	cmd.Flags().BoolVar(&pr.interactive, "interactive", false,
+		`If present, prompt for the value of every provider-specific flag of the providers not set on the command line, e.g. the listener strategy or the default certificate, defaulting to the value of the decisions file or of the flag. The answers are recorded in --decisions-file.`)
+
+	cmd.Flags().StringVar(&pr.configFile, "config", "",
+		`Path to a YAML file with a section per provider setting its provider-specific flags, e.g. nginx.tlsListenerStrategy. Flags set on the command line take precedence.`)
+
+	cmd.Flags().StringVar(&pr.decisionsFile, "decisions-file", "",
+		`Path to the file the answers of --interactive are recorded in. Subsequent runs read the provider-specific flags not set on the command line from it.`)
+
 	cmd.Flags().StringVarP(&pr.namespace, "namespace", "n", "",
 		`If present, the namespace scope for this CLI request.`)
 
@@ -339,35 +653,117 @@ if specified with --namespace.`)
 	cmd.Flags().StringSliceVar(&pr.providers, "providers", []string{},
 		fmt.Sprintf("If present, the tool will try to convert only resources related to the specified providers, supported values are %v.", i2gw.GetSupportedProviders()))
 
-	pr.providerSpecificFlags = make(map[string]*string)
-	for provider, flags := range i2gw.GetProviderSpecificFlagDefinitions() {
-		for _, flag := range flags {
-			flagName := fmt.Sprintf("%s-%s", provider, flag.Name)
-			pr.providerSpecificFlags[flagName] = cmd.Flags().String(flagName, flag.DefaultValue, fmt.Sprintf("Provider-specific: %s. %s", provider, flag.Description))
-		}
-	}
+	cmd.Flags().StringSliceVar(&pr.contexts, "contexts", []string{},
+		`If present, convert the cluster of each of the given kubeconfig contexts, writing the generated resources and the notifications of each to its own directory under --output-dir, followed by a combined report.md. The namespace of each context is used unless --namespace or --all-namespaces is set.`)
+
+	cmd.Flags().StringVar(&pr.outputDir, "output-dir", "",
+		fmt.Sprintf(`If present, write the generated resources to this directory instead of printing them, a directory per namespace holding a file per kind, e.g. default/httproute.yaml, with an index.json listing them. With --contexts, defaults to %s.`, defaultContextsOutputDir))
+
+	cmd.Flags().StringVar(&pr.strict, "strict", "",
+		`If present, fail without printing, writing or applying the generated resources when the conversion raises notifications of the given type or more severe, one of blocker, error, warning or info. --strict alone fails on migration blockers only. With --contexts, every context is still converted and the command fails after writing the report.`)
+	cmd.Flags().Lookup("strict").NoOptDefVal = "blocker"
+
+	cmd.Flags().StringVar(&pr.profile, "profile", "",
+		fmt.Sprintf(`If present, write the CPU and heap profiles of the run to this directory, as %s and %s, to be inspected with go tool pprof.`, cpuProfileFile, heapProfileFile))
+
+	pr.providerSpecificFlags = registerProviderSpecificFlags(cmd)
 
-	_ = cmd.MarkFlagRequired("providers")
 	cmd.MarkFlagsMutuallyExclusive("namespace", "all-namespaces")
+	cmd.MarkFlagsMutuallyExclusive("apply", "rollback", "explain")
+	for _, flag := range []string{"input-file", "apply", "rollback", "explain", "fixtures-file", "inventory-file", "graph-file"} {
+		cmd.MarkFlagsMutuallyExclusive("contexts", flag)
+	}
+	cmd.MarkFlagsMutuallyExclusive("output-dir", "apply", "rollback", "explain")
 	return cmd
 }
 
 // getNamespaceInCurrentContext returns the namespace in the current active context of the user.
 func getNamespaceInCurrentContext() (string, error) {
+	return getNamespaceInContext("")
+}
+
+// getNamespaceInContext returns the namespace of the given kubeconfig context, or
+// of the current one when empty.
+func getNamespaceInContext(kubeContext string) (string, error) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 
-	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{})
+	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{CurrentContext: kubeContext})
 	currentNamespace, _, err := kubeConfig.Namespace()
 
 	return currentNamespace, err
 }
 
+// registerProviderSpecificFlags adds a --<provider>-<flag> flag to the command
+// for every provider specific flag.
+func registerProviderSpecificFlags(cmd *cobra.Command) map[string]*string {
+	providerSpecificFlags := make(map[string]*string)
+	for provider, flags := range i2gw.GetProviderSpecificFlagDefinitions() {
+		for _, flag := range flags {
+			flagName := fmt.Sprintf("%s-%s", provider, flag.Name)
+			providerSpecificFlags[flagName] = cmd.Flags().String(flagName, flag.DefaultValue, fmt.Sprintf("Provider-specific: %s. %s", provider, flag.Description))
+		}
+	}
+	return providerSpecificFlags
+}
+
+// applyProviderConfigFile sets the provider-specific flags of the providers
+// from the --config file, unless they are set on the command line. The flags
+// are not marked as changed, so that --interactive still prompts for them.
+func applyProviderConfigFile(cmd *cobra.Command, providers []string, configFile string) error {
+	if configFile == "" {
+		return nil
+	}
+	config, err := i2gw.ReadProviderConfigFile(configFile)
+	if err != nil {
+		return err
+	}
+	for _, provider := range providers {
+		for name, value := range config[provider] {
+			flagName := fmt.Sprintf("%s-%s", provider, name)
+			flag := cmd.Flags().Lookup(flagName)
+			if flag == nil || flag.Changed {
+				continue
+			}
+			if err = flag.Value.Set(value); err != nil {
+				return fmt.Errorf("invalid value %q for --%s in config file %s: %w", value, flagName, configFile, err)
+			}
+		}
+	}
+	return nil
+}
+
+// conversionOptions returns the options of the conversion of the resources of
+// the namespace, read from the input file or from the cluster when it is empty.
+func (pr *PrintRunner) conversionOptions(namespace, inputFile string) i2gw.ConversionOptions {
+	return i2gw.ConversionOptions{
+		Namespace:             namespace,
+		InputFile:             inputFile,
+		OverridesFile:         pr.overridesFile,
+		AttachToGateways:      pr.attachToGateways,
+		Naming:                pr.namingOptions(),
+		RoutesOnly:            pr.routesOnly,
+		TLSPlaceholderMode:    pr.tlsPlaceholderMode,
+		ConformanceProfile:    pr.conformanceProfile,
+		Providers:             pr.providers,
+		ProviderSpecificFlags: pr.getProviderSpecificFlags(),
+	}
+}
+
+// namingOptions returns the templates naming the generated resources.
+func (pr *PrintRunner) namingOptions() i2gw.NamingOptions {
+	return i2gw.NamingOptions{GatewayTemplate: pr.gatewayNameTemplate, RouteTemplate: pr.routeNameTemplate}
+}
+
 // getProviderSpecificFlags returns the provider specific flags input by the user.
 // The flags are returned in a map where the key is the provider name and the value is a map of flag name to flag value.
 func (pr *PrintRunner) getProviderSpecificFlags() map[string]map[string]string {
+	return providerSpecificFlagValues(pr.providers, pr.providerSpecificFlags)
+}
+
+func providerSpecificFlagValues(providers []string, flags map[string]*string) map[string]map[string]string {
 	providerSpecificFlags := make(map[string]map[string]string)
-	for flagName, value := range pr.providerSpecificFlags {
-		provider, found := lo.Find(pr.providers, func(p string) bool { return strings.HasPrefix(flagName, fmt.Sprintf("%s-", p)) })
+	for flagName, value := range flags {
+		provider, found := lo.Find(providers, func(p string) bool { return strings.HasPrefix(flagName, fmt.Sprintf("%s-", p)) })
 		if !found {
 			continue
 		}
@@ -381,6 +777,10 @@ func (pr *PrintRunner) getProviderSpecificFlags() map[string]map[string]string {
 }
 
 func PrintUnstructuredAsYaml(obj *unstructured.Unstructured) error {
+	return printUnstructuredAsYaml(obj, os.Stdout)
+}
+
+func printUnstructuredAsYaml(obj *unstructured.Unstructured, w io.Writer) error {
 	// Create a YAML serializer
 	serializer := json.NewSerializerWithOptions(json.DefaultMetaFactory, nil, nil,
 		json.SerializerOptions{
@@ -390,7 +790,7 @@ func PrintUnstructuredAsYaml(obj *unstructured.Unstructured) error {
 		})
 
 	// Encode the unstructured object to YAML
-	err := serializer.Encode(obj, os.Stdout)
+	err := serializer.Encode(obj, w)
 	if err != nil {
 		return err
 	}
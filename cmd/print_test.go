@@ -17,15 +17,21 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/printers"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 func Test_getResourcePrinter(t *testing.T) {
@@ -294,3 +300,52 @@ func Test_getProviderSpecificFlags(t *testing.T) {
 		})
 	}
 }
+
+func Test_printObjWithSources(t *testing.T) {
+	sources := []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "foo"}}
+	pr := PrintRunner{resourcePrinter: &printers.YAMLPrinter{}, provenanceComments: true}
+
+	var buf bytes.Buffer
+	for _, name := range []string{"first", "second"} {
+		route := &gatewayv1.HTTPRoute{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		}
+		if err := pr.printObjWithSources(route, i2gw.Provenance{}, sources, &buf); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	documents := strings.Split(buf.String(), "---\n")
+	if len(documents) != 2 {
+		t.Fatalf("Expected 2 documents, got %d:\n%s", len(documents), buf.String())
+	}
+	for _, document := range documents {
+		if !strings.HasPrefix(document, "# Generated from Ingress default/foo\n") {
+			t.Errorf("Expected document to start with the provenance comment, got:\n%s", document)
+		}
+	}
+}
+
+func Test_printProvidersRequirement(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          []string
+		expectedError bool
+	}{
+		{name: "providers", args: []string{"--providers=nginx"}},
+		{name: "no providers", args: []string{}, expectedError: true},
+		{name: "rollback without providers", args: []string{"--rollback"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newPrintCommand()
+			if err := cmd.ParseFlags(tc.args); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := cmd.PreRunE(cmd, nil); tc.expectedError != (err != nil) {
+				t.Errorf("Expected an error: %t, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
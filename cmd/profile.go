@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+)
+
+// The files of the profiles written to the --profile directory.
+const (
+	cpuProfileFile  = "cpu.pprof"
+	heapProfileFile = "heap.pprof"
+)
+
+// startProfiling starts the CPU profiling of the run to the profile directory,
+// which is created if needed. The returned function stops it and writes the
+// heap profile, to be inspected with go tool pprof.
+func startProfiling(dir string) (func() error, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the profile directory: %w", err)
+	}
+	cpuFile, err := os.Create(filepath.Join(dir, cpuProfileFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the CPU profile: %w", err)
+	}
+	if err = pprof.StartCPUProfile(cpuFile); err != nil {
+		cpuFile.Close()
+		return nil, fmt.Errorf("failed to start the CPU profiling: %w", err)
+	}
+
+	return func() error {
+		pprof.StopCPUProfile()
+		errs := []error{cpuFile.Close()}
+
+		heapFile, err := os.Create(filepath.Join(dir, heapProfileFile))
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("failed to create the heap profile: %w", err))...)
+		}
+		// The heap profile reports the allocations as of the last garbage collection.
+		runtime.GC()
+		if err = pprof.WriteHeapProfile(heapFile); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write the heap profile: %w", err))
+		}
+		return errors.Join(append(errs, heapFile.Close())...)
+	}, nil
+}
+
+// runWithProfile runs the command, profiled to the profile directory unless it
+// is empty.
+func runWithProfile(dir string, run func() error) error {
+	if dir == "" {
+		return run()
+	}
+	stop, err := startProfiling(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(run(), stop())
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_runWithProfile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+	runErr := errors.New("conversion failed")
+
+	err := runWithProfile(dir, func() error {
+		_ = make([]byte, 1<<20)
+		return runErr
+	})
+	if !errors.Is(err, runErr) {
+		t.Errorf("Expected the error of the run, got %v", err)
+	}
+	for _, file := range []string{cpuProfileFile, heapProfileFile} {
+		info, err := os.Stat(filepath.Join(dir, file))
+		if err != nil {
+			t.Errorf("Expected the profile %s: %v", file, err)
+			continue
+		}
+		if info.Size() == 0 {
+			t.Errorf("Expected the profile %s not to be empty", file)
+		}
+	}
+
+	ran := false
+	if err := runWithProfile("", func() error { ran = true; return nil }); err != nil || !ran {
+		t.Errorf("Expected the run without profiling, got ran=%v and error %v", ran, err)
+	}
+}
@@ -48,6 +48,12 @@ func getKubeconfig() {
 func Execute() {
 	rootCmd := newRootCmd()
 	rootCmd.AddCommand(newPrintCommand())
+	rootCmd.AddCommand(newVerifyCommand())
+	rootCmd.AddCommand(newSyncCommand())
+	rootCmd.AddCommand(newWebhookCommand())
+	rootCmd.AddCommand(newPreflightCommand())
+	rootCmd.AddCommand(newBatchCommand())
+	rootCmd.AddCommand(newAnalyzeCommand())
 	rootCmd.AddCommand(versionCmd)
 	err := rootCmd.Execute()
 	if err != nil {
@@ -0,0 +1,624 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// syncManagedByLabel marks the objects written by the sync command. Only
+	// objects carrying it are updated or deleted.
+	syncManagedByLabel = "app.kubernetes.io/managed-by"
+	syncManagedByValue = "ingress2gateway"
+
+	// syncSourceNamespaceAnnotation records the namespace the object was
+	// generated for before being moved to the target namespace.
+	syncSourceNamespaceAnnotation = "ingress2gateway.kubernetes.io/source-namespace"
+
+	// syncTargetNamespaceLabel marks the ReferenceGrants written to the source
+	// namespaces with the target namespace they allow references from.
+	syncTargetNamespaceLabel = "ingress2gateway.kubernetes.io/sync-target-namespace"
+)
+
+// The kinds of the generated resources written to the cluster.
+var (
+	gatewayKind          = gatewayv1.SchemeGroupVersion.WithKind("Gateway")
+	httpRouteKind        = gatewayv1.SchemeGroupVersion.WithKind("HTTPRoute")
+	grpcRouteKind        = gatewayv1.SchemeGroupVersion.WithKind("GRPCRoute")
+	tlsRouteKind         = gatewayv1alpha2.SchemeGroupVersion.WithKind("TLSRoute")
+	tcpRouteKind         = gatewayv1alpha2.SchemeGroupVersion.WithKind("TCPRoute")
+	udpRouteKind         = gatewayv1alpha2.SchemeGroupVersion.WithKind("UDPRoute")
+	backendTLSPolicyKind = gatewayv1alpha3.SchemeGroupVersion.WithKind("BackendTLSPolicy")
+	referenceGrantKind   = gatewayv1beta1.SchemeGroupVersion.WithKind("ReferenceGrant")
+)
+
+// syncedKinds are the kinds written to the target namespace, in the order they
+// are applied.
+var syncedKinds = []schema.GroupVersionKind{
+	gatewayKind,
+	httpRouteKind,
+	grpcRouteKind,
+	tlsRouteKind,
+	tcpRouteKind,
+	udpRouteKind,
+	backendTLSPolicyKind,
+	referenceGrantKind,
+}
+
+type SyncRunner struct {
+	// The namespace the generated resources are written to. Value assigned
+	// via --target-namespace flag
+	targetNamespace string
+
+	// The namespace the source resources are read from, all namespaces when
+	// empty. Value assigned via --namespace/-n flag
+	namespace string
+
+	// The path to the overrides file. Value assigned via --overrides-file flag
+	overridesFile string
+
+	// The time between two conversions. Value assigned via --interval flag
+	interval time.Duration
+
+	// once indicates whether the command exits after the first conversion,
+	// e.g. when run as a Job. Value assigned via --once flag
+	once bool
+
+	// providers indicates which providers are used to execute convert action.
+	providers []string
+
+	// Provider specific flags --<provider>-<flag>.
+	providerSpecificFlags map[string]*string
+
+	// The path to the file configuring the providers. Value assigned via
+	// --config flag
+	configFile string
+}
+
+// SyncGatewayAPIObjects converts the source resources of the cluster every
+// interval and writes the generated Gateway API resources to the target
+// namespace, until the command is interrupted.
+func (sr *SyncRunner) SyncGatewayAPIObjects(cmd *cobra.Command, _ []string) error {
+	if err := applyProviderConfigFile(cmd, sr.providers, sr.configFile); err != nil {
+		return err
+	}
+	cl, err := newClusterClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	for {
+		err = sr.syncOnce(ctx, cl, cmd.OutOrStdout())
+		if sr.once {
+			return err
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Sync failed: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(sr.interval):
+		}
+	}
+}
+
+// syncOnce runs a conversion and writes its result to the target namespace.
+func (sr *SyncRunner) syncOnce(ctx context.Context, cl client.Client, out io.Writer) error {
+	// The notifications of the previous conversions are not relevant anymore.
+	notifications.NotificationAggr.Reset()
+
+	gatewayResources, notificationTablesMap, err := i2gw.ToGatewayAPIResources(ctx, i2gw.ConversionOptions{
+		Namespace:             sr.namespace,
+		OverridesFile:         sr.overridesFile,
+		Providers:             sr.providers,
+		ProviderSpecificFlags: providerSpecificFlagValues(sr.providers, sr.providerSpecificFlags),
+	})
+	for _, table := range notificationTablesMap {
+		fmt.Fprintln(out, table)
+	}
+	if err != nil {
+		return err
+	}
+
+	desired, err := syncDesiredObjects(gatewayResources, sr.targetNamespace, out)
+	if err != nil {
+		return err
+	}
+	return syncObjects(ctx, cl, sr.targetNamespace, desired, out)
+}
+
+// syncDesiredObjects moves the generated resources of the synced kinds to the
+// target namespace and labels them as managed by the sync command. The moved
+// resources are prefixed with the namespace they were generated for, so that
+// resources of the same kind and name generated in different namespaces do not
+// collide, and the parentRefs to the moved Gateways follow them. The Services
+// and Secrets the moved resources reference stay in the source namespaces,
+// which get a ReferenceGrant allowing the references from the target namespace.
+// The ReferenceGrants generated by the providers stay in the namespace of the
+// objects they grant access to, and allow the references from the target
+// namespace instead of the source ones.
+func syncDesiredObjects(gatewayResources []i2gw.GatewayResources, targetNamespace string, out io.Writer) ([]unstructured.Unstructured, error) {
+	var generated, referenceGrants []client.Object
+	for _, r := range gatewayResources {
+		generated = append(generated, sortedObjects(r.Gateways)...)
+		generated = append(generated, sortedObjects(r.HTTPRoutes)...)
+		generated = append(generated, sortedObjects(r.GRPCRoutes)...)
+		generated = append(generated, sortedObjects(r.TLSRoutes)...)
+		generated = append(generated, sortedObjects(r.TCPRoutes)...)
+		generated = append(generated, sortedObjects(r.UDPRoutes)...)
+		generated = append(generated, sortedObjects(r.BackendTLSPolicies)...)
+		referenceGrants = append(referenceGrants, sortedObjects(r.ReferenceGrants)...)
+	}
+
+	sourceNamespaces := sets.New[string]()
+	gateways := sets.New[types.NamespacedName]()
+	for _, obj := range generated {
+		sourceNamespaces.Insert(obj.GetNamespace())
+		if _, ok := obj.(*gatewayv1.Gateway); ok {
+			gateways.Insert(client.ObjectKeyFromObject(obj))
+		}
+	}
+
+	var desired []unstructured.Unstructured
+	seen := map[string]string{}
+	references := map[string]map[string]sets.Set[string]{}
+	for _, obj := range generated {
+		u, err := syncedObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		sourceNamespace := u.GetNamespace()
+		annotations := u.GetAnnotations()
+		annotations[syncSourceNamespaceAnnotation] = sourceNamespace
+		u.SetAnnotations(annotations)
+
+		retargetParentRefs(u, sourceNamespace, targetNamespace, gateways)
+		if sourceNamespace != targetNamespace {
+			for _, kind := range pinSourceReferences(u, sourceNamespace) {
+				if references[sourceNamespace] == nil {
+					references[sourceNamespace] = map[string]sets.Set[string]{}
+				}
+				if references[sourceNamespace][kind] == nil {
+					references[sourceNamespace][kind] = sets.New[string]()
+				}
+				references[sourceNamespace][kind].Insert(u.GetKind())
+			}
+		}
+		u.SetName(syncedName(sourceNamespace, u.GetName(), targetNamespace))
+		u.SetNamespace(targetNamespace)
+
+		key := u.GetKind() + "/" + u.GetName()
+		if other, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%s %s/%s and the %s generated in namespace %s are both named %s in namespace %s", u.GetKind(), sourceNamespace, obj.GetName(), u.GetKind(), other, u.GetName(), targetNamespace)
+		}
+		seen[key] = sourceNamespace
+		desired = append(desired, *u)
+	}
+
+	for _, obj := range referenceGrants {
+		u, err := syncedObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		if !retargetReferenceGrant(u, sourceNamespaces, targetNamespace) {
+			fmt.Fprintf(out, "Skipping ReferenceGrant %s, it allows no references from the synced namespaces\n", client.ObjectKeyFromObject(obj))
+			continue
+		}
+		labels := u.GetLabels()
+		labels[syncTargetNamespaceLabel] = targetNamespace
+		u.SetLabels(labels)
+		desired = append(desired, *u)
+	}
+
+	for _, sourceNamespace := range sets.List(sets.KeySet(references)) {
+		u, err := i2gw.CastToUnstructured(syncReferenceGrant(sourceNamespace, targetNamespace, references[sourceNamespace]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the ReferenceGrant of namespace %s: %w", sourceNamespace, err)
+		}
+		unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+		desired = append(desired, *u)
+	}
+	return desired, nil
+}
+
+// syncedObject converts the generated object, annotated with the generator and
+// labeled as managed by the sync command.
+func syncedObject(obj client.Object) (*unstructured.Unstructured, error) {
+	u, err := i2gw.CastToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s: %w", client.ObjectKeyFromObject(obj), err)
+	}
+	u.SetGroupVersionKind(syncedKind(obj))
+
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[i2gw.GeneratorAnnotationKey] = fmt.Sprintf("ingress2gateway-%s", i2gw.Version)
+	u.SetAnnotations(annotations)
+
+	labels := u.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[syncManagedByLabel] = syncManagedByValue
+	u.SetLabels(labels)
+
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	return u, nil
+}
+
+// syncedName returns the name of an object generated in the source namespace
+// once moved to the target namespace.
+func syncedName(sourceNamespace, name, targetNamespace string) string {
+	if sourceNamespace == targetNamespace {
+		return name
+	}
+	return sourceNamespace + "-" + name
+}
+
+// retargetParentRefs points the parentRefs of a route to the moved Gateways to
+// their name in the target namespace. The parentRefs to other Gateways keep
+// resolving in the namespace they did.
+func retargetParentRefs(u *unstructured.Unstructured, sourceNamespace, targetNamespace string, gateways sets.Set[types.NamespacedName]) {
+	parentRefs, found, _ := unstructured.NestedSlice(u.Object, "spec", "parentRefs")
+	if !found {
+		return
+	}
+	for _, p := range parentRefs {
+		parentRef, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		group, hasGroup, _ := unstructured.NestedString(parentRef, "group")
+		kind, hasKind, _ := unstructured.NestedString(parentRef, "kind")
+		if (hasGroup && group != gatewayv1.GroupName) || (hasKind && kind != "Gateway") {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(parentRef, "name")
+		namespace, hasNamespace, _ := unstructured.NestedString(parentRef, "namespace")
+		if !hasNamespace {
+			namespace = sourceNamespace
+		}
+		if gateways.Has(types.NamespacedName{Namespace: namespace, Name: name}) {
+			parentRef["name"] = syncedName(namespace, name, targetNamespace)
+			delete(parentRef, "namespace")
+		} else if namespace != targetNamespace {
+			parentRef["namespace"] = namespace
+		}
+	}
+	_ = unstructured.SetNestedSlice(u.Object, parentRefs, "spec", "parentRefs")
+}
+
+// retargetReferenceGrant makes the ReferenceGrant allow the references from the
+// target namespace instead of the source namespaces, the synced objects being
+// moved there. It returns whether the ReferenceGrant allows any reference from
+// the target namespace.
+func retargetReferenceGrant(u *unstructured.Unstructured, sourceNamespaces sets.Set[string], targetNamespace string) bool {
+	from, _, _ := unstructured.NestedSlice(u.Object, "spec", "from")
+	var retargeted []interface{}
+	seen := sets.New[string]()
+	for _, f := range from {
+		entry, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace, _, _ := unstructured.NestedString(entry, "namespace")
+		if !sourceNamespaces.Has(namespace) {
+			continue
+		}
+		entry["namespace"] = targetNamespace
+		group, _, _ := unstructured.NestedString(entry, "group")
+		kind, _, _ := unstructured.NestedString(entry, "kind")
+		if seen.Has(group + "/" + kind) {
+			continue
+		}
+		seen.Insert(group + "/" + kind)
+		retargeted = append(retargeted, entry)
+	}
+	if len(retargeted) == 0 {
+		return false
+	}
+	_ = unstructured.SetNestedSlice(u.Object, retargeted, "spec", "from")
+	return true
+}
+
+// pinSourceReferences sets the namespace of the Service backendRefs of a route
+// and of the Secret certificateRefs of a Gateway without namespace, which
+// resolve in the namespace of the object, to the source namespace. It returns
+// the kinds of the pinned references.
+func pinSourceReferences(u *unstructured.Unstructured, sourceNamespace string) []string {
+	var kinds []string
+	pin := func(refs []interface{}, kind string) bool {
+		pinned := false
+		for _, r := range refs {
+			ref, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			group, _, _ := unstructured.NestedString(ref, "group")
+			refKind, found, _ := unstructured.NestedString(ref, "kind")
+			_, hasNamespace := ref["namespace"]
+			if group != "" || (found && refKind != kind) || hasNamespace {
+				continue
+			}
+			ref["namespace"] = sourceNamespace
+			pinned = true
+		}
+		if pinned && !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+		return pinned
+	}
+
+	switch u.GetKind() {
+	case "Gateway":
+		listeners, _, _ := unstructured.NestedSlice(u.Object, "spec", "listeners")
+		for _, l := range listeners {
+			listener, ok := l.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			certificateRefs, _, _ := unstructured.NestedSlice(listener, "tls", "certificateRefs")
+			if pin(certificateRefs, "Secret") {
+				_ = unstructured.SetNestedSlice(listener, certificateRefs, "tls", "certificateRefs")
+			}
+		}
+		if len(kinds) > 0 {
+			_ = unstructured.SetNestedSlice(u.Object, listeners, "spec", "listeners")
+		}
+	case "HTTPRoute", "GRPCRoute", "TLSRoute", "TCPRoute", "UDPRoute":
+		rules, _, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+			if pin(backendRefs, "Service") {
+				_ = unstructured.SetNestedSlice(rule, backendRefs, "backendRefs")
+			}
+		}
+		if len(kinds) > 0 {
+			_ = unstructured.SetNestedSlice(u.Object, rules, "spec", "rules")
+		}
+	}
+	return kinds
+}
+
+// syncReferenceGrant returns the ReferenceGrant of the source namespace allowing
+// the kinds of the target namespace to reference the kinds of the source one.
+// referencingKinds maps every referenced kind to the kinds referencing it.
+func syncReferenceGrant(sourceNamespace, targetNamespace string, referencingKinds map[string]sets.Set[string]) *gatewayv1beta1.ReferenceGrant {
+	from := sets.New[string]()
+	for _, kinds := range referencingKinds {
+		from = from.Union(kinds)
+	}
+	referenceGrant := &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: sourceNamespace,
+			Name:      fmt.Sprintf("ingress2gateway-sync-from-%s", targetNamespace),
+			Labels:    map[string]string{syncManagedByLabel: syncManagedByValue, syncTargetNamespaceLabel: targetNamespace},
+			Annotations: map[string]string{
+				i2gw.GeneratorAnnotationKey: fmt.Sprintf("ingress2gateway-%s", i2gw.Version),
+			},
+		},
+	}
+	for _, kind := range sets.List(from) {
+		referenceGrant.Spec.From = append(referenceGrant.Spec.From, gatewayv1beta1.ReferenceGrantFrom{
+			Group:     gatewayv1.GroupName,
+			Kind:      gatewayv1.Kind(kind),
+			Namespace: gatewayv1.Namespace(targetNamespace),
+		})
+	}
+	for _, kind := range sets.List(sets.KeySet(referencingKinds)) {
+		referenceGrant.Spec.To = append(referenceGrant.Spec.To, gatewayv1beta1.ReferenceGrantTo{Kind: gatewayv1.Kind(kind)})
+	}
+	referenceGrant.SetGroupVersionKind(referenceGrantKind)
+	return referenceGrant
+}
+
+// syncObjects creates or updates the desired objects, then deletes the objects
+// previously written by the sync command which are no longer generated: those
+// of the target namespace, and the ReferenceGrants of the source namespaces
+// allowing references from it. Objects not labeled as managed by the sync
+// command are never modified.
+func syncObjects(ctx context.Context, cl client.Client, targetNamespace string, desired []unstructured.Unstructured, out io.Writer) error {
+	desiredKeys := map[string]bool{}
+	for _, obj := range desired {
+		obj := obj
+		desiredKeys[obj.GetKind()+"/"+client.ObjectKeyFromObject(&obj).String()] = true
+
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		err := cl.Get(ctx, client.ObjectKeyFromObject(&obj), existing)
+		switch {
+		case apierrors.IsNotFound(err):
+			if err = cl.Create(ctx, &obj); err != nil {
+				return fmt.Errorf("failed to create %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(&obj), err)
+			}
+			fmt.Fprintf(out, "Created %s %s\n", obj.GetKind(), client.ObjectKeyFromObject(&obj))
+		case err != nil:
+			return fmt.Errorf("failed to get %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(&obj), err)
+		case existing.GetLabels()[syncManagedByLabel] != syncManagedByValue:
+			fmt.Fprintf(out, "Skipping %s %s, it exists and is not managed by ingress2gateway\n", obj.GetKind(), client.ObjectKeyFromObject(&obj))
+		default:
+			obj.SetResourceVersion(existing.GetResourceVersion())
+			if err = cl.Update(ctx, &obj); err != nil {
+				return fmt.Errorf("failed to update %s %s: %w", obj.GetKind(), client.ObjectKeyFromObject(&obj), err)
+			}
+		}
+	}
+
+	for _, gvk := range syncedKinds {
+		if err := pruneObjects(ctx, cl, gvk, desiredKeys, out, client.InNamespace(targetNamespace), client.MatchingLabels{syncManagedByLabel: syncManagedByValue}); err != nil {
+			return err
+		}
+	}
+	return pruneObjects(ctx, cl, referenceGrantKind, desiredKeys, out, client.MatchingLabels{syncManagedByLabel: syncManagedByValue, syncTargetNamespaceLabel: targetNamespace})
+}
+
+// pruneObjects deletes the objects of the kind matching the list options which
+// are not desired.
+func pruneObjects(ctx context.Context, cl client.Client, gvk schema.GroupVersionKind, desiredKeys map[string]bool, out io.Writer, opts ...client.ListOption) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	err := cl.List(ctx, list, opts...)
+	if meta.IsNoMatchError(err) {
+		// The CRD of the kind is not installed, so nothing was written.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list %s objects: %w", gvk.Kind, err)
+	}
+	for _, obj := range list.Items {
+		obj := obj
+		if desiredKeys[gvk.Kind+"/"+client.ObjectKeyFromObject(&obj).String()] {
+			continue
+		}
+		if err = cl.Delete(ctx, &obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(&obj), err)
+		}
+		fmt.Fprintf(out, "Deleted %s %s\n", gvk.Kind, client.ObjectKeyFromObject(&obj))
+	}
+	return nil
+}
+
+// syncedKind returns the kind of the generated object, whose TypeMeta may be
+// left empty by the providers.
+func syncedKind(obj client.Object) schema.GroupVersionKind {
+	switch obj.(type) {
+	case *gatewayv1.Gateway:
+		return gatewayKind
+	case *gatewayv1.HTTPRoute:
+		return httpRouteKind
+	case *gatewayv1.GRPCRoute:
+		return grpcRouteKind
+	case *gatewayv1alpha2.TLSRoute:
+		return tlsRouteKind
+	case *gatewayv1alpha2.TCPRoute:
+		return tcpRouteKind
+	case *gatewayv1alpha2.UDPRoute:
+		return udpRouteKind
+	case *gatewayv1alpha3.BackendTLSPolicy:
+		return backendTLSPolicyKind
+	case *gatewayv1beta1.ReferenceGrant:
+		return referenceGrantKind
+	}
+	return obj.GetObjectKind().GroupVersionKind()
+}
+
+// sortedObjects returns pointers to the objects of the map, sorted by
+// namespace and name.
+func sortedObjects[T any, PT interface {
+	*T
+	client.Object
+}](objects map[types.NamespacedName]T) []client.Object {
+	keys := make([]types.NamespacedName, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	sorted := make([]client.Object, 0, len(keys))
+	for _, key := range keys {
+		obj := objects[key]
+		sorted = append(sorted, PT(&obj))
+	}
+	return sorted
+}
+
+func newSyncCommand() *cobra.Command {
+	sr := &SyncRunner{}
+
+	// syncCmd represents the sync command. It keeps the Gateway API resources
+	// generated from the cluster up to date in a dry-run namespace.
+	var cmd = &cobra.Command{
+		Use:   "sync",
+		Short: "Continuously converts the cluster resources and writes the generated Gateway API objects to a dry-run namespace.",
+		RunE:  sr.SyncGatewayAPIObjects,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if slices.Contains(sr.providers, "openapi3") {
+				return fmt.Errorf("openapi3 reads its resources from a file and cannot be synced")
+			}
+			if !sr.once && sr.interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&sr.targetNamespace, "target-namespace", "",
+		`The dry-run namespace the generated resources are written to. Existing objects not labeled app.kubernetes.io/managed-by=ingress2gateway are never modified.`)
+
+	cmd.Flags().StringVarP(&sr.namespace, "namespace", "n", "",
+		`If present, only the resources of this namespace are converted. All namespaces are converted otherwise.`)
+
+	cmd.Flags().StringVar(&sr.overridesFile, "overrides-file", "",
+		`Path to a YAML file declaring per-source-resource overrides (gateway name, route name, extra hostnames, listener port) applied to the converted resources.`)
+
+	cmd.Flags().DurationVar(&sr.interval, "interval", time.Minute,
+		`The time between two conversions.`)
+
+	cmd.Flags().BoolVar(&sr.once, "once", false,
+		`If present, exit after the first conversion, e.g. when run as a Kubernetes Job.`)
+
+	cmd.Flags().StringSliceVar(&sr.providers, "providers", []string{},
+		fmt.Sprintf("The providers whose resources are converted, supported values are %v.", i2gw.GetSupportedProviders()))
+
+	cmd.Flags().StringVar(&sr.configFile, "config", "",
+		`Path to a YAML file with a section per provider setting its provider-specific flags. Flags set on the command line take precedence.`)
+
+	sr.providerSpecificFlags = registerProviderSpecificFlags(cmd)
+
+	_ = cmd.MarkFlagRequired("target-namespace")
+	_ = cmd.MarkFlagRequired("providers")
+	return cmd
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_syncObjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{gatewayv1.Install, gatewayv1alpha2.Install, gatewayv1alpha3.Install, gatewayv1beta1.Install} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatalf("Failed to build scheme: %v", err)
+		}
+	}
+
+	managedLabels := map[string]string{syncManagedByLabel: syncManagedByValue}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		// Written by a previous sync, no longer generated.
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "dry-run", Name: "stale", Labels: managedLabels}},
+		// Written by a previous sync, still generated.
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "dry-run", Name: "default-web", Labels: managedLabels}},
+		// Written by a previous sync for a namespace no longer generated.
+		&gatewayv1beta1.ReferenceGrant{ObjectMeta: metav1.ObjectMeta{Namespace: "stale", Name: "ingress2gateway-sync-from-dry-run", Labels: map[string]string{
+			syncManagedByLabel: syncManagedByValue, syncTargetNamespaceLabel: "dry-run",
+		}}},
+		// Created by a user, must not be modified.
+		&gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "dry-run", Name: "default-nginx"}},
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "dry-run", Name: "user"}},
+	).Build()
+
+	gatewayResources := []i2gw.GatewayResources{{
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			{Namespace: "default", Name: "nginx"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec: gatewayv1.GatewaySpec{GatewayClassName: "nginx", Listeners: []gatewayv1.Listener{{
+					Name:     "https",
+					Port:     443,
+					Protocol: gatewayv1.HTTPSProtocolType,
+					TLS:      &gatewayv1.GatewayTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{{Name: "web-tls"}}},
+				}}},
+			},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "default", Name: "web"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx"}, {Name: "external"}}},
+					Hostnames:       []gatewayv1.Hostname{"web.example.com"},
+					Rules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{{
+						BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "web", Port: ptr.To(gatewayv1.PortNumber(80))}},
+					}, {
+						BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "auth", Namespace: ptr.To(gatewayv1.Namespace("shared")), Port: ptr.To(gatewayv1.PortNumber(80))}},
+					}}}},
+				},
+			},
+			{Namespace: "other", Name: "web"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "web"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx", Namespace: ptr.To(gatewayv1.Namespace("default"))}}},
+					Hostnames:       []gatewayv1.Hostname{"other.example.com"},
+				},
+			},
+			{Namespace: "default", Name: "api"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"},
+			},
+		},
+		ReferenceGrants: map[types.NamespacedName]gatewayv1beta1.ReferenceGrant{
+			{Namespace: "shared", Name: "from-default"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "from-default"},
+				Spec: gatewayv1beta1.ReferenceGrantSpec{
+					From: []gatewayv1beta1.ReferenceGrantFrom{{Group: gatewayv1.GroupName, Kind: "HTTPRoute", Namespace: "default"}},
+					To:   []gatewayv1beta1.ReferenceGrantTo{{Kind: "Service"}},
+				},
+			},
+		},
+	}}
+
+	var out bytes.Buffer
+	desired, err := syncDesiredObjects(gatewayResources, "dry-run", &out)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(desired) != 6 {
+		t.Fatalf("Expected 6 desired objects, got %d", len(desired))
+	}
+
+	ctx := context.Background()
+	if err = syncObjects(ctx, cl, "dry-run", desired, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	gateway := &gatewayv1.Gateway{}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "default-nginx"}, gateway); err != nil {
+		t.Fatalf("Failed to get the user Gateway: %v", err)
+	}
+	if gateway.Spec.GatewayClassName != "" || gateway.Labels[syncManagedByLabel] != "" {
+		t.Errorf("Expected the user Gateway to be left untouched, got %+v", gateway)
+	}
+
+	web := &gatewayv1.HTTPRoute{}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "default-web"}, web); err != nil {
+		t.Fatalf("Failed to get the web HTTPRoute: %v", err)
+	}
+	if len(web.Spec.Hostnames) != 1 || web.Spec.Hostnames[0] != "web.example.com" {
+		t.Errorf("Expected the web HTTPRoute to be updated from the default namespace, got hostnames %v", web.Spec.Hostnames)
+	}
+	if web.Annotations[syncSourceNamespaceAnnotation] != "default" {
+		t.Errorf("Expected the source namespace annotation to be default, got %q", web.Annotations[syncSourceNamespaceAnnotation])
+	}
+	if backendRefs := web.Spec.Rules[0].BackendRefs; ptr.Deref(backendRefs[0].Namespace, "") != "default" || ptr.Deref(backendRefs[1].Namespace, "") != "shared" {
+		t.Errorf("Expected the Service backendRefs to resolve in the default and shared namespaces, got %+v", backendRefs)
+	}
+	expectedParentRefs := []gatewayv1.ParentReference{{Name: "default-nginx"}, {Name: "external", Namespace: ptr.To(gatewayv1.Namespace("default"))}}
+	if !reflect.DeepEqual(web.Spec.ParentRefs, expectedParentRefs) {
+		t.Errorf("Expected the parentRefs %+v, got %+v", expectedParentRefs, web.Spec.ParentRefs)
+	}
+
+	otherWeb := &gatewayv1.HTTPRoute{}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "other-web"}, otherWeb); err != nil {
+		t.Fatalf("Expected the web HTTPRoute of the other namespace to be created: %v", err)
+	}
+	if expectedParentRefs = []gatewayv1.ParentReference{{Name: "default-nginx"}}; !reflect.DeepEqual(otherWeb.Spec.ParentRefs, expectedParentRefs) {
+		t.Errorf("Expected the parentRefs %+v, got %+v", expectedParentRefs, otherWeb.Spec.ParentRefs)
+	}
+
+	providerGrant := &gatewayv1beta1.ReferenceGrant{}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "shared", Name: "from-default"}, providerGrant); err != nil {
+		t.Fatalf("Expected the generated ReferenceGrant to stay in the referenced namespace: %v", err)
+	}
+	if from := providerGrant.Spec.From; len(from) != 1 || from[0].Namespace != "dry-run" {
+		t.Errorf("Expected the generated ReferenceGrant to allow the references from the target namespace, got %+v", from)
+	}
+
+	referenceGrant := &gatewayv1beta1.ReferenceGrant{}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ingress2gateway-sync-from-dry-run"}, referenceGrant); err != nil {
+		t.Fatalf("Expected a ReferenceGrant allowing the references from the target namespace: %v", err)
+	}
+	expectedSpec := gatewayv1beta1.ReferenceGrantSpec{
+		From: []gatewayv1beta1.ReferenceGrantFrom{
+			{Group: gatewayv1.GroupName, Kind: "Gateway", Namespace: "dry-run"},
+			{Group: gatewayv1.GroupName, Kind: "HTTPRoute", Namespace: "dry-run"},
+		},
+		To: []gatewayv1beta1.ReferenceGrantTo{{Kind: "Secret"}, {Kind: "Service"}},
+	}
+	if !reflect.DeepEqual(referenceGrant.Spec, expectedSpec) {
+		t.Errorf("Expected the ReferenceGrant spec %+v, got %+v", expectedSpec, referenceGrant.Spec)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "stale", Name: "ingress2gateway-sync-from-dry-run"}, &gatewayv1beta1.ReferenceGrant{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the stale ReferenceGrant to be deleted, got %v", err)
+	}
+
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "default-api"}, &gatewayv1.HTTPRoute{}); err != nil {
+		t.Errorf("Expected the api HTTPRoute to be created: %v", err)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "user"}, &gatewayv1.HTTPRoute{}); err != nil {
+		t.Errorf("Expected the user HTTPRoute to be kept: %v", err)
+	}
+	if err = cl.Get(ctx, client.ObjectKey{Namespace: "dry-run", Name: "stale"}, &gatewayv1.HTTPRoute{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the stale HTTPRoute to be deleted, got %v", err)
+	}
+}
+
+func Test_syncDesiredObjectsNameCollision(t *testing.T) {
+	gatewayResources := []i2gw.GatewayResources{{
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "team-a", Name: "web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "web"}},
+			{Namespace: "team", Name: "a-web"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "a-web"}},
+		},
+	}}
+	var out bytes.Buffer
+	if _, err := syncDesiredObjects(gatewayResources, "dry-run", &out); err == nil {
+		t.Errorf("Expected an error for the HTTPRoutes both named team-a-web in the target namespace")
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/spf13/cobra"
+)
+
+type VerifyRunner struct {
+	// The path to the request fixtures generated by print --fixtures-file.
+	// Value assigned via --fixtures-file flag
+	fixturesFile string
+
+	// The address of the new Gateway, e.g. http://203.0.113.10. Value assigned
+	// via --address flag
+	address string
+
+	// The address of the NGINX Ingress Controller the responses of the Gateway
+	// are compared with. Value assigned via --compare-address flag
+	compareAddress string
+
+	// The response header identifying the backend which served the request.
+	// Value assigned via --backend-header flag
+	backendHeader string
+
+	// The timeout of every request. Value assigned via --timeout flag
+	timeout time.Duration
+}
+
+// response holds the parts of a response compared by the verify command.
+type response struct {
+	status   int
+	location string
+	backend  string
+}
+
+// VerifyGatewayBehavior replays the request fixtures against the Gateway and
+// reports the requests whose responses do not match the expectations, or differ
+// from the responses of the compare address.
+func (vr *VerifyRunner) VerifyGatewayBehavior(cmd *cobra.Command, _ []string) error {
+	fixtures, err := i2gw.ReadRequestFixtures(vr.fixturesFile)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Timeout: vr.timeout,
+		// Redirects are part of the verified behavior and must not be followed.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	failures := vr.verify(cmd.Context(), client, fixtures, cmd.OutOrStdout())
+	if failures > 0 {
+		return fmt.Errorf("%d of %d requests failed verification", failures, len(fixtures))
+	}
+	return nil
+}
+
+// verify replays every fixture, prints its result and returns the number of
+// failed fixtures.
+func (vr *VerifyRunner) verify(ctx context.Context, client *http.Client, fixtures []i2gw.RequestFixture, out io.Writer) int {
+	failures := 0
+	for _, fixture := range fixtures {
+		problems := vr.verifyFixture(ctx, client, fixture)
+		result := "PASS"
+		if len(problems) > 0 {
+			result = "FAIL"
+			failures++
+		}
+		fmt.Fprintf(out, "%s %s %s%s", result, fixture.Route, fixture.Host, fixture.Path)
+		if len(problems) > 0 {
+			fmt.Fprintf(out, ": %s", strings.Join(problems, "; "))
+		}
+		fmt.Fprintln(out)
+	}
+	return failures
+}
+
+// verifyFixture returns the problems found with the responses to the fixture.
+func (vr *VerifyRunner) verifyFixture(ctx context.Context, client *http.Client, fixture i2gw.RequestFixture) []string {
+	got, err := vr.send(ctx, client, vr.address, fixture)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	switch {
+	case fixture.RedirectStatus != 0 && got.status != fixture.RedirectStatus:
+		problems = append(problems, fmt.Sprintf("expected redirect status %d, got %d", fixture.RedirectStatus, got.status))
+	case fixture.RedirectStatus == 0 && (got.status == http.StatusNotFound || got.status >= http.StatusInternalServerError):
+		problems = append(problems, fmt.Sprintf("expected the request to reach %s, got status %d", strings.Join(fixture.Backends, " or "), got.status))
+	}
+
+	if vr.compareAddress == "" {
+		return problems
+	}
+	want, err := vr.send(ctx, client, vr.compareAddress, fixture)
+	if err != nil {
+		return append(problems, err.Error())
+	}
+	if got.status != want.status {
+		problems = append(problems, fmt.Sprintf("status %d differs from %d returned by %s", got.status, want.status, vr.compareAddress))
+	}
+	if got.location != want.location {
+		problems = append(problems, fmt.Sprintf("Location %q differs from %q returned by %s", got.location, want.location, vr.compareAddress))
+	}
+	if got.backend != want.backend {
+		problems = append(problems, fmt.Sprintf("backend %q differs from %q returned by %s", got.backend, want.backend, vr.compareAddress))
+	}
+	return problems
+}
+
+// send sends the fixture request to the address.
+func (vr *VerifyRunner) send(ctx context.Context, client *http.Client, address string, fixture i2gw.RequestFixture) (response, error) {
+	method := fixture.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(address, "/")+fixture.Path, nil)
+	if err != nil {
+		return response{}, fmt.Errorf("failed to create request to %s: %w", address, err)
+	}
+	if fixture.Host != "" {
+		req.Host = fixture.Host
+	}
+	for name, value := range fixture.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return response{}, fmt.Errorf("request to %s failed: %w", address, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	r := response{status: resp.StatusCode, location: resp.Header.Get("Location")}
+	if vr.backendHeader != "" {
+		r.backend = resp.Header.Get(vr.backendHeader)
+	}
+	return r, nil
+}
+
+func newVerifyCommand() *cobra.Command {
+	vr := &VerifyRunner{}
+
+	// verifyCmd represents the verify command. It replays the request fixtures
+	// generated by the print command against the new Gateway.
+	var cmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Replays the request fixtures generated by print against the new Gateway to validate behavioral parity.",
+		RunE:  vr.VerifyGatewayBehavior,
+	}
+
+	cmd.Flags().StringVar(&vr.fixturesFile, "fixtures-file", "",
+		`Path to the request fixtures generated with print --fixtures-file.`)
+
+	cmd.Flags().StringVar(&vr.address, "address", "",
+		`Base URL of the new Gateway, e.g. http://203.0.113.10. The Host header of every request is set from the fixture.`)
+
+	cmd.Flags().StringVar(&vr.compareAddress, "compare-address", "",
+		`If present, base URL of the NGINX Ingress Controller. The status code, Location header and backend of both responses are compared.`)
+
+	cmd.Flags().StringVar(&vr.backendHeader, "backend-header", "",
+		`If present, the response header identifying the backend which served the request, compared when --compare-address is set.`)
+
+	cmd.Flags().DurationVar(&vr.timeout, "timeout", 10*time.Second,
+		`The timeout of every request.`)
+
+	_ = cmd.MarkFlagRequired("fixtures-file")
+	_ = cmd.MarkFlagRequired("address")
+	return cmd
+}
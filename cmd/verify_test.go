@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+)
+
+// newTestGateway returns a server routing foo.example.com/api to the given
+// backend and redirecting /old.
+func newTestGateway(backend string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Host != "foo.example.com":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/old":
+			http.Redirect(w, r, "https://foo.example.com/new", http.StatusMovedPermanently)
+		case strings.HasPrefix(r.URL.Path, "/api"):
+			w.Header().Set("X-Backend", backend)
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func Test_verify(t *testing.T) {
+	gateway := newTestGateway("api")
+	defer gateway.Close()
+	sameController := newTestGateway("api")
+	defer sameController.Close()
+	otherController := newTestGateway("legacy")
+	defer otherController.Close()
+
+	testCases := []struct {
+		name             string
+		fixture          i2gw.RequestFixture
+		compareAddress   string
+		expectedFailures int
+	}{
+		{
+			name:    "request reaches a backend",
+			fixture: i2gw.RequestFixture{Route: "default/foo", Host: "foo.example.com", Path: "/api", Backends: []string{"default/api:80"}},
+		},
+		{
+			name:             "request matches no route",
+			fixture:          i2gw.RequestFixture{Route: "default/foo", Host: "bar.example.com", Path: "/api"},
+			expectedFailures: 1,
+		},
+		{
+			name:    "redirect status matches",
+			fixture: i2gw.RequestFixture{Route: "default/foo", Host: "foo.example.com", Path: "/old", RedirectStatus: 301},
+		},
+		{
+			name:             "redirect status differs",
+			fixture:          i2gw.RequestFixture{Route: "default/foo", Host: "foo.example.com", Path: "/old", RedirectStatus: 302},
+			expectedFailures: 1,
+		},
+		{
+			name:           "same backend as the compared controller",
+			fixture:        i2gw.RequestFixture{Route: "default/foo", Host: "foo.example.com", Path: "/api"},
+			compareAddress: sameController.URL,
+		},
+		{
+			name:             "backend differs from the compared controller",
+			fixture:          i2gw.RequestFixture{Route: "default/foo", Host: "foo.example.com", Path: "/api"},
+			compareAddress:   otherController.URL,
+			expectedFailures: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vr := VerifyRunner{address: gateway.URL, compareAddress: tc.compareAddress, backendHeader: "X-Backend"}
+			client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+			var out bytes.Buffer
+			failures := vr.verify(context.Background(), client, []i2gw.RequestFixture{tc.fixture}, &out)
+			if failures != tc.expectedFailures {
+				t.Errorf("Expected %d failures, got %d:\n%s", tc.expectedFailures, failures, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/spf13/cobra"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// maxAdmissionReviewBytes bounds the size of the admission review requests.
+const maxAdmissionReviewBytes = 3 << 20
+
+// webhookWarningPrefix starts every warning returned by the webhook, so users
+// can tell them from the warnings of other admission webhooks.
+const webhookWarningPrefix = "ingress2gateway: "
+
+type WebhookRunner struct {
+	// The address the webhook server listens on. Value assigned via --address
+	// flag
+	address string
+
+	// The certificate and key files used to serve TLS. Values assigned via
+	// --tls-cert-file and --tls-key-file flags
+	tlsCertFile string
+	tlsKeyFile  string
+
+	// providers indicates which providers are used to execute convert action.
+	providers []string
+
+	// Provider specific flags --<provider>-<flag>.
+	providerSpecificFlags map[string]*string
+
+	// mutex serializes the conversions, which share the notification aggregator.
+	mutex sync.Mutex
+}
+
+// ServeConversionPreview runs the admission webhook server until the command is
+// interrupted.
+func (wr *WebhookRunner) ServeConversionPreview(cmd *cobra.Command, _ []string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/preview", wr.handleAdmissionReview)
+
+	server := &http.Server{
+		Addr:              wr.address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Serving conversion previews on %s/preview\n", wr.address)
+	if err := server.ListenAndServeTLS(wr.tlsCertFile, wr.tlsKeyFile); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// handleAdmissionReview always admits the object, and returns the conversion
+// preview as admission warnings.
+func (wr *WebhookRunner) handleAdmissionReview(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAdmissionReviewBytes))
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, fmt.Sprintf("failed to read request: %v", err), status)
+		return
+	}
+	review := admissionv1.AdmissionReview{}
+	if err = json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "expected an admission.k8s.io/v1 AdmissionReview request", http.StatusBadRequest)
+		return
+	}
+
+	response := &admissionv1.AdmissionResponse{UID: review.Request.UID, Allowed: true}
+	if review.Request.Operation == admissionv1.Create || review.Request.Operation == admissionv1.Update {
+		warnings, err := wr.preview(r.Context(), review.Request.Namespace, review.Request.Object.Raw)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%sconversion failed: %v", webhookWarningPrefix, err))
+		}
+		response.Warnings = warnings
+	}
+
+	review.Response = response
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(review)
+}
+
+// preview converts the admitted object alone and returns the generated
+// resources and the notifications raised as warnings.
+func (wr *WebhookRunner) preview(ctx context.Context, namespace string, raw []byte) ([]string, error) {
+	object := &unstructured.Unstructured{}
+	if err := object.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode the admitted object: %w", err)
+	}
+	// The namespace of created objects may only be set in the request.
+	if object.GetNamespace() == "" {
+		object.SetNamespace(namespace)
+	}
+
+	wr.mutex.Lock()
+	defer wr.mutex.Unlock()
+	notifications.NotificationAggr.Reset()
+
+	gatewayResources, _, conversionErr := i2gw.ToGatewayAPIResources(ctx, i2gw.ConversionOptions{
+		InputObjects:          []*unstructured.Unstructured{object},
+		Providers:             wr.providers,
+		ProviderSpecificFlags: providerSpecificFlagValues(wr.providers, wr.providerSpecificFlags),
+	})
+
+	var warnings []string
+	if generated := generatedResourceNames(gatewayResources); len(generated) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%sconverts to %s", webhookWarningPrefix, strings.Join(generated, ", ")))
+	}
+	for _, provider := range sortedProviders(notifications.NotificationAggr.Notifications) {
+		for _, n := range notifications.NotificationAggr.Notifications[provider] {
+			if n.Type == notifications.InfoNotification {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("%s%s: %s", webhookWarningPrefix, n.Type, n.Message))
+		}
+	}
+	for _, findings := range notifications.NotificationAggr.SecurityFindings {
+		for _, f := range findings {
+			warnings = append(warnings, fmt.Sprintf("%s%s %s requires manual migration", webhookWarningPrefix, f.Feature, f.Reference))
+		}
+	}
+	return slices.Compact(warnings), conversionErr
+}
+
+// generatedResourceNames lists the generated Gateways and routes as
+// Kind namespace/name.
+func generatedResourceNames(gatewayResources []i2gw.GatewayResources) []string {
+	var names []string
+	for _, r := range gatewayResources {
+		names = append(names, kindNames("Gateway", r.Gateways)...)
+		names = append(names, kindNames("HTTPRoute", r.HTTPRoutes)...)
+		names = append(names, kindNames("GRPCRoute", r.GRPCRoutes)...)
+		names = append(names, kindNames("TLSRoute", r.TLSRoutes)...)
+		names = append(names, kindNames("TCPRoute", r.TCPRoutes)...)
+		names = append(names, kindNames("UDPRoute", r.UDPRoutes)...)
+		names = append(names, kindNames("BackendTLSPolicy", r.BackendTLSPolicies)...)
+	}
+	return names
+}
+
+func kindNames[T any](kind string, objects map[types.NamespacedName]T) []string {
+	names := make([]string, 0, len(objects))
+	for key := range objects {
+		names = append(names, fmt.Sprintf("%s %s", kind, key))
+	}
+	slices.Sort(names)
+	return names
+}
+
+func sortedProviders(m map[string][]notifications.Notification) []string {
+	providers := make([]string, 0, len(m))
+	for provider := range m {
+		providers = append(providers, provider)
+	}
+	slices.Sort(providers)
+	return providers
+}
+
+func newWebhookCommand() *cobra.Command {
+	wr := &WebhookRunner{}
+
+	// webhookCmd represents the webhook command. It serves an admission
+	// webhook previewing the conversion of the admitted resources.
+	var cmd = &cobra.Command{
+		Use:   "webhook",
+		Short: "Serves an admission webhook returning the conversion preview of created and updated resources as warnings.",
+		RunE:  wr.ServeConversionPreview,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if slices.Contains(wr.providers, "openapi3") {
+				return fmt.Errorf("openapi3 resources cannot be admitted")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&wr.address, "address", ":8443",
+		`The address the webhook server listens on.`)
+
+	cmd.Flags().StringVar(&wr.tlsCertFile, "tls-cert-file", "",
+		`Path to the certificate served by the webhook.`)
+
+	cmd.Flags().StringVar(&wr.tlsKeyFile, "tls-key-file", "",
+		`Path to the private key of the served certificate.`)
+
+	cmd.Flags().StringSliceVar(&wr.providers, "providers", []string{},
+		fmt.Sprintf("The providers whose resources are converted, supported values are %v.", i2gw.GetSupportedProviders()))
+
+	wr.providerSpecificFlags = registerProviderSpecificFlags(cmd)
+
+	_ = cmd.MarkFlagRequired("tls-cert-file")
+	_ = cmd.MarkFlagRequired("tls-key-file")
+	_ = cmd.MarkFlagRequired("providers")
+	return cmd
+}
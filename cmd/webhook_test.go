@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const admittedIngress = `{
+  "apiVersion": "networking.k8s.io/v1",
+  "kind": "Ingress",
+  "metadata": {
+    "name": "web",
+    "annotations": {"nginx.org/lb-method": "fastest"}
+  },
+  "spec": {
+    "ingressClassName": "nginx",
+    "rules": [{
+      "host": "web.example.com",
+      "http": {"paths": [{"path": "/", "pathType": "Prefix", "backend": {"service": {"name": "web", "port": {"number": 80}}}}]}
+    }]
+  }
+}`
+
+func Test_handleAdmissionReview(t *testing.T) {
+	wr := &WebhookRunner{providers: []string{"nginx"}, providerSpecificFlags: map[string]*string{}}
+
+	request := admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:       "7c6b6a1e",
+			Namespace: "default",
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: []byte(admittedIngress)},
+		},
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("Failed to marshal the AdmissionReview: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	wr.handleAdmissionReview(recorder, httptest.NewRequest(http.MethodPost, "/preview", bytes.NewReader(body)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	review := admissionv1.AdmissionReview{}
+	if err = json.Unmarshal(recorder.Body.Bytes(), &review); err != nil {
+		t.Fatalf("Failed to unmarshal the response: %v", err)
+	}
+	if review.Response == nil || review.Response.UID != "7c6b6a1e" || !review.Response.Allowed {
+		t.Fatalf("Expected the object to be allowed, got %+v", review.Response)
+	}
+
+	warnings := review.Response.Warnings
+	if !slices.ContainsFunc(warnings, func(w string) bool {
+		return strings.HasPrefix(w, webhookWarningPrefix+"converts to") && strings.Contains(w, "HTTPRoute default/web-web-example-com")
+	}) {
+		t.Errorf("Expected a warning listing the generated HTTPRoute, got %v", warnings)
+	}
+	if !slices.ContainsFunc(warnings, func(w string) bool {
+		return strings.HasPrefix(w, webhookWarningPrefix+"ERROR") && strings.Contains(w, "lb-method")
+	}) {
+		t.Errorf("Expected a warning for the invalid lb-method, got %v", warnings)
+	}
+}
+
+func Test_handleAdmissionReviewInvalidRequest(t *testing.T) {
+	wr := &WebhookRunner{providers: []string{"nginx"}}
+
+	recorder := httptest.NewRecorder()
+	wr.handleAdmissionReview(recorder, httptest.NewRequest(http.MethodPost, "/preview", strings.NewReader("{}")))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", recorder.Code)
+	}
+}
+
+func Test_handleAdmissionReviewTooLarge(t *testing.T) {
+	wr := &WebhookRunner{providers: []string{"nginx"}}
+
+	body := bytes.Repeat([]byte(" "), maxAdmissionReviewBytes+1)
+	recorder := httptest.NewRecorder()
+	wr.handleAdmissionReview(recorder, httptest.NewRequest(http.MethodPost, "/preview", bytes.NewReader(body)))
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status 413, got %d", recorder.Code)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+// AnnotationUsageReporter is implemented by the providers reporting the
+// annotations of the source objects they read and how much of each they
+// convert, for the feature coverage of the analysis.
+type AnnotationUsageReporter interface {
+	// AnnotationUsage returns the annotations of the provider used by the
+	// objects read by ReadResourcesFromCluster or ReadResourcesFromFile.
+	AnnotationUsage() []AnnotationUsage
+}
+
+// AnnotationSupportUnknown is the support of the annotations a provider does
+// not recognize.
+const AnnotationSupportUnknown = "unknown"
+
+// AnnotationUsage is an annotation of the provider used by the source objects.
+type AnnotationUsage struct {
+	// Provider is set by the conversion.
+	Provider   string `json:"provider"`
+	Annotation string `json:"annotation"`
+	// Support tells how much of the behavior of the annotation is converted,
+	// as named by the provider, or AnnotationSupportUnknown.
+	Support string `json:"support"`
+	// Objects is the number of source objects carrying the annotation.
+	Objects int `json:"objects"`
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// GatewayAttachment attaches the routes generated in a namespace, or in every
+// namespace, to a pre-existing Gateway instead of the generated ones.
+type GatewayAttachment struct {
+	// Namespace restricts the attachment to the routes of the namespace. The
+	// attachments without namespace apply to the namespaces which have no
+	// attachment of their own.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Gateway references the Gateway as <namespace>/<name>[:<listener>].
+	Gateway string `json:"gateway"`
+}
+
+// gatewayTarget is a parsed GatewayAttachment Gateway reference.
+type gatewayTarget struct {
+	types.NamespacedName
+	Listener string
+}
+
+// ValidateGatewayReference returns an error when the value is not a valid
+// <namespace>/<name>[:<listener>] Gateway reference.
+func ValidateGatewayReference(value string) error {
+	_, err := parseGatewayTarget(value)
+	return err
+}
+
+// parseGatewayTarget parses and validates a <namespace>/<name>[:<listener>]
+// Gateway reference.
+func parseGatewayTarget(value string) (gatewayTarget, error) {
+	var target gatewayTarget
+	ref, listener, hasListener := strings.Cut(value, ":")
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return target, fmt.Errorf("gateway %q must be <namespace>/<name>[:<listener>]", value)
+	}
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return target, fmt.Errorf("gateway %q has an invalid namespace: %s", value, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return target, fmt.Errorf("gateway %q has an invalid name: %s", value, strings.Join(errs, ", "))
+	}
+	if hasListener {
+		if errs := validation.IsDNS1123Subdomain(listener); len(errs) > 0 {
+			return target, fmt.Errorf("gateway %q has an invalid listener name: %s", value, strings.Join(errs, ", "))
+		}
+	}
+	target.NamespacedName = types.NamespacedName{Namespace: namespace, Name: name}
+	target.Listener = listener
+	return target, nil
+}
+
+// AttachToGateways replaces the parentRefs of the routes of the IR with the
+// Gateways of the attachments of their namespace, and removes the generated
+// Gateways no route references anymore. A route matching several attachments
+// gets a parentRef per Gateway.
+func AttachToGateways(ir *intermediate.IR, attachments []GatewayAttachment, providerName string) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+	targetsByNamespace, err := parseGatewayAttachments(attachments)
+	if err != nil {
+		return err
+	}
+
+	detached := sets.New[types.NamespacedName]()
+	crossNamespace := sets.New[string]()
+	attach := func(namespace string, parentRefs []gatewayv1.ParentReference) []gatewayv1.ParentReference {
+		targets, ok := attachmentTargets(targetsByNamespace, namespace)
+		if !ok {
+			return parentRefs
+		}
+		for _, parentRef := range parentRefs {
+			detached.Insert(ParentGatewayKey(namespace, parentRef))
+		}
+		attached := make([]gatewayv1.ParentReference, 0, len(targets))
+		for _, target := range targets {
+			parentRef := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(target.Name)}
+			if target.Namespace != namespace {
+				parentRef.Namespace = ptr.To(gatewayv1.Namespace(target.Namespace))
+				crossNamespace.Insert(fmt.Sprintf("The routes of namespace %s attach to Gateway %s, whose listeners must allow routes from namespace %s in allowedRoutes", namespace, target.NamespacedName, namespace))
+			}
+			if target.Listener != "" {
+				parentRef.SectionName = ptr.To(gatewayv1.SectionName(target.Listener))
+			}
+			attached = append(attached, parentRef)
+		}
+		return attached
+	}
+
+	_ = intermediate.WalkHTTPRoutes(ir, func(key types.NamespacedName, routeContext *intermediate.HTTPRouteContext) error {
+		routeContext.Spec.ParentRefs = attach(key.Namespace, routeContext.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkGRPCRoutes(ir, func(key types.NamespacedName, route *gatewayv1.GRPCRoute) error {
+		route.Spec.ParentRefs = attach(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkTLSRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.TLSRoute) error {
+		route.Spec.ParentRefs = attach(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkTCPRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.TCPRoute) error {
+		route.Spec.ParentRefs = attach(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkUDPRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.UDPRoute) error {
+		route.Spec.ParentRefs = attach(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+
+	referenced := referencedGateways(ir)
+	detachedKeys := detached.UnsortedList()
+	slices.SortFunc(detachedKeys, func(a, b types.NamespacedName) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+	for _, key := range detachedKeys {
+		if _, ok := ir.Gateways[key]; !ok || referenced.Has(key) {
+			continue
+		}
+		delete(ir.Gateways, key)
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.InfoNotification,
+			fmt.Sprintf("Gateway %s was not generated, its routes are attached to the pre-existing Gateways", key)), providerName)
+	}
+	for _, message := range sets.List(crossNamespace) {
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.InfoNotification, message), providerName)
+	}
+	return nil
+}
+
+// parseGatewayAttachments returns the Gateways of the attachments by
+// namespace, the attachments without namespace under the empty namespace.
+func parseGatewayAttachments(attachments []GatewayAttachment) (map[string][]gatewayTarget, error) {
+	targetsByNamespace := map[string][]gatewayTarget{}
+	for i, attachment := range attachments {
+		target, err := attachment.target()
+		if err != nil {
+			return nil, fmt.Errorf("gatewayAttachments[%d]: %w", i, err)
+		}
+		if !slices.Contains(targetsByNamespace[attachment.Namespace], target) {
+			targetsByNamespace[attachment.Namespace] = append(targetsByNamespace[attachment.Namespace], target)
+		}
+	}
+	return targetsByNamespace, nil
+}
+
+// attachmentTargets returns the Gateways the routes of the namespace attach
+// to, and false when no attachment applies to the namespace.
+func attachmentTargets(targetsByNamespace map[string][]gatewayTarget, namespace string) ([]gatewayTarget, bool) {
+	if targets, ok := targetsByNamespace[namespace]; ok {
+		return targets, true
+	}
+	targets, ok := targetsByNamespace[""]
+	return targets, ok
+}
+
+// target validates the attachment and returns its parsed Gateway reference.
+func (a GatewayAttachment) target() (gatewayTarget, error) {
+	if a.Namespace != "" {
+		if errs := validation.IsDNS1123Label(a.Namespace); len(errs) > 0 {
+			return gatewayTarget{}, fmt.Errorf("invalid namespace %q: %s", a.Namespace, strings.Join(errs, ", "))
+		}
+	}
+	return parseGatewayTarget(a.Gateway)
+}
+
+// referencedGateways returns the Gateways referenced by the parentRefs of the
+// routes of the IR.
+func referencedGateways(ir *intermediate.IR) sets.Set[types.NamespacedName] {
+	referenced := sets.New[types.NamespacedName]()
+	insert := func(namespace string, parentRefs []gatewayv1.ParentReference) {
+		for _, parentRef := range parentRefs {
+			referenced.Insert(ParentGatewayKey(namespace, parentRef))
+		}
+	}
+	for key, route := range ir.HTTPRoutes {
+		insert(key.Namespace, route.Spec.ParentRefs)
+	}
+	for key, route := range ir.GRPCRoutes {
+		insert(key.Namespace, route.Spec.ParentRefs)
+	}
+	for key, route := range ir.TLSRoutes {
+		insert(key.Namespace, route.Spec.ParentRefs)
+	}
+	for key, route := range ir.TCPRoutes {
+		insert(key.Namespace, route.Spec.ParentRefs)
+	}
+	for key, route := range ir.UDPRoutes {
+		insert(key.Namespace, route.Spec.ParentRefs)
+	}
+	return referenced
+}
+
+// ParentGatewayKey returns the key of the Gateway the parentRef of a route of
+// the given namespace references.
+func ParentGatewayKey(routeNamespace string, parentRef gatewayv1.ParentReference) types.NamespacedName {
+	key := types.NamespacedName{Namespace: routeNamespace, Name: string(parentRef.Name)}
+	if parentRef.Namespace != nil {
+		key.Namespace = string(*parentRef.Namespace)
+	}
+	return key
+}
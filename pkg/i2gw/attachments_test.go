@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestParseGatewayTarget(t *testing.T) {
+	testCases := []struct {
+		value         string
+		expected      gatewayTarget
+		expectedError bool
+	}{
+		{value: "infra/shared", expected: gatewayTarget{NamespacedName: types.NamespacedName{Namespace: "infra", Name: "shared"}}},
+		{value: "infra/shared:https", expected: gatewayTarget{NamespacedName: types.NamespacedName{Namespace: "infra", Name: "shared"}, Listener: "https"}},
+		{value: "shared", expectedError: true},
+		{value: "/shared", expectedError: true},
+		{value: "Infra/shared", expectedError: true},
+		{value: "infra/shared:", expectedError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			target, err := parseGatewayTarget(tc.value)
+			if tc.expectedError {
+				if err == nil {
+					t.Errorf("Expected an error, got %+v", target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if target != tc.expected {
+				t.Errorf("Expected %+v, got %+v", tc.expected, target)
+			}
+		})
+	}
+}
+
+func TestAttachToGateways(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	generatedRef := []gatewayv1.ParentReference{{Name: "nginx"}}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			{Namespace: "team-a", Name: "nginx"}: {},
+			{Namespace: "team-b", Name: "nginx"}: {},
+			{Namespace: "team-c", Name: "nginx"}: {},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "team-a", Name: "web"}: {HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "web"},
+				Spec:       gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef}},
+			}},
+			{Namespace: "team-b", Name: "web"}: {HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "web"},
+				Spec:       gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef}},
+			}},
+		},
+		TLSRoutes: map[types.NamespacedName]gatewayv1alpha2.TLSRoute{
+			{Namespace: "team-c", Name: "passthrough"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-c", Name: "passthrough"},
+				Spec:       gatewayv1alpha2.TLSRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef}},
+			},
+		},
+	}
+	attachments := []GatewayAttachment{
+		{Gateway: "infra/shared:https"},
+		{Gateway: "infra/internal"},
+		{Namespace: "team-b", Gateway: "team-b/own"},
+		{Namespace: "team-c", Gateway: "team-c/nginx"},
+	}
+
+	if err := AttachToGateways(&ir, attachments, "test"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedParentRefs := map[types.NamespacedName][]gatewayv1.ParentReference{
+		{Namespace: "team-a", Name: "web"}: {
+			{Namespace: ptr.To(gatewayv1.Namespace("infra")), Name: "shared", SectionName: ptr.To(gatewayv1.SectionName("https"))},
+			{Namespace: ptr.To(gatewayv1.Namespace("infra")), Name: "internal"},
+		},
+		{Namespace: "team-b", Name: "web"}: {{Name: "own"}},
+	}
+	for key, expected := range expectedParentRefs {
+		if diff := cmp.Diff(expected, ir.HTTPRoutes[key].Spec.ParentRefs); diff != "" {
+			t.Errorf("Unexpected parentRefs of HTTPRoute %s (-want +got):\n%s", key, diff)
+		}
+	}
+	if diff := cmp.Diff(generatedRef, ir.TLSRoutes[types.NamespacedName{Namespace: "team-c", Name: "passthrough"}].Spec.ParentRefs); diff != "" {
+		t.Errorf("Unexpected parentRefs of the TLSRoute (-want +got):\n%s", diff)
+	}
+
+	// The Gateway of team-c is still referenced by its attachment.
+	expectedGateways := []types.NamespacedName{{Namespace: "team-c", Name: "nginx"}}
+	var gateways []types.NamespacedName
+	for key := range ir.Gateways {
+		gateways = append(gateways, key)
+	}
+	if diff := cmp.Diff(expectedGateways, gateways); diff != "" {
+		t.Errorf("Unexpected Gateways (-want +got):\n%s", diff)
+	}
+
+	var messages []string
+	for _, n := range notifications.NotificationAggr.Notifications["test"] {
+		messages = append(messages, n.Message)
+	}
+	expectedMessages := []string{
+		"Gateway team-a/nginx was not generated, its routes are attached to the pre-existing Gateways",
+		"Gateway team-b/nginx was not generated, its routes are attached to the pre-existing Gateways",
+		"The routes of namespace team-a attach to Gateway infra/internal, whose listeners must allow routes from namespace team-a in allowedRoutes",
+		"The routes of namespace team-a attach to Gateway infra/shared, whose listeners must allow routes from namespace team-a in allowedRoutes",
+	}
+	if diff := cmp.Diff(expectedMessages, messages); diff != "" {
+		t.Errorf("Unexpected notifications (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+//go:embed conformance_profiles/*.yaml
+var conformanceProfileFiles embed.FS
+
+// Extended Gateway API features checked against the conformance profile. The
+// names are the ones of the Gateway API conformance suite, except
+// HTTPRouteRegularExpressionPathMatching as regular expression paths are
+// implementation-specific.
+const (
+	FeatureHTTPRouteQueryParamMatching            = "HTTPRouteQueryParamMatching"
+	FeatureHTTPRouteMethodMatching                = "HTTPRouteMethodMatching"
+	FeatureHTTPRouteRegularExpressionPathMatching = "HTTPRouteRegularExpressionPathMatching"
+	FeatureHTTPRouteResponseHeaderModification    = "HTTPRouteResponseHeaderModification"
+	FeatureHTTPRoutePathRewrite                   = "HTTPRoutePathRewrite"
+	FeatureHTTPRouteHostRewrite                   = "HTTPRouteHostRewrite"
+	FeatureHTTPRouteSchemeRedirect                = "HTTPRouteSchemeRedirect"
+	FeatureHTTPRoutePortRedirect                  = "HTTPRoutePortRedirect"
+	FeatureHTTPRoutePathRedirect                  = "HTTPRoutePathRedirect"
+	FeatureHTTPRouteRequestMirror                 = "HTTPRouteRequestMirror"
+)
+
+// ConformanceProfile lists the resource kinds and extended features supported
+// by the Gateway API implementation targeted by the conversion.
+type ConformanceProfile struct {
+	Name              string   `json:"name"`
+	SupportedKinds    []string `json:"supportedKinds"`
+	SupportedFeatures []string `json:"supportedFeatures"`
+}
+
+// ConformanceProfileNames returns the names of the built-in profiles.
+func ConformanceProfileNames() []string {
+	entries, _ := fs.ReadDir(conformanceProfileFiles, "conformance_profiles")
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+	return names
+}
+
+// LoadConformanceProfile returns the built-in profile with the given name, or
+// reads the profile from the file at the given path.
+func LoadConformanceProfile(nameOrPath string) (*ConformanceProfile, error) {
+	data, err := conformanceProfileFiles.ReadFile("conformance_profiles/" + nameOrPath + ".yaml")
+	if err != nil {
+		data, err = os.ReadFile(nameOrPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s is neither a built-in conformance profile, one of %v, nor a readable file: %w", nameOrPath, ConformanceProfileNames(), err)
+		}
+	}
+
+	profile := &ConformanceProfile{}
+	if err = kubeyaml.UnmarshalStrict(data, profile); err != nil {
+		return nil, fmt.Errorf("failed to parse conformance profile %s: %w", nameOrPath, err)
+	}
+	return profile, nil
+}
+
+// applyConformanceProfile removes the resources of kinds the profile does not
+// support, and reports the HTTPRoutes using extended features it does not
+// support, which the target implementation would reject or ignore.
+func applyConformanceProfile(gatewayResources *GatewayResources, profile *ConformanceProfile, providerName string) {
+	if profile == nil {
+		return
+	}
+	kinds := sets.New(profile.SupportedKinds...)
+	features := sets.New(profile.SupportedFeatures...)
+
+	dropUnsupportedKind(gatewayResources.GRPCRoutes, "GRPCRoute", kinds, profile.Name, providerName)
+	dropUnsupportedKind(gatewayResources.TLSRoutes, "TLSRoute", kinds, profile.Name, providerName)
+	dropUnsupportedKind(gatewayResources.TCPRoutes, "TCPRoute", kinds, profile.Name, providerName)
+	dropUnsupportedKind(gatewayResources.UDPRoutes, "UDPRoute", kinds, profile.Name, providerName)
+	dropUnsupportedKind(gatewayResources.BackendTLSPolicies, "BackendTLSPolicy", kinds, profile.Name, providerName)
+	dropUnsupportedKind(gatewayResources.ReferenceGrants, "ReferenceGrant", kinds, profile.Name, providerName)
+
+	for key, httpRoute := range gatewayResources.HTTPRoutes {
+		unsupported := sets.List(httpRouteFeatures(httpRoute).Difference(features))
+		if len(unsupported) == 0 {
+			continue
+		}
+		httpRoute := httpRoute
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification,
+			fmt.Sprintf("HTTPRoute %s uses features not supported by the %s conformance profile: %s", key, profile.Name, strings.Join(unsupported, ", ")),
+			&httpRoute), providerName)
+	}
+}
+
+// dropUnsupportedKind removes every resource of the map when the kind is not
+// supported by the profile.
+func dropUnsupportedKind[T any](resources map[types.NamespacedName]T, kind string, kinds sets.Set[string], profileName, providerName string) {
+	if len(resources) == 0 || kinds.Has(kind) {
+		return
+	}
+	keys := make([]string, 0, len(resources))
+	for key := range resources {
+		keys = append(keys, key.String())
+		delete(resources, key)
+	}
+	slices.Sort(keys)
+	notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification,
+		fmt.Sprintf("%s is not supported by the %s conformance profile, the following %ss were not generated: %s", kind, profileName, kind, strings.Join(keys, ", "))), providerName)
+}
+
+// httpRouteFeatures returns the extended features used by the HTTPRoute.
+func httpRouteFeatures(httpRoute gatewayv1.HTTPRoute) sets.Set[string] {
+	features := sets.New[string]()
+	for _, rule := range httpRoute.Spec.Rules {
+		for _, match := range rule.Matches {
+			if len(match.QueryParams) > 0 {
+				features.Insert(FeatureHTTPRouteQueryParamMatching)
+			}
+			if match.Method != nil {
+				features.Insert(FeatureHTTPRouteMethodMatching)
+			}
+			if match.Path != nil && match.Path.Type != nil && *match.Path.Type == gatewayv1.PathMatchRegularExpression {
+				features.Insert(FeatureHTTPRouteRegularExpressionPathMatching)
+			}
+		}
+		for _, filter := range rule.Filters {
+			switch {
+			case filter.ResponseHeaderModifier != nil:
+				features.Insert(FeatureHTTPRouteResponseHeaderModification)
+			case filter.RequestMirror != nil:
+				features.Insert(FeatureHTTPRouteRequestMirror)
+			case filter.URLRewrite != nil:
+				if filter.URLRewrite.Path != nil {
+					features.Insert(FeatureHTTPRoutePathRewrite)
+				}
+				if filter.URLRewrite.Hostname != nil {
+					features.Insert(FeatureHTTPRouteHostRewrite)
+				}
+			case filter.RequestRedirect != nil:
+				if filter.RequestRedirect.Scheme != nil {
+					features.Insert(FeatureHTTPRouteSchemeRedirect)
+				}
+				if filter.RequestRedirect.Port != nil {
+					features.Insert(FeatureHTTPRoutePortRedirect)
+				}
+				if filter.RequestRedirect.Path != nil {
+					features.Insert(FeatureHTTPRoutePathRedirect)
+				}
+			}
+		}
+	}
+	return features
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestLoadConformanceProfile(t *testing.T) {
+	for _, name := range ConformanceProfileNames() {
+		profile, err := LoadConformanceProfile(name)
+		if err != nil {
+			t.Fatalf("Failed to load built-in profile %s: %v", name, err)
+		}
+		if profile.Name != name {
+			t.Errorf("Expected built-in profile %s to be named after its file, got %s", name, profile.Name)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "custom.yaml")
+	if err := os.WriteFile(path, []byte("name: custom\nsupportedKinds: [Gateway, HTTPRoute]\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write profile: %v", err)
+	}
+	profile, err := LoadConformanceProfile(path)
+	if err != nil {
+		t.Fatalf("Failed to load profile file: %v", err)
+	}
+	if profile.Name != "custom" || len(profile.SupportedKinds) != 2 {
+		t.Errorf("Unexpected profile %+v", profile)
+	}
+
+	if _, err = LoadConformanceProfile("unknown"); err == nil {
+		t.Errorf("Expected an error for an unknown profile")
+	}
+}
+
+func TestApplyConformanceProfile(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	routeKey := types.NamespacedName{Namespace: "default", Name: "web"}
+	gatewayResources := GatewayResources{
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			routeKey: {Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path:        &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchRegularExpression), Value: ptr.To("/api/.*")},
+					QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "version", Value: "2"}},
+				}},
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "X-App", Value: "web"}}},
+				}},
+			}}}},
+		},
+		TLSRoutes: map[types.NamespacedName]gatewayv1alpha2.TLSRoute{
+			{Namespace: "default", Name: "passthrough"}: {},
+		},
+		GRPCRoutes: map[types.NamespacedName]gatewayv1.GRPCRoute{
+			{Namespace: "default", Name: "grpc"}: {},
+		},
+	}
+	profile := &ConformanceProfile{
+		Name:              "custom",
+		SupportedKinds:    []string{"Gateway", "HTTPRoute", "GRPCRoute"},
+		SupportedFeatures: []string{FeatureHTTPRouteQueryParamMatching},
+	}
+
+	applyConformanceProfile(&gatewayResources, profile, "test")
+
+	if len(gatewayResources.TLSRoutes) != 0 {
+		t.Errorf("Expected the unsupported TLSRoutes to be removed, got %v", gatewayResources.TLSRoutes)
+	}
+	if len(gatewayResources.GRPCRoutes) != 1 || len(gatewayResources.HTTPRoutes) != 1 {
+		t.Errorf("Expected the supported routes to be kept")
+	}
+
+	var messages []string
+	for _, n := range notifications.NotificationAggr.Notifications["test"] {
+		messages = append(messages, n.Message)
+	}
+	expected := []string{
+		"TLSRoute is not supported by the custom conformance profile, the following TLSRoutes were not generated: default/passthrough",
+		"HTTPRoute default/web uses features not supported by the custom conformance profile: HTTPRouteRegularExpressionPathMatching",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected notifications:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(messages, "\n"))
+	}
+}
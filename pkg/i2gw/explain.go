@@ -0,0 +1,306 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ParseSourceReference parses a source resource formatted as
+// <kind>/<namespace>/<name>, e.g. Ingress/default/web.
+func ParseSourceReference(value string) (intermediate.SourceReference, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		return intermediate.SourceReference{}, fmt.Errorf("invalid source %q, expected <kind>/<namespace>/<name>", value)
+	}
+	return intermediate.SourceReference{Kind: parts[0], Namespace: parts[1], Name: parts[2]}, nil
+}
+
+// Explain writes a trace of the conversion of the source resource: the
+// HTTPRoutes it was converted to, the Gateway listeners each route attaches to,
+// how the matches, filters and backends of every rule were built, and the
+// notifications raised for the source. It returns an error when nothing was
+// generated from the source.
+func Explain(w io.Writer, source intermediate.SourceReference, gatewayResources []GatewayResources, providerNotifications map[string][]notifications.Notification) error {
+	var routeCount int
+	fmt.Fprintf(w, "Explaining %s %s/%s\n", source.Kind, source.Namespace, source.Name)
+
+	for _, r := range gatewayResources {
+		for _, key := range sortedRouteKeys(r.Sources.HTTPRoutes) {
+			sources := r.Sources.HTTPRoutes[key]
+			if !slices.ContainsFunc(sources, func(s intermediate.SourceReference) bool { return sameSource(s, source) }) {
+				continue
+			}
+			httpRoute, ok := r.HTTPRoutes[key]
+			if !ok {
+				continue
+			}
+			routeCount++
+			explainHTTPRoute(w, source, key, httpRoute, sources, r.Gateways)
+		}
+	}
+
+	notificationCount := explainNotifications(w, source, providerNotifications)
+	if routeCount == 0 && notificationCount == 0 {
+		return fmt.Errorf("no resources were generated from %s %s/%s", source.Kind, source.Namespace, source.Name)
+	}
+	if routeCount == 0 {
+		fmt.Fprintln(w, "\nNo HTTPRoute was generated from the source.")
+	}
+	return nil
+}
+
+func explainHTTPRoute(w io.Writer, source intermediate.SourceReference, key types.NamespacedName, httpRoute gatewayv1.HTTPRoute, sources []intermediate.SourceReference, gateways map[types.NamespacedName]gatewayv1.Gateway) {
+	fmt.Fprintf(w, "\nHTTPRoute %s\n", key)
+	for _, s := range sources {
+		if !sameSource(s, source) {
+			fmt.Fprintf(w, "  merged with %s %s/%s\n", s.Kind, s.Namespace, s.Name)
+		}
+	}
+
+	hostnames := "any hostname"
+	if len(httpRoute.Spec.Hostnames) > 0 {
+		hostnames = joinHostnames(httpRoute.Spec.Hostnames)
+	}
+	fmt.Fprintf(w, "  hostnames: %s\n", hostnames)
+
+	fmt.Fprintln(w, "  parents:")
+	for _, parentRef := range httpRoute.Spec.ParentRefs {
+		fmt.Fprintf(w, "    %s\n", explainParentRef(key.Namespace, parentRef, httpRoute.Spec.Hostnames, gateways))
+	}
+
+	for i, rule := range httpRoute.Spec.Rules {
+		fmt.Fprintf(w, "  rule %d:\n", i+1)
+		if len(rule.Matches) == 0 {
+			fmt.Fprintln(w, "    match: every request")
+		}
+		for _, match := range rule.Matches {
+			fmt.Fprintf(w, "    match: %s\n", explainMatch(match))
+		}
+		for _, filter := range rule.Filters {
+			fmt.Fprintf(w, "    filter: %s\n", explainFilter(filter))
+		}
+		for _, backendRef := range rule.BackendRefs {
+			fmt.Fprintf(w, "    backend: %s\n", explainBackendRef(key.Namespace, backendRef))
+		}
+		if len(rule.BackendRefs) == 0 {
+			fmt.Fprintln(w, "    backend: none")
+		}
+	}
+}
+
+// explainParentRef describes the Gateway a route attaches to, together with the
+// listeners accepting the route's hostnames.
+func explainParentRef(namespace string, parentRef gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, gateways map[types.NamespacedName]gatewayv1.Gateway) string {
+	gatewayKey := types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}
+	if parentRef.Namespace != nil {
+		gatewayKey.Namespace = string(*parentRef.Namespace)
+	}
+	gateway, ok := gateways[gatewayKey]
+	if !ok {
+		return fmt.Sprintf("Gateway %s (not generated by this conversion)", gatewayKey)
+	}
+
+	var listeners []string
+	for _, listener := range gateway.Spec.Listeners {
+		if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if listener.Hostname != nil && len(hostnames) > 0 && !slices.ContainsFunc(hostnames, func(h gatewayv1.Hostname) bool {
+			return HostnamesIntersect(*listener.Hostname, h)
+		}) {
+			continue
+		}
+		listeners = append(listeners, fmt.Sprintf("%s (%s/%d)", listener.Name, listener.Protocol, listener.Port))
+	}
+	if len(listeners) == 0 {
+		return fmt.Sprintf("Gateway %s, no listener accepts the route", gatewayKey)
+	}
+	return fmt.Sprintf("Gateway %s, listeners %s", gatewayKey, strings.Join(listeners, ", "))
+}
+
+func explainMatch(match gatewayv1.HTTPRouteMatch) string {
+	var conditions []string
+	if match.Path != nil && match.Path.Value != nil {
+		pathType := gatewayv1.PathMatchPathPrefix
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		conditions = append(conditions, fmt.Sprintf("path %s %s", pathType, *match.Path.Value))
+	}
+	for _, header := range match.Headers {
+		conditions = append(conditions, fmt.Sprintf("header %s=%s", header.Name, header.Value))
+	}
+	for _, param := range match.QueryParams {
+		conditions = append(conditions, fmt.Sprintf("query %s=%s", param.Name, param.Value))
+	}
+	if match.Method != nil {
+		conditions = append(conditions, fmt.Sprintf("method %s", *match.Method))
+	}
+	if len(conditions) == 0 {
+		return "every request"
+	}
+	return strings.Join(conditions, ", ")
+}
+
+func explainFilter(filter gatewayv1.HTTPRouteFilter) string {
+	switch {
+	case filter.RequestHeaderModifier != nil:
+		return fmt.Sprintf("%s %s", filter.Type, explainHeaderFilter(filter.RequestHeaderModifier))
+	case filter.ResponseHeaderModifier != nil:
+		return fmt.Sprintf("%s %s", filter.Type, explainHeaderFilter(filter.ResponseHeaderModifier))
+	case filter.RequestRedirect != nil:
+		var parts []string
+		if filter.RequestRedirect.Scheme != nil {
+			parts = append(parts, "scheme "+*filter.RequestRedirect.Scheme)
+		}
+		if filter.RequestRedirect.Hostname != nil {
+			parts = append(parts, "hostname "+string(*filter.RequestRedirect.Hostname))
+		}
+		if filter.RequestRedirect.Port != nil {
+			parts = append(parts, fmt.Sprintf("port %d", *filter.RequestRedirect.Port))
+		}
+		if filter.RequestRedirect.Path != nil {
+			parts = append(parts, explainPathModifier(*filter.RequestRedirect.Path))
+		}
+		if filter.RequestRedirect.StatusCode != nil {
+			parts = append(parts, fmt.Sprintf("status %d", *filter.RequestRedirect.StatusCode))
+		}
+		return fmt.Sprintf("%s %s", filter.Type, strings.Join(parts, ", "))
+	case filter.URLRewrite != nil:
+		var parts []string
+		if filter.URLRewrite.Hostname != nil {
+			parts = append(parts, "hostname "+string(*filter.URLRewrite.Hostname))
+		}
+		if filter.URLRewrite.Path != nil {
+			parts = append(parts, explainPathModifier(*filter.URLRewrite.Path))
+		}
+		return fmt.Sprintf("%s %s", filter.Type, strings.Join(parts, ", "))
+	case filter.RequestMirror != nil:
+		return fmt.Sprintf("%s to %s", filter.Type, filter.RequestMirror.BackendRef.Name)
+	case filter.ExtensionRef != nil:
+		return fmt.Sprintf("%s %s %s", filter.Type, filter.ExtensionRef.Kind, filter.ExtensionRef.Name)
+	}
+	return string(filter.Type)
+}
+
+func explainHeaderFilter(filter *gatewayv1.HTTPHeaderFilter) string {
+	var parts []string
+	for _, header := range filter.Set {
+		parts = append(parts, fmt.Sprintf("set %s=%s", header.Name, header.Value))
+	}
+	for _, header := range filter.Add {
+		parts = append(parts, fmt.Sprintf("add %s=%s", header.Name, header.Value))
+	}
+	for _, name := range filter.Remove {
+		parts = append(parts, "remove "+name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func explainPathModifier(modifier gatewayv1.HTTPPathModifier) string {
+	switch {
+	case modifier.ReplaceFullPath != nil:
+		return "full path " + *modifier.ReplaceFullPath
+	case modifier.ReplacePrefixMatch != nil:
+		return "prefix " + *modifier.ReplacePrefixMatch
+	}
+	return string(modifier.Type)
+}
+
+func explainBackendRef(namespace string, backendRef gatewayv1.HTTPBackendRef) string {
+	kind := "Service"
+	if backendRef.Kind != nil {
+		kind = string(*backendRef.Kind)
+	}
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace)
+	}
+	backend := fmt.Sprintf("%s %s/%s", kind, namespace, backendRef.Name)
+	if backendRef.Port != nil {
+		backend = fmt.Sprintf("%s:%d", backend, *backendRef.Port)
+	}
+	if backendRef.Weight != nil {
+		backend = fmt.Sprintf("%s, weight %d", backend, *backendRef.Weight)
+	}
+	return backend
+}
+
+// explainNotifications writes the notifications raised for the source and
+// returns their count.
+func explainNotifications(w io.Writer, source intermediate.SourceReference, providerNotifications map[string][]notifications.Notification) int {
+	var lines []string
+	for _, provider := range sortedProviders(providerNotifications) {
+		for _, n := range providerNotifications[provider] {
+			if !slices.ContainsFunc(n.CallingObjects, func(o client.Object) bool {
+				kind := o.GetObjectKind().GroupVersionKind().Kind
+				return (kind == "" || strings.EqualFold(kind, source.Kind)) && o.GetNamespace() == source.Namespace && o.GetName() == source.Name
+			}) {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("  %s [%s] %s", n.Type, provider, n.Message))
+		}
+	}
+	if len(lines) > 0 {
+		fmt.Fprintln(w, "\nNotifications:")
+		fmt.Fprintln(w, strings.Join(lines, "\n"))
+	}
+	return len(lines)
+}
+
+// sameSource compares the sources, ignoring the case of the kind so that the
+// kind can be given in lower case on the command line.
+func sameSource(a, b intermediate.SourceReference) bool {
+	return strings.EqualFold(a.Kind, b.Kind) && a.Namespace == b.Namespace && a.Name == b.Name
+}
+
+func joinHostnames(hostnames []gatewayv1.Hostname) string {
+	names := make([]string, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		names = append(names, string(hostname))
+	}
+	return strings.Join(names, ", ")
+}
+
+func sortedRouteKeys(m map[types.NamespacedName][]intermediate.SourceReference) []types.NamespacedName {
+	keys := make([]types.NamespacedName, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return keys
+}
+
+func sortedProviders(m map[string][]notifications.Notification) []string {
+	providers := make([]string, 0, len(m))
+	for provider := range m {
+		providers = append(providers, provider)
+	}
+	slices.Sort(providers)
+	return providers
+}
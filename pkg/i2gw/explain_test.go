@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestParseSourceReference(t *testing.T) {
+	testCases := []struct {
+		value       string
+		expected    intermediate.SourceReference
+		expectedErr bool
+	}{
+		{value: "Ingress/default/web", expected: intermediate.SourceReference{Kind: "Ingress", Namespace: "default", Name: "web"}},
+		{value: "default/web", expectedErr: true},
+		{value: "Ingress//web", expectedErr: true},
+		{value: "Ingress/default/web/extra", expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			source, err := ParseSourceReference(tc.value)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %+v", source)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if source != tc.expected {
+				t.Errorf("Expected %+v, got %+v", tc.expected, source)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	routeKey := types.NamespacedName{Namespace: "default", Name: "web-example-com"}
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	gatewayResources := []GatewayResources{{
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			gatewayKey: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+					{Name: "example-com-http", Hostname: ptr.To[gatewayv1.Hostname]("example.com"), Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+					{Name: "other-com-http", Hostname: ptr.To[gatewayv1.Hostname]("other.com"), Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+				}},
+			},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			routeKey: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-example-com"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx"}}},
+					Hostnames:       []gatewayv1.Hostname{"example.com"},
+					Rules: []gatewayv1.HTTPRouteRule{{
+						Matches: []gatewayv1.HTTPRouteMatch{{
+							Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchExact), Value: ptr.To("/api")},
+						}},
+						Filters: []gatewayv1.HTTPRouteFilter{{
+							Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+							RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+								Set: []gatewayv1.HTTPHeader{{Name: "X-App", Value: "web"}},
+							},
+						}},
+						BackendRefs: []gatewayv1.HTTPBackendRef{{
+							BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: "web",
+								Port: ptr.To[gatewayv1.PortNumber](80),
+							}},
+						}},
+					}},
+				},
+			},
+		},
+		Sources: Provenance{HTTPRoutes: map[types.NamespacedName][]intermediate.SourceReference{
+			routeKey: {
+				{Kind: "Ingress", Namespace: "default", Name: "other"},
+				{Kind: "Ingress", Namespace: "default", Name: "web"},
+			},
+		}},
+	}}
+	providerNotifications := map[string][]notifications.Notification{
+		"nginx": {
+			notifications.NewNotification(notifications.WarningNotification, "web warning", &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}}),
+			notifications.NewNotification(notifications.WarningNotification, "other warning", &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"}}),
+		},
+	}
+
+	var out strings.Builder
+	err := Explain(&out, intermediate.SourceReference{Kind: "ingress", Namespace: "default", Name: "web"}, gatewayResources, providerNotifications)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `Explaining ingress default/web
+
+HTTPRoute default/web-example-com
+  merged with Ingress default/other
+  hostnames: example.com
+  parents:
+    Gateway default/nginx, listeners example-com-http (HTTP/80)
+  rule 1:
+    match: path Exact /api
+    filter: RequestHeaderModifier set X-App=web
+    backend: Service default/web:80
+
+Notifications:
+  WARNING [nginx] web warning
+`
+	if out.String() != expected {
+		t.Errorf("Expected trace:\n%s\ngot:\n%s", expected, out.String())
+	}
+
+	err = Explain(&out, intermediate.SourceReference{Kind: "Ingress", Namespace: "default", Name: "missing"}, gatewayResources, providerNotifications)
+	if err == nil {
+		t.Errorf("Expected an error for a source without generated resources")
+	}
+}
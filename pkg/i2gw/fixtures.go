@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// wildcardFixtureLabel replaces the wildcard label of wildcard hostnames in
+// the generated requests.
+const wildcardFixtureLabel = "fixture"
+
+// RequestFixture is an HTTP request derived from a generated HTTPRoute match,
+// together with the behavior expected from the Gateway.
+type RequestFixture struct {
+	// Route is the HTTPRoute, formatted as namespace/name, the request matches.
+	Route   string            `json:"route"`
+	Method  string            `json:"method,omitempty"`
+	Host    string            `json:"host,omitempty"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Backends lists the Services, formatted as namespace/name:port, the
+	// request may be forwarded to.
+	Backends []string `json:"backends,omitempty"`
+
+	// RedirectStatus is the status code expected when the request is
+	// redirected instead of forwarded.
+	RedirectStatus int `json:"redirectStatus,omitempty"`
+}
+
+// GenerateRequestFixtures derives a request per hostname and match of the
+// generated HTTPRoutes. Matches which cannot be turned into a concrete request,
+// e.g. regular expressions, are skipped.
+func GenerateRequestFixtures(gatewayResources []GatewayResources) []RequestFixture {
+	var fixtures []RequestFixture
+	for _, r := range gatewayResources {
+		for key, httpRoute := range r.HTTPRoutes {
+			fixtures = append(fixtures, routeFixtures(key, httpRoute)...)
+		}
+	}
+	slices.SortStableFunc(fixtures, func(a, b RequestFixture) int {
+		return cmp.Or(
+			strings.Compare(a.Route, b.Route),
+			strings.Compare(a.Host, b.Host),
+			strings.Compare(a.Path, b.Path),
+			strings.Compare(a.Method, b.Method),
+		)
+	})
+	return fixtures
+}
+
+// WriteRequestFixtures writes the fixtures to the file as JSON.
+func WriteRequestFixtures(path string, fixtures []RequestFixture) error {
+	data, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal request fixtures: %w", err)
+	}
+	if err = os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write request fixtures to %s: %w", path, err)
+	}
+	return nil
+}
+
+// ReadRequestFixtures reads the fixtures written by WriteRequestFixtures.
+func ReadRequestFixtures(path string) ([]RequestFixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request fixtures file %v: %w", path, err)
+	}
+
+	var fixtures []RequestFixture
+	if err = kubeyaml.UnmarshalStrict(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse request fixtures file %v: %w", path, err)
+	}
+	return fixtures, nil
+}
+
+func routeFixtures(key types.NamespacedName, httpRoute gatewayv1.HTTPRoute) []RequestFixture {
+	hosts := []string{""}
+	if len(httpRoute.Spec.Hostnames) > 0 {
+		hosts = hosts[:0]
+		for _, hostname := range httpRoute.Spec.Hostnames {
+			hosts = append(hosts, strings.Replace(string(hostname), "*", wildcardFixtureLabel, 1))
+		}
+	}
+
+	var fixtures []RequestFixture
+	for _, rule := range httpRoute.Spec.Rules {
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []gatewayv1.HTTPRouteMatch{{}}
+		}
+		for _, match := range matches {
+			fixture, ok := matchFixture(match)
+			if !ok {
+				continue
+			}
+			fixture.Route = key.String()
+			fixture.Backends = ruleBackends(key.Namespace, rule.BackendRefs)
+			fixture.RedirectStatus = ruleRedirectStatus(rule.Filters)
+			for _, host := range hosts {
+				hostFixture := fixture
+				hostFixture.Host = host
+				fixtures = append(fixtures, hostFixture)
+			}
+		}
+	}
+	return fixtures
+}
+
+// matchFixture builds the request matching the match. It returns false when the
+// match uses a regular expression.
+func matchFixture(match gatewayv1.HTTPRouteMatch) (RequestFixture, bool) {
+	fixture := RequestFixture{Path: "/"}
+	if match.Path != nil && match.Path.Value != nil {
+		if match.Path.Type != nil && *match.Path.Type == gatewayv1.PathMatchRegularExpression {
+			return RequestFixture{}, false
+		}
+		fixture.Path = *match.Path.Value
+	}
+	if match.Method != nil {
+		fixture.Method = string(*match.Method)
+	}
+
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gatewayv1.HeaderMatchExact {
+			return RequestFixture{}, false
+		}
+		if fixture.Headers == nil {
+			fixture.Headers = make(map[string]string)
+		}
+		fixture.Headers[string(header.Name)] = header.Value
+	}
+
+	query := url.Values{}
+	for _, param := range match.QueryParams {
+		if param.Type != nil && *param.Type != gatewayv1.QueryParamMatchExact {
+			return RequestFixture{}, false
+		}
+		query.Set(string(param.Name), param.Value)
+	}
+	if len(query) > 0 {
+		fixture.Path += "?" + query.Encode()
+	}
+	return fixture, true
+}
+
+func ruleBackends(namespace string, backendRefs []gatewayv1.HTTPBackendRef) []string {
+	var backends []string
+	for _, backendRef := range backendRefs {
+		if backendRef.Kind != nil && *backendRef.Kind != "Service" {
+			continue
+		}
+		if backendRef.Weight != nil && *backendRef.Weight == 0 {
+			continue
+		}
+		backendNamespace := namespace
+		if backendRef.Namespace != nil {
+			backendNamespace = string(*backendRef.Namespace)
+		}
+		backend := fmt.Sprintf("%s/%s", backendNamespace, backendRef.Name)
+		if backendRef.Port != nil {
+			backend = fmt.Sprintf("%s:%d", backend, *backendRef.Port)
+		}
+		backends = append(backends, backend)
+	}
+	return backends
+}
+
+func ruleRedirectStatus(filters []gatewayv1.HTTPRouteFilter) int {
+	for _, filter := range filters {
+		if filter.Type != gatewayv1.HTTPRouteFilterRequestRedirect || filter.RequestRedirect == nil {
+			continue
+		}
+		if filter.RequestRedirect.StatusCode != nil {
+			return *filter.RequestRedirect.StatusCode
+		}
+		return 302
+	}
+	return 0
+}
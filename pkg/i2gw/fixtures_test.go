@@ -0,0 +1,76 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_GenerateRequestFixtures(t *testing.T) {
+	httpRoute := gatewayv1.HTTPRoute{
+		Spec: gatewayv1.HTTPRouteSpec{
+			Hostnames: []gatewayv1.Hostname{"foo.example.com", "*.example.com"},
+			Rules: []gatewayv1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{
+							Path:        &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/api")},
+							Headers:     []gatewayv1.HTTPHeaderMatch{{Name: "X-Version", Value: "2"}},
+							QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "debug", Value: "true"}},
+						},
+						{
+							Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchRegularExpression), Value: ptr.To("/v[0-9]+")},
+						},
+					},
+					BackendRefs: []gatewayv1.HTTPBackendRef{
+						{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "api", Port: ptr.To(gatewayv1.PortNumber(8080))}}},
+						{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "legacy", Namespace: ptr.To(gatewayv1.Namespace("old"))}, Weight: ptr.To(int32(0))}},
+					},
+				},
+				{
+					Matches: []gatewayv1.HTTPRouteMatch{{
+						Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchExact), Value: ptr.To("/old")},
+					}},
+					Filters: []gatewayv1.HTTPRouteFilter{{
+						Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+						RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{StatusCode: ptr.To(301)},
+					}},
+				},
+			},
+		},
+	}
+	gatewayResources := []GatewayResources{{
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{{Namespace: "default", Name: "foo"}: httpRoute},
+	}}
+
+	headers := map[string]string{"X-Version": "2"}
+	expected := []RequestFixture{
+		{Route: "default/foo", Host: "fixture.example.com", Path: "/api?debug=true", Headers: headers, Backends: []string{"default/api:8080"}},
+		{Route: "default/foo", Host: "fixture.example.com", Path: "/old", RedirectStatus: 301},
+		{Route: "default/foo", Host: "foo.example.com", Path: "/api?debug=true", Headers: headers, Backends: []string{"default/api:8080"}},
+		{Route: "default/foo", Host: "foo.example.com", Path: "/old", RedirectStatus: 301},
+	}
+
+	if diff := cmp.Diff(expected, GenerateRequestFixtures(gatewayResources)); diff != "" {
+		t.Errorf("Unexpected fixtures (-want +got):\n%s", diff)
+	}
+}
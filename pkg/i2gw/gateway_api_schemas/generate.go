@@ -0,0 +1,131 @@
+//go:build ignore
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// generate writes the OpenAPI schemas of the Gateway API CRDs of the
+// experimental channel of the sigs.k8s.io/gateway-api module required by
+// go.mod, a <kind>.<version>.json file for the storage version of each CRD, to
+// the directory given as argument. The descriptions, CEL validation rules and
+// status of the schemas are dropped, as they are not used to validate the
+// generated resources.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+type crd struct {
+	Spec struct {
+		Names struct {
+			Kind string `json:"kind"`
+		} `json:"names"`
+		Versions []struct {
+			Name    string `json:"name"`
+			Storage bool   `json:"storage"`
+			Schema  struct {
+				OpenAPIV3Schema map[string]interface{} `json:"openAPIV3Schema"`
+			} `json:"schema"`
+		} `json:"versions"`
+	} `json:"spec"`
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: go run generate.go <output directory>")
+		os.Exit(1)
+	}
+	if err := generate(os.Args[1]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(outputDir string) error {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "sigs.k8s.io/gateway-api").Output()
+	if err != nil {
+		return fmt.Errorf("failed to locate the sigs.k8s.io/gateway-api module: %w", err)
+	}
+	crdFiles, err := filepath.Glob(filepath.Join(strings.TrimSpace(string(out)), "config", "crd", "experimental", "gateway.networking.k8s.io_*.yaml"))
+	if err != nil {
+		return err
+	}
+
+	for _, crdFile := range crdFiles {
+		data, err := os.ReadFile(crdFile)
+		if err != nil {
+			return err
+		}
+		decoder := kubeyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+		for {
+			var c crd
+			if err = decoder.Decode(&c); err == io.EOF {
+				break
+			} else if err != nil {
+				return fmt.Errorf("failed to parse %s: %w", crdFile, err)
+			}
+			for _, version := range c.Spec.Versions {
+				if !version.Storage {
+					continue
+				}
+				schema := version.Schema.OpenAPIV3Schema
+				if properties, ok := schema["properties"].(map[string]interface{}); ok {
+					delete(properties, "status")
+				}
+				dropKeys(schema)
+
+				data, err := json.MarshalIndent(schema, "", " ")
+				if err != nil {
+					return err
+				}
+				name := fmt.Sprintf("%s.%s.json", strings.ToLower(c.Spec.Names.Kind), version.Name)
+				if err = os.WriteFile(filepath.Join(outputDir, name), append(data, '\n'), 0o644); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// dropKeys removes the descriptions and the CEL validation rules from the
+// schema and its subschemas. Fields named description, whose schema is an
+// object, are kept.
+func dropKeys(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if _, ok := v["description"].(string); ok {
+			delete(v, "description")
+		}
+		delete(v, "x-kubernetes-validations")
+		for _, child := range v {
+			dropKeys(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			dropKeys(child)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// The formats the topology graph is written in.
+const (
+	GraphFormatDOT  = "dot"
+	GraphFormatJSON = "json"
+)
+
+// GraphFormats lists the supported formats of the topology graph.
+var GraphFormats = []string{GraphFormatDOT, GraphFormatJSON}
+
+// TopologyGraph is the attachment structure of the generated resources: the
+// Gateways, their listeners, the routes attached to the listeners and the
+// backends of the routes.
+type TopologyGraph struct {
+	Nodes []TopologyNode `json:"nodes"`
+	Edges []TopologyEdge `json:"edges"`
+}
+
+// TopologyNode is a Gateway, listener, route or backend of the topology graph.
+type TopologyNode struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Gateway is the name of the Gateway of a listener.
+	Gateway string `json:"gateway,omitempty"`
+	// Details describes the protocol, port and hostname of a listener.
+	Details string `json:"details,omitempty"`
+	// External is set on the Gateways, and their listeners, which are not
+	// generated, e.g. the pre-existing Gateways the routes are attached to.
+	External bool `json:"external,omitempty"`
+}
+
+// TopologyEdge links a Gateway to its listeners, a listener to the routes
+// attached to it, and a route to its backends. A route attached to a Gateway
+// which is not generated, without sectionName, is linked to the Gateway.
+type TopologyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// graphRoute holds what the topology of a generated route depends on.
+type graphRoute struct {
+	kind        string
+	key         types.NamespacedName
+	parentRefs  []gatewayv1.ParentReference
+	hostnames   []gatewayv1.Hostname
+	backendRefs []gatewayv1.BackendRef
+}
+
+// BuildTopologyGraph returns the topology graph of the generated resources. A
+// route is linked to the listeners of its parent Gateways its parentRefs
+// select and which accept its kind and hostnames.
+func BuildTopologyGraph(gatewayResources []GatewayResources) TopologyGraph {
+	nodes := map[string]TopologyNode{}
+	edges := sets.New[TopologyEdge]()
+	addNode := func(node TopologyNode) string {
+		if _, ok := nodes[node.ID]; !ok {
+			nodes[node.ID] = node
+		}
+		return node.ID
+	}
+
+	gateways := map[types.NamespacedName]gatewayv1.Gateway{}
+	for _, r := range gatewayResources {
+		for key, gateway := range r.Gateways {
+			gateways[key] = gateway
+			gatewayID := addNode(gatewayNode(key, false))
+			for _, listener := range gateway.Spec.Listeners {
+				node := listenerNode(key, listener.Name, false)
+				node.Details = listenerDetails(listener)
+				edges.Insert(TopologyEdge{From: gatewayID, To: addNode(node)})
+			}
+		}
+	}
+
+	for _, r := range gatewayResources {
+		for _, route := range graphRoutes(r) {
+			routeID := addNode(TopologyNode{ID: fmt.Sprintf("%s/%s", route.kind, route.key), Kind: route.kind, Namespace: route.key.Namespace, Name: route.key.Name})
+			for _, parentRef := range route.parentRefs {
+				if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+					continue
+				}
+				key := ParentGatewayKey(route.key.Namespace, parentRef)
+				gateway, ok := gateways[key]
+				if !ok {
+					gatewayID := addNode(gatewayNode(key, true))
+					if parentRef.SectionName == nil {
+						edges.Insert(TopologyEdge{From: gatewayID, To: routeID})
+						continue
+					}
+					listenerID := addNode(listenerNode(key, *parentRef.SectionName, true))
+					edges.Insert(TopologyEdge{From: gatewayID, To: listenerID}, TopologyEdge{From: listenerID, To: routeID})
+					continue
+				}
+				for _, listener := range gateway.Spec.Listeners {
+					if (parentRef.SectionName != nil && *parentRef.SectionName != listener.Name) ||
+						(parentRef.Port != nil && *parentRef.Port != listener.Port) ||
+						!routeProtocols[route.kind].Has(listener.Protocol) ||
+						!ListenerAcceptsHostnames(listener, route.hostnames) {
+						continue
+					}
+					edges.Insert(TopologyEdge{From: listenerNode(key, listener.Name, false).ID, To: routeID})
+				}
+			}
+			for _, backendRef := range route.backendRefs {
+				edges.Insert(TopologyEdge{From: routeID, To: addNode(backendNode(route.key.Namespace, backendRef))})
+			}
+		}
+	}
+
+	graph := TopologyGraph{Nodes: make([]TopologyNode, 0, len(nodes)), Edges: edges.UnsortedList()}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	slices.SortFunc(graph.Nodes, func(a, b TopologyNode) int {
+		return cmp.Or(cmp.Compare(nodeRank(a.Kind), nodeRank(b.Kind)), cmp.Compare(a.ID, b.ID))
+	})
+	slices.SortFunc(graph.Edges, func(a, b TopologyEdge) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To))
+	})
+	return graph
+}
+
+// graphRoutes returns the routes of the generated resources.
+func graphRoutes(gatewayResources GatewayResources) []graphRoute {
+	var routes []graphRoute
+	for key, route := range gatewayResources.HTTPRoutes {
+		var backendRefs []gatewayv1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+		}
+		routes = append(routes, graphRoute{kind: "HTTPRoute", key: key, parentRefs: route.Spec.ParentRefs, hostnames: route.Spec.Hostnames, backendRefs: backendRefs})
+	}
+	for key, route := range gatewayResources.GRPCRoutes {
+		var backendRefs []gatewayv1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+		}
+		routes = append(routes, graphRoute{kind: "GRPCRoute", key: key, parentRefs: route.Spec.ParentRefs, hostnames: route.Spec.Hostnames, backendRefs: backendRefs})
+	}
+	for key, route := range gatewayResources.TLSRoutes {
+		var backendRefs []gatewayv1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		routes = append(routes, graphRoute{kind: "TLSRoute", key: key, parentRefs: route.Spec.ParentRefs, hostnames: route.Spec.Hostnames, backendRefs: backendRefs})
+	}
+	for key, route := range gatewayResources.TCPRoutes {
+		var backendRefs []gatewayv1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		routes = append(routes, graphRoute{kind: "TCPRoute", key: key, parentRefs: route.Spec.ParentRefs, backendRefs: backendRefs})
+	}
+	for key, route := range gatewayResources.UDPRoutes {
+		var backendRefs []gatewayv1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		routes = append(routes, graphRoute{kind: "UDPRoute", key: key, parentRefs: route.Spec.ParentRefs, backendRefs: backendRefs})
+	}
+	return routes
+}
+
+func gatewayNode(key types.NamespacedName, external bool) TopologyNode {
+	return TopologyNode{ID: "Gateway/" + key.String(), Kind: "Gateway", Namespace: key.Namespace, Name: key.Name, External: external}
+}
+
+func listenerNode(gateway types.NamespacedName, name gatewayv1.SectionName, external bool) TopologyNode {
+	return TopologyNode{ID: fmt.Sprintf("Listener/%s/%s", gateway, name), Kind: "Listener", Namespace: gateway.Namespace, Name: string(name), Gateway: gateway.Name, External: external}
+}
+
+// backendNode returns the node of a backend, a Service unless its kind is set.
+// Backends with a port are named <name>:<port>.
+func backendNode(routeNamespace string, backendRef gatewayv1.BackendRef) TopologyNode {
+	kind := "Service"
+	if backendRef.Kind != nil && *backendRef.Kind != "" {
+		kind = string(*backendRef.Kind)
+	}
+	namespace := routeNamespace
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace)
+	}
+	name := string(backendRef.Name)
+	if backendRef.Port != nil {
+		name = fmt.Sprintf("%s:%d", name, *backendRef.Port)
+	}
+	return TopologyNode{ID: fmt.Sprintf("%s/%s/%s", kind, namespace, name), Kind: kind, Namespace: namespace, Name: name}
+}
+
+func listenerDetails(listener gatewayv1.Listener) string {
+	details := fmt.Sprintf("%s:%d", listener.Protocol, listener.Port)
+	if listener.Hostname != nil && *listener.Hostname != "" {
+		details += " " + string(*listener.Hostname)
+	}
+	return details
+}
+
+// nodeRank orders the nodes from the Gateways to the backends.
+func nodeRank(kind string) int {
+	switch {
+	case kind == "Gateway":
+		return 0
+	case kind == "Listener":
+		return 1
+	case routeProtocols[kind] != nil:
+		return 2
+	}
+	return 3
+}
+
+// DOT returns the graph in the Graphviz DOT language, laid out from left to
+// right. The Gateways which are not generated are dashed.
+func (g TopologyGraph) DOT() string {
+	shapes := map[int]string{0: "box", 1: "ellipse", 2: "note", 3: "cylinder"}
+
+	var b strings.Builder
+	b.WriteString("digraph topology {\n  rankdir=LR;\n")
+	for _, node := range g.Nodes {
+		label := fmt.Sprintf("%s\n%s/%s", node.Kind, node.Namespace, node.Name)
+		if node.Kind == "Listener" {
+			label = fmt.Sprintf("%s\n%s", node.Name, node.Details)
+		}
+		attributes := fmt.Sprintf("label=%q, shape=%s", strings.TrimSpace(label), shapes[nodeRank(node.Kind)])
+		if node.External {
+			attributes += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %q [%s];\n", node.ID, attributes)
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %q -> %q;\n", edge.From, edge.To)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// WriteTopologyGraph writes the graph to the file in the given format.
+func WriteTopologyGraph(path, format string, graph TopologyGraph) error {
+	var data []byte
+	switch format {
+	case GraphFormatDOT:
+		data = []byte(graph.DOT())
+	case GraphFormatJSON:
+		var err error
+		if data, err = json.MarshalIndent(graph, "", "  "); err != nil {
+			return fmt.Errorf("failed to marshal the topology graph: %w", err)
+		}
+		data = append(data, '\n')
+	default:
+		return fmt.Errorf("%s is not a supported graph format, one of %v", format, GraphFormats)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write the topology graph to %s: %w", path, err)
+	}
+	return nil
+}
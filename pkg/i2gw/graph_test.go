@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestBuildTopologyGraph(t *testing.T) {
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	backendRef := func(name string, port gatewayv1.PortNumber) gatewayv1.BackendRef {
+		return gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(name), Port: ptr.To(port)}}
+	}
+	gatewayResources := []GatewayResources{{
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			gatewayKey: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+					{Name: "cafe-http", Protocol: gatewayv1.HTTPProtocolType, Port: 80, Hostname: ptr.To(gatewayv1.Hostname("cafe.example.com"))},
+					{Name: "cafe-https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443, Hostname: ptr.To(gatewayv1.Hostname("cafe.example.com"))},
+					{Name: "tea-http", Protocol: gatewayv1.HTTPProtocolType, Port: 80, Hostname: ptr.To(gatewayv1.Hostname("tea.example.com"))},
+					{Name: "tcp", Protocol: gatewayv1.TCPProtocolType, Port: 5432},
+				}},
+			},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "default", Name: "cafe"}: {
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx"}}},
+					Hostnames:       []gatewayv1.Hostname{"cafe.example.com"},
+					Rules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{
+						{BackendRef: backendRef("coffee", 80)}, {BackendRef: backendRef("coffee", 80)},
+					}}},
+				},
+			},
+			{Namespace: "tea", Name: "tea"}: {
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{
+						{Name: "shared", Namespace: ptr.To(gatewayv1.Namespace("infra")), SectionName: ptr.To(gatewayv1.SectionName("https"))},
+						{Name: "legacy", Namespace: ptr.To(gatewayv1.Namespace("infra"))},
+					}},
+					Rules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: backendRef("tea", 8080)}}}},
+				},
+			},
+		},
+		TCPRoutes: map[types.NamespacedName]gatewayv1alpha2.TCPRoute{
+			{Namespace: "default", Name: "postgres"}: {
+				Spec: gatewayv1alpha2.TCPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("tcp"))}}},
+					Rules:           []gatewayv1alpha2.TCPRouteRule{{BackendRefs: []gatewayv1.BackendRef{backendRef("postgres", 5432)}}},
+				},
+			},
+		},
+	}}
+
+	graph := BuildTopologyGraph(gatewayResources)
+
+	expectedNodes := []TopologyNode{
+		{ID: "Gateway/default/nginx", Kind: "Gateway", Namespace: "default", Name: "nginx"},
+		{ID: "Gateway/infra/legacy", Kind: "Gateway", Namespace: "infra", Name: "legacy", External: true},
+		{ID: "Gateway/infra/shared", Kind: "Gateway", Namespace: "infra", Name: "shared", External: true},
+		{ID: "Listener/default/nginx/cafe-http", Kind: "Listener", Namespace: "default", Name: "cafe-http", Gateway: "nginx", Details: "HTTP:80 cafe.example.com"},
+		{ID: "Listener/default/nginx/cafe-https", Kind: "Listener", Namespace: "default", Name: "cafe-https", Gateway: "nginx", Details: "HTTPS:443 cafe.example.com"},
+		{ID: "Listener/default/nginx/tcp", Kind: "Listener", Namespace: "default", Name: "tcp", Gateway: "nginx", Details: "TCP:5432"},
+		{ID: "Listener/default/nginx/tea-http", Kind: "Listener", Namespace: "default", Name: "tea-http", Gateway: "nginx", Details: "HTTP:80 tea.example.com"},
+		{ID: "Listener/infra/shared/https", Kind: "Listener", Namespace: "infra", Name: "https", Gateway: "shared", External: true},
+		{ID: "HTTPRoute/default/cafe", Kind: "HTTPRoute", Namespace: "default", Name: "cafe"},
+		{ID: "HTTPRoute/tea/tea", Kind: "HTTPRoute", Namespace: "tea", Name: "tea"},
+		{ID: "TCPRoute/default/postgres", Kind: "TCPRoute", Namespace: "default", Name: "postgres"},
+		{ID: "Service/default/coffee:80", Kind: "Service", Namespace: "default", Name: "coffee:80"},
+		{ID: "Service/default/postgres:5432", Kind: "Service", Namespace: "default", Name: "postgres:5432"},
+		{ID: "Service/tea/tea:8080", Kind: "Service", Namespace: "tea", Name: "tea:8080"},
+	}
+	if diff := cmp.Diff(expectedNodes, graph.Nodes); diff != "" {
+		t.Errorf("Unexpected nodes (-want +got):\n%s", diff)
+	}
+
+	expectedEdges := []TopologyEdge{
+		{From: "Gateway/default/nginx", To: "Listener/default/nginx/cafe-http"},
+		{From: "Gateway/default/nginx", To: "Listener/default/nginx/cafe-https"},
+		{From: "Gateway/default/nginx", To: "Listener/default/nginx/tcp"},
+		{From: "Gateway/default/nginx", To: "Listener/default/nginx/tea-http"},
+		{From: "Gateway/infra/legacy", To: "HTTPRoute/tea/tea"},
+		{From: "Gateway/infra/shared", To: "Listener/infra/shared/https"},
+		{From: "HTTPRoute/default/cafe", To: "Service/default/coffee:80"},
+		{From: "HTTPRoute/tea/tea", To: "Service/tea/tea:8080"},
+		{From: "Listener/default/nginx/cafe-http", To: "HTTPRoute/default/cafe"},
+		{From: "Listener/default/nginx/cafe-https", To: "HTTPRoute/default/cafe"},
+		{From: "Listener/default/nginx/tcp", To: "TCPRoute/default/postgres"},
+		{From: "Listener/infra/shared/https", To: "HTTPRoute/tea/tea"},
+		{From: "TCPRoute/default/postgres", To: "Service/default/postgres:5432"},
+	}
+	if diff := cmp.Diff(expectedEdges, graph.Edges); diff != "" {
+		t.Errorf("Unexpected edges (-want +got):\n%s", diff)
+	}
+
+	dot := graph.DOT()
+	for _, line := range []string{
+		`  "Listener/default/nginx/cafe-http" [label="cafe-http\nHTTP:80 cafe.example.com", shape=ellipse];`,
+		`  "Gateway/infra/shared" [label="Gateway\ninfra/shared", shape=box, style=dashed];`,
+		`  "Listener/default/nginx/cafe-http" -> "HTTPRoute/default/cafe";`,
+	} {
+		if !strings.Contains(dot, line+"\n") {
+			t.Errorf("Expected the DOT graph to contain %s, got:\n%s", line, dot)
+		}
+	}
+}
+
+func TestWriteTopologyGraph(t *testing.T) {
+	graph := TopologyGraph{
+		Nodes: []TopologyNode{{ID: "Gateway/default/nginx", Kind: "Gateway", Namespace: "default", Name: "nginx"}},
+		Edges: []TopologyEdge{},
+	}
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "graph.json")
+	if err := WriteTopologyGraph(path, GraphFormatJSON, graph); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var written TopologyGraph
+	if err = json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("Invalid JSON graph: %v", err)
+	}
+	if diff := cmp.Diff(graph, written); diff != "" {
+		t.Errorf("Unexpected graph (-want +got):\n%s", diff)
+	}
+
+	if err = WriteTopologyGraph(filepath.Join(dir, "graph.svg"), "svg", graph); err == nil {
+		t.Errorf("Expected an error for an unsupported format")
+	}
+}
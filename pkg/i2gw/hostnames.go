@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ValidateHostnames reports the parentRefs of the HTTPRoutes of the IR whose
+// listeners have no hostname intersecting the hostnames of the route, as the
+// Gateway would not attach the route to them. A warning is reported for each of
+// those parentRefs, and an error when the route attaches to none of its
+// parents. Parents which are not Gateways of the IR are not validated.
+func ValidateHostnames(ir *intermediate.IR, providerName string) {
+	_ = intermediate.WalkHTTPRoutes(ir, func(routeKey types.NamespacedName, routeContext *intermediate.HTTPRouteContext) error {
+		var validated, rejected int
+		for _, parentRef := range routeContext.Spec.ParentRefs {
+			listeners, ok := parentListeners(ir, routeKey.Namespace, parentRef)
+			if !ok || len(listeners) == 0 {
+				continue
+			}
+			validated++
+			if slices.ContainsFunc(listeners, func(listener gatewayv1.Listener) bool {
+				return ListenerAcceptsHostnames(listener, routeContext.Spec.Hostnames)
+			}) {
+				continue
+			}
+			rejected++
+
+			listenerHostnames := make([]string, 0, len(listeners))
+			for _, listener := range listeners {
+				listenerHostnames = append(listenerHostnames, fmt.Sprintf("%s (%s)", listener.Name, *listener.Hostname))
+			}
+			notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification,
+				fmt.Sprintf("HTTPRoute %s has no hostname intersecting the listeners %s of parent %s: the Gateway will not attach the route to them",
+					routeKey, strings.Join(listenerHostnames, ", "), parentRefString(routeKey.Namespace, parentRef)), &routeContext.HTTPRoute), providerName)
+		}
+		if validated > 0 && rejected == validated {
+			notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.ErrorNotification,
+				fmt.Sprintf("HTTPRoute %s does not attach to any of its parents: its hostnames %s intersect none of their listener hostnames",
+					routeKey, joinHostnames(routeContext.Spec.Hostnames)), &routeContext.HTTPRoute), providerName)
+		}
+		return nil
+	})
+}
+
+// parentListeners returns the HTTP and HTTPS listeners of the Gateway of the IR
+// the parentRef selects, narrowed to its sectionName and port when set. It
+// returns false when the parent is not a Gateway of the IR.
+func parentListeners(ir *intermediate.IR, routeNamespace string, parentRef gatewayv1.ParentReference) ([]gatewayv1.Listener, bool) {
+	if (parentRef.Group != nil && *parentRef.Group != gatewayv1.GroupName) || (parentRef.Kind != nil && *parentRef.Kind != "Gateway") {
+		return nil, false
+	}
+	gatewayContext, ok := ir.Gateways[ParentGatewayKey(routeNamespace, parentRef)]
+	if !ok {
+		return nil, false
+	}
+
+	var listeners []gatewayv1.Listener
+	for _, listener := range gatewayContext.Spec.Listeners {
+		if listener.Protocol != gatewayv1.HTTPProtocolType && listener.Protocol != gatewayv1.HTTPSProtocolType {
+			continue
+		}
+		if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != listener.Port {
+			continue
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, true
+}
+
+// ListenerAcceptsHostnames returns whether a hostname of the route intersects the
+// hostname of the listener. Listeners without hostname, and routes without
+// hostnames, match any hostname.
+func ListenerAcceptsHostnames(listener gatewayv1.Listener, hostnames []gatewayv1.Hostname) bool {
+	if listener.Hostname == nil || *listener.Hostname == "" || len(hostnames) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(hostnames, func(hostname gatewayv1.Hostname) bool {
+		return HostnamesIntersect(*listener.Hostname, hostname)
+	})
+}
+
+// HostnamesIntersect reports whether a listener hostname accepts a route
+// hostname, either of them being possibly a wildcard. A wildcard matches one or
+// more labels, so *.example.com accepts a.b.example.com but not example.com,
+// and *.example.com and *.a.example.com intersect.
+func HostnamesIntersect(listener, route gatewayv1.Hostname) bool {
+	l, r := string(listener), string(route)
+	switch {
+	case l == r:
+		return true
+	case strings.HasPrefix(l, "*.") && strings.HasPrefix(r, "*."):
+		return strings.HasSuffix(r, l[1:]) || strings.HasSuffix(l, r[1:])
+	case strings.HasPrefix(l, "*."):
+		return strings.HasSuffix(r, l[1:])
+	case strings.HasPrefix(r, "*."):
+		return strings.HasSuffix(l, r[1:])
+	}
+	return false
+}
+
+// parentRefString formats the parentRef as namespace/name, followed by its
+// sectionName and port when set.
+func parentRefString(routeNamespace string, parentRef gatewayv1.ParentReference) string {
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	s := namespace + "/" + string(parentRef.Name)
+	if parentRef.SectionName != nil {
+		s += "/" + string(*parentRef.SectionName)
+	}
+	if parentRef.Port != nil {
+		s += fmt.Sprintf(":%d", *parentRef.Port)
+	}
+	return s
+}
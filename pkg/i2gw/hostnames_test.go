@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestHostnamesIntersect(t *testing.T) {
+	testCases := []struct {
+		listener, route gatewayv1.Hostname
+		expected        bool
+	}{
+		{listener: "foo.example.com", route: "foo.example.com", expected: true},
+		{listener: "foo.example.com", route: "bar.example.com", expected: false},
+		{listener: "*.example.com", route: "foo.example.com", expected: true},
+		{listener: "*.example.com", route: "a.b.example.com", expected: true},
+		{listener: "*.example.com", route: "example.com", expected: false},
+		{listener: "foo.example.com", route: "*.example.com", expected: true},
+		{listener: "foo.example.org", route: "*.example.com", expected: false},
+		{listener: "*.example.com", route: "*.a.example.com", expected: true},
+		{listener: "*.a.example.com", route: "*.example.com", expected: true},
+		{listener: "*.example.org", route: "*.example.com", expected: false},
+	}
+	for _, tc := range testCases {
+		if got := HostnamesIntersect(tc.listener, tc.route); got != tc.expected {
+			t.Errorf("HostnamesIntersect(%s, %s) = %t, expected %t", tc.listener, tc.route, got, tc.expected)
+		}
+	}
+}
+
+func Test_ValidateHostnames(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	route := func(name string, hostname gatewayv1.Hostname, parentRefs ...gatewayv1.ParentReference) intermediate.HTTPRouteContext {
+		return intermediate.HTTPRouteContext{HTTPRoute: gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+				Hostnames:       []gatewayv1.Hostname{hostname},
+			},
+		}}
+	}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			gatewayKey: {Gateway: gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+					{Name: "wildcard-example-com-http", Hostname: ptr.To(gatewayv1.Hostname("*.example.com")), Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+					{Name: "example-org-http", Hostname: ptr.To(gatewayv1.Hostname("example.org")), Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				}},
+			}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "default", Name: "attached"}: route("attached", "foo.example.com", gatewayv1.ParentReference{Name: "nginx"}),
+			{Namespace: "default", Name: "partial"}: route("partial", "foo.example.com",
+				gatewayv1.ParentReference{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("wildcard-example-com-http"))},
+				gatewayv1.ParentReference{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("example-org-http"))}),
+			{Namespace: "default", Name: "detached"}: route("detached", "example.com",
+				gatewayv1.ParentReference{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("wildcard-example-com-http"))}),
+			{Namespace: "default", Name: "external"}: route("external", "example.com", gatewayv1.ParentReference{Name: "other"}),
+		},
+	}
+
+	ValidateHostnames(&ir, "nginx")
+
+	var warnings, errors []string
+	for _, n := range notifications.NotificationAggr.Notifications["nginx"] {
+		switch n.Type {
+		case notifications.WarningNotification:
+			warnings = append(warnings, n.Message)
+		case notifications.ErrorNotification:
+			errors = append(errors, n.Message)
+		}
+	}
+	if len(warnings) != 2 ||
+		!strings.Contains(warnings[0], "HTTPRoute default/detached has no hostname intersecting the listeners wildcard-example-com-http (*.example.com) of parent default/nginx/wildcard-example-com-http") ||
+		!strings.Contains(warnings[1], "HTTPRoute default/partial has no hostname intersecting the listeners example-org-http (example.org) of parent default/nginx/example-org-http") {
+		t.Errorf("Unexpected warnings: %v", warnings)
+	}
+	if len(errors) != 1 || !strings.Contains(errors[0], "HTTPRoute default/detached does not attach to any of its parents") {
+		t.Errorf("Unexpected errors: %v", errors)
+	}
+}
@@ -24,9 +24,12 @@ import (
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const GeneratorAnnotationKey = "gateway.networking.k8s.io/generator"
@@ -36,11 +39,68 @@ const GeneratorAnnotationKey = "gateway.networking.k8s.io/generator"
 // Examples: "v0.4.0", "v0.4.0-5-gabcdef", "v0.4.0-5-gabcdef-dirty"
 var Version = "dev" // Default value if not built with linker flags
 
-func ToGatewayAPIResources(ctx context.Context, namespace string, inputFile string, providers []string, providerSpecificFlags map[string]map[string]string) ([]GatewayResources, map[string]string, error) {
-	var clusterClient client.Client
+// ConversionOptions configures the conversion of the provider resources to
+// Gateway API resources.
+type ConversionOptions struct {
+	// Namespace restricts the conversion to a namespace. Empty stands for
+	// all the namespaces.
+	Namespace string
+	// InputFile is the file the resources are read from. The resources are
+	// read from the cluster when it and InputObjects are empty.
+	InputFile string
+	// InputObjects are the decoded objects the resources are read from,
+	// instead of the input file. The providers must implement ObjectReader.
+	InputObjects []*unstructured.Unstructured
+	// OverridesFile is the file of the overrides applied to the IR.
+	OverridesFile string
+	// AttachToGateways lists the Gateways the routes attach to.
+	AttachToGateways []string
+	// Naming holds the templates naming the generated resources.
+	Naming NamingOptions
+	// RoutesOnly omits the Gateways, the routes attaching to existing ones.
+	RoutesOnly bool
+	// TLSPlaceholderMode sets the generation of the missing TLS Secrets.
+	TLSPlaceholderMode string
+	// ConformanceProfile restricts the generated resources to a profile.
+	ConformanceProfile *ConformanceProfile
+	// Providers lists the providers converting the resources.
+	Providers []string
+	// ProviderSpecificFlags holds the flags of every provider.
+	ProviderSpecificFlags map[string]map[string]string
+}
+
+func ToGatewayAPIResources(ctx context.Context, options ConversionOptions) ([]GatewayResources, map[string]string, error) {
+	return ToGatewayAPIResourcesInContext(ctx, "", options)
+}
+
+// ToGatewayAPIResourcesInContext converts the resources like ToGatewayAPIResources,
+// reading them from the cluster of the given kubeconfig context when no input
+// file or objects are set. An empty context stands for the current one.
+func ToGatewayAPIResourcesInContext(ctx context.Context, kubeContext string, options ConversionOptions) ([]GatewayResources, map[string]string, error) {
+	var clusterClient, gatewayClient client.Client
+
+	var overrides *Overrides
+	if options.OverridesFile != "" {
+		var err error
+		overrides, err = ReadOverridesFromFile(options.OverridesFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	var attachments []GatewayAttachment
+	if overrides != nil {
+		attachments = append(attachments, overrides.GatewayAttachments...)
+	}
+	for _, gateway := range options.AttachToGateways {
+		attachments = append(attachments, GatewayAttachment{Gateway: gateway})
+	}
+	namer, err := newNamer(options.Naming)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if inputFile == "" {
-		conf, err := config.GetConfig()
+	if options.InputFile == "" && options.InputObjects == nil {
+		conf, err := config.GetConfigWithContext(kubeContext)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to get client config: %w", err)
 		}
@@ -49,20 +109,29 @@ func ToGatewayAPIResources(ctx context.Context, namespace string, inputFile stri
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create client: %w", err)
 		}
-		clusterClient = client.NewNamespacedClient(cl, namespace)
+		clusterClient = client.NewNamespacedClient(cl, options.Namespace)
+		// The Gateways the routes attach to may be in other namespaces.
+		gatewayClient = cl
 	}
 
 	providerByName, err := constructProviders(&ProviderConf{
 		Client:                clusterClient,
-		Namespace:             namespace,
-		ProviderSpecificFlags: providerSpecificFlags,
-	}, providers)
+		Namespace:             options.Namespace,
+		ProviderSpecificFlags: options.ProviderSpecificFlags,
+	}, options.Providers)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if inputFile != "" {
-		if err = readProviderResourcesFromFile(ctx, providerByName, inputFile); err != nil {
+	if options.InputObjects != nil {
+		// Like the input files, the Gateways and Secrets are looked up in every
+		// namespace, the provider resources in the converted one.
+		inputObjects := filterObjectsByNamespace(options.InputObjects, options.Namespace)
+		if err = readProviderResourcesFromObjects(ctx, providerByName, inputObjects); err != nil {
+			return nil, nil, err
+		}
+	} else if options.InputFile != "" {
+		if err = readProviderResourcesFromFile(ctx, providerByName, options.InputFile); err != nil {
 			return nil, nil, err
 		}
 	} else {
@@ -71,17 +140,99 @@ func ToGatewayAPIResources(ctx context.Context, namespace string, inputFile stri
 		}
 	}
 
+	var existingGateways map[types.NamespacedName]gatewayv1.Gateway
+	if options.RoutesOnly {
+		if len(attachments) == 0 {
+			return nil, nil, fmt.Errorf("routes-only mode requires at least one Gateway attachment")
+		}
+		if options.InputObjects != nil {
+			existingGateways, err = gatewaysFromObjects(options.InputObjects)
+		} else if options.InputFile != "" {
+			existingGateways, err = readGatewaysFromFile(options.InputFile)
+		} else {
+			existingGateways, err = readGatewaysFromCluster(ctx, gatewayClient)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var existingSecrets sets.Set[types.NamespacedName]
+	if TLSPlaceholderMode(options.TLSPlaceholderMode) != TLSPlaceholderNone {
+		if options.InputObjects != nil {
+			existingSecrets = secretKeysFromObjects(options.InputObjects)
+		} else if options.InputFile != "" {
+			existingSecrets, err = readSecretKeysFromFile(options.InputFile)
+		} else {
+			existingSecrets, err = readSecretKeysFromCluster(ctx, clusterClient)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	var (
 		gatewayResources []GatewayResources
 		errs             field.ErrorList
+		matchedOverrides = sets.New[int]()
 	)
-	for _, provider := range providerByName {
+	for name, provider := range providerByName {
 		ir, conversionErrs := provider.ToIR()
 		errs = append(errs, conversionErrs...)
+		// The names of the overrides are final, and are not templated.
+		if err = namer.apply(&ir); err != nil {
+			return nil, nil, err
+		}
+		matched, err := ApplyOverrides(&ir, overrides)
+		if err != nil {
+			return nil, nil, err
+		}
+		matchedOverrides = matchedOverrides.Union(matched)
+		if lister, ok := provider.(SourceObjectLister); ok && overrides != nil {
+			if err = ApplyMappings(&ir, overrides.Mappings, lister.SourceObjects(), string(name)); err != nil {
+				return nil, nil, err
+			}
+		}
+		if options.RoutesOnly {
+			if err = checkGatewayCoverage(&ir, attachments, existingGateways, string(name)); err != nil {
+				return nil, nil, err
+			}
+		}
+		if err = AttachToGateways(&ir, attachments, string(name)); err != nil {
+			return nil, nil, err
+		}
+		SanitizeNumericFields(&ir, string(name))
+		ValidateHostnames(&ir, string(name))
+		reportUnmatchedSectionNames(&ir, string(name))
 		providerGatewayResources, conversionErrs := provider.ToGatewayResources(ir)
 		errs = append(errs, conversionErrs...)
+		if options.RoutesOnly {
+			removeGateways(&providerGatewayResources)
+		}
+		placeholders, err := generateTLSPlaceholders(providerGatewayResources.Gateways, existingSecrets, TLSPlaceholderMode(options.TLSPlaceholderMode), string(name))
+		if err != nil {
+			return nil, nil, err
+		}
+		providerGatewayResources.GatewayExtensions = append(providerGatewayResources.GatewayExtensions, placeholders...)
+		applyConformanceProfile(&providerGatewayResources, options.ConformanceProfile, string(name))
+		validateGatewayAPISchemas(&providerGatewayResources, string(name))
+		providerGatewayResources.Sources = provenanceFromIR(ir)
+		if options.RoutesOnly {
+			clear(providerGatewayResources.Sources.Gateways)
+		}
+		providerGatewayResources.Inventory, err = buildInventory(string(name), provider, providerGatewayResources.Sources, notifications.NotificationAggr.Notifications[string(name)])
+		if err != nil {
+			return nil, nil, err
+		}
+		if reporter, ok := provider.(AnnotationUsageReporter); ok {
+			providerGatewayResources.AnnotationUsage = reporter.AnnotationUsage()
+			for i := range providerGatewayResources.AnnotationUsage {
+				providerGatewayResources.AnnotationUsage[i].Provider = string(name)
+			}
+		}
 		gatewayResources = append(gatewayResources, providerGatewayResources)
 	}
+	reportUnmatchedOverrides(overrides, matchedOverrides, options.Providers)
 	notificationTablesMap := notifications.NotificationAggr.CreateNotificationTables()
 	if len(errs) > 0 {
 		return nil, notificationTablesMap, aggregatedErrs(errs)
@@ -99,6 +250,34 @@ func readProviderResourcesFromFile(ctx context.Context, providerByName map[Provi
 	return nil
 }
 
+func readProviderResourcesFromObjects(ctx context.Context, providerByName map[ProviderName]Provider, objects []*unstructured.Unstructured) error {
+	for name, provider := range providerByName {
+		reader, ok := provider.(ObjectReader)
+		if !ok {
+			return fmt.Errorf("the %s provider cannot read decoded objects", name)
+		}
+		if err := reader.ReadResourcesFromObjects(ctx, objects); err != nil {
+			return fmt.Errorf("failed to read %s resources from the objects: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// filterObjectsByNamespace returns the objects of the namespace, or all the
+// objects when the namespace is empty.
+func filterObjectsByNamespace(objects []*unstructured.Unstructured, namespace string) []*unstructured.Unstructured {
+	if namespace == "" {
+		return objects
+	}
+	var filtered []*unstructured.Unstructured
+	for _, object := range objects {
+		if object.GetNamespace() == namespace {
+			filtered = append(filtered, object)
+		}
+	}
+	return filtered
+}
+
 func readProviderResourcesFromCluster(ctx context.Context, providerByName map[ProviderName]Provider) error {
 	for name, provider := range providerByName {
 		if err := provider.ReadResourcesFromCluster(ctx); err != nil {
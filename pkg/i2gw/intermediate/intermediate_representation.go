@@ -17,6 +17,9 @@ limitations under the License.
 package intermediate
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/types"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -41,6 +44,10 @@ type IR struct {
 
 	BackendTLSPolicies map[types.NamespacedName]gatewayv1alpha3.BackendTLSPolicy
 	ReferenceGrants    map[types.NamespacedName]gatewayv1beta1.ReferenceGrant
+
+	// SourceManifests holds the manifests of the source resources as read by
+	// the provider, keyed by their SourceReference Digest.
+	SourceManifests map[string][]byte
 }
 
 // GatewayContext contains the Gateway-API Gateway object and GatewayIR, which
@@ -71,6 +78,27 @@ type ProviderSpecificGatewayIR struct {
 type HTTPRouteContext struct {
 	gatewayv1.HTTPRoute
 	ProviderSpecificIR ProviderSpecificHTTPRouteIR
+
+	// Sources lists the provider resources the HTTPRoute was generated from.
+	Sources []SourceReference
+}
+
+// SourceReference identifies a provider resource (e.g. an Ingress) that
+// contributed to a generated Gateway API object.
+type SourceReference struct {
+	Kind      string
+	Namespace string
+	Name      string
+
+	// Digest identifies the manifest of the source resource, formatted as
+	// sha256:<hex>. It is empty when the provider does not record it.
+	Digest string
+}
+
+// ManifestDigest returns the digest of a source manifest, as recorded in
+// SourceReference.
+func ManifestDigest(manifest []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(manifest))
 }
 
 type ProviderSpecificHTTPRouteIR struct {
@@ -18,4 +18,55 @@ package intermediate
 
 type NginxGatewayIR struct{}
 type NginxHTTPRouteIR struct{}
-type NginxServiceIR struct{}
+type NginxServiceIR struct {
+	ProxyBuffering *ProxyBufferingConfig
+
+	// AppProtocols maps the ports of the Service referenced by Ingresses, by
+	// name or number, to the appProtocol they must declare for the Gateway to
+	// speak the right protocol to the backend, e.g. kubernetes.io/ws.
+	AppProtocols map[string]string
+
+	// LoadBalancingMethod is the NGINX load balancing method of the upstream,
+	// e.g. "least_conn", as configured by the nginx.org/lb-method annotation.
+	LoadBalancingMethod string
+
+	// Retry is the retry policy of the upstream, as configured by the
+	// nginx.org/proxy-next-upstream annotations.
+	Retry *RetryPolicy
+
+	// Keepalive is the number of idle connections to the upstream each NGINX
+	// worker keeps for reuse, as configured by the nginx.org/keepalive
+	// annotation or the keepalive field of a VirtualServer upstream.
+	Keepalive int
+
+	// NTLM tells the upstream authenticates the clients with NTLM, as
+	// configured by the ntlm field of a VirtualServer upstream.
+	NTLM bool
+}
+
+// RetryPolicy holds the conditions under which a request is passed to the next
+// upstream server, in terms of the Gateway API retry semantics.
+type RetryPolicy struct {
+	// Codes are the HTTP response status codes retried, e.g. 502.
+	Codes []int
+	// Conditions are the other conditions retried: error, timeout,
+	// invalid_header and non_idempotent.
+	Conditions []string
+	// Attempts is the maximum number of retries, nil when unlimited.
+	Attempts *int
+	// PerTryTimeout is the timeout of the retries as a Gateway API duration,
+	// e.g. "10s", empty when unlimited.
+	PerTryTimeout string
+}
+
+// ProxyBufferingConfig holds the proxy buffering settings of the upstream
+// Service, as configured by the nginx.org/proxy-buffering, proxy-buffers and
+// proxy-buffer-size annotations.
+type ProxyBufferingConfig struct {
+	// Enabled is nil when buffering is not explicitly turned on or off.
+	Enabled *bool
+	// Buffers is the number and size of the buffers, e.g. "8 4k".
+	Buffers string
+	// BufferSize is the size of the buffer for the first part of the response.
+	BufferSize string
+}
@@ -49,6 +49,7 @@ func MergeIRs(irs ...IR) (IR, field.ErrorList) {
 		GRPCRoutes:         make(map[types.NamespacedName]gatewayv1.GRPCRoute),
 		BackendTLSPolicies: make(map[types.NamespacedName]gatewayv1alpha3.BackendTLSPolicy),
 		ReferenceGrants:    make(map[types.NamespacedName]gatewayv1beta1.ReferenceGrant),
+		SourceManifests:    make(map[string][]byte),
 	}
 	var errs field.ErrorList
 	mergedIRs.Gateways, errs = mergeGatewayContexts(irs)
@@ -66,6 +67,7 @@ func MergeIRs(irs ...IR) (IR, field.ErrorList) {
 		maps.Copy(mergedIRs.GRPCRoutes, gr.GRPCRoutes)
 		maps.Copy(mergedIRs.BackendTLSPolicies, gr.BackendTLSPolicies)
 		maps.Copy(mergedIRs.ReferenceGrants, gr.ReferenceGrants)
+		maps.Copy(mergedIRs.SourceManifests, gr.SourceManifests)
 	}
 	return mergedIRs, errs
 }
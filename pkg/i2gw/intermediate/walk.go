@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package intermediate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// SkipAll is returned by a visitor to stop the walk without error. The changes
+// the visitor made to the current object are kept.
+var SkipAll = errors.New("skip all remaining objects")
+
+// Visitor is called by the Walk functions for every object of a kind of the IR,
+// in the order of their keys. The visitor may mutate the object; changing its
+// namespace or name moves it under its new key. Returning an error other than
+// SkipAll stops the walk and is returned by the Walk function.
+type Visitor[T any] func(key types.NamespacedName, object *T) error
+
+// WalkGateways calls the visitor for every Gateway of the IR.
+func WalkGateways(ir *IR, visit Visitor[GatewayContext]) error {
+	return walk(ir.Gateways, func(c *GatewayContext) *types.NamespacedName {
+		return &types.NamespacedName{Namespace: c.Namespace, Name: c.Name}
+	}, visit)
+}
+
+// WalkHTTPRoutes calls the visitor for every HTTPRoute of the IR.
+func WalkHTTPRoutes(ir *IR, visit Visitor[HTTPRouteContext]) error {
+	return walk(ir.HTTPRoutes, func(c *HTTPRouteContext) *types.NamespacedName {
+		return &types.NamespacedName{Namespace: c.Namespace, Name: c.Name}
+	}, visit)
+}
+
+// WalkGRPCRoutes calls the visitor for every GRPCRoute of the IR.
+func WalkGRPCRoutes(ir *IR, visit Visitor[gatewayv1.GRPCRoute]) error {
+	return walk(ir.GRPCRoutes, func(r *gatewayv1.GRPCRoute) *types.NamespacedName {
+		return &types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	}, visit)
+}
+
+// WalkTLSRoutes calls the visitor for every TLSRoute of the IR.
+func WalkTLSRoutes(ir *IR, visit Visitor[gatewayv1alpha2.TLSRoute]) error {
+	return walk(ir.TLSRoutes, func(r *gatewayv1alpha2.TLSRoute) *types.NamespacedName {
+		return &types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	}, visit)
+}
+
+// WalkTCPRoutes calls the visitor for every TCPRoute of the IR.
+func WalkTCPRoutes(ir *IR, visit Visitor[gatewayv1alpha2.TCPRoute]) error {
+	return walk(ir.TCPRoutes, func(r *gatewayv1alpha2.TCPRoute) *types.NamespacedName {
+		return &types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	}, visit)
+}
+
+// WalkUDPRoutes calls the visitor for every UDPRoute of the IR.
+func WalkUDPRoutes(ir *IR, visit Visitor[gatewayv1alpha2.UDPRoute]) error {
+	return walk(ir.UDPRoutes, func(r *gatewayv1alpha2.UDPRoute) *types.NamespacedName {
+		return &types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	}, visit)
+}
+
+// walk visits the objects in the order of their keys, and stores the visited
+// objects back in the map. An object whose namespace or name was changed by the
+// visitor is moved under its new key, unless another object already has it.
+func walk[T any](objects map[types.NamespacedName]T, objectKey func(*T) *types.NamespacedName, visit Visitor[T]) error {
+	keys := make([]types.NamespacedName, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	for _, key := range keys {
+		object := objects[key]
+		before := *objectKey(&object)
+		visitErr := visit(key, &object)
+
+		newKey := key
+		if after := *objectKey(&object); after != before {
+			newKey = after
+			if _, exists := objects[newKey]; exists {
+				return fmt.Errorf("cannot rename %s to %s: %s already exists", key, newKey, newKey)
+			}
+			delete(objects, key)
+		}
+		objects[newKey] = object
+
+		if errors.Is(visitErr, SkipAll) {
+			return nil
+		}
+		if visitErr != nil {
+			return visitErr
+		}
+	}
+	return nil
+}
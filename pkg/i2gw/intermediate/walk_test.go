@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package intermediate
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func testIR() IR {
+	route := func(name string) HTTPRouteContext {
+		return HTTPRouteContext{HTTPRoute: gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}}
+	}
+	return IR{HTTPRoutes: map[types.NamespacedName]HTTPRouteContext{
+		{Namespace: "default", Name: "c"}: route("c"),
+		{Namespace: "default", Name: "a"}: route("a"),
+		{Namespace: "default", Name: "b"}: route("b"),
+	}}
+}
+
+func TestWalkHTTPRoutes(t *testing.T) {
+	ir := testIR()
+
+	var visited []string
+	err := WalkHTTPRoutes(&ir, func(key types.NamespacedName, routeContext *HTTPRouteContext) error {
+		visited = append(visited, key.Name)
+		routeContext.Labels = map[string]string{"visited": "true"}
+		if key.Name == "a" {
+			routeContext.Name = "renamed"
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("Expected the routes to be visited in order %v, got %v", expected, visited)
+	}
+	if _, ok := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "a"}]; ok {
+		t.Error("Expected route default/a to be moved under its new name")
+	}
+	for key, routeContext := range ir.HTTPRoutes {
+		if routeContext.Labels["visited"] != "true" {
+			t.Errorf("Expected the changes to route %s to be kept", key)
+		}
+		if key.Name != routeContext.Name {
+			t.Errorf("Expected route %s to be stored under its name, got %s", routeContext.Name, key)
+		}
+	}
+}
+
+func TestWalkHTTPRoutesStop(t *testing.T) {
+	ir := testIR()
+	var visited int
+	err := WalkHTTPRoutes(&ir, func(key types.NamespacedName, routeContext *HTTPRouteContext) error {
+		visited++
+		return SkipAll
+	})
+	if err != nil || visited != 1 {
+		t.Errorf("Expected SkipAll to stop the walk without error, got %d visits and %v", visited, err)
+	}
+
+	visitErr := errors.New("visit failed")
+	if err = WalkHTTPRoutes(&ir, func(types.NamespacedName, *HTTPRouteContext) error { return visitErr }); !errors.Is(err, visitErr) {
+		t.Errorf("Expected the visitor error to be returned, got %v", err)
+	}
+
+	err = WalkHTTPRoutes(&ir, func(key types.NamespacedName, routeContext *HTTPRouteContext) error {
+		routeContext.Name = "c"
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Expected renaming a route to an existing name to fail, got %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// SourceObjectLister is implemented by the providers listing the source objects
+// they read, for the inventory of the conversion.
+type SourceObjectLister interface {
+	// SourceObjects returns the objects read by ReadResourcesFromCluster or
+	// ReadResourcesFromFile.
+	SourceObjects() []client.Object
+}
+
+// Disposition tells what the conversion did with a source object.
+type Disposition string
+
+const (
+	// DispositionConverted objects are the source of generated resources.
+	DispositionConverted Disposition = "converted"
+	// DispositionFailed objects raised an error notification.
+	DispositionFailed Disposition = "failed"
+	// DispositionBlocked objects raised a blocker notification: resources may
+	// be generated from them, but their migration needs a manual decision.
+	DispositionBlocked Disposition = "blocked"
+	// DispositionRead objects were read, but no resource was generated from
+	// them, e.g. the Services of the backends.
+	DispositionRead Disposition = "read"
+)
+
+// InventoryEntry is a source object read by a provider.
+type InventoryEntry struct {
+	Provider        string      `json:"provider"`
+	Group           string      `json:"group"`
+	Version         string      `json:"version"`
+	Kind            string      `json:"kind"`
+	Namespace       string      `json:"namespace,omitempty"`
+	Name            string      `json:"name"`
+	ResourceVersion string      `json:"resourceVersion,omitempty"`
+	Disposition     Disposition `json:"disposition"`
+	// GeneratedResources lists the generated resources, formatted as
+	// <kind>/<namespace>/<name>, recording the object as their source.
+	GeneratedResources []string `json:"generatedResources,omitempty"`
+	// Warnings is the number of warning notifications raised for the object.
+	Warnings int `json:"warnings,omitempty"`
+	// Blockers is the number of blocker notifications raised for the object.
+	Blockers int `json:"blockers,omitempty"`
+}
+
+// sourceKey identifies a source object in the provenance and the notifications.
+type sourceKey struct {
+	kind string
+	types.NamespacedName
+}
+
+// buildInventory lists the source objects read by the provider with their
+// disposition, derived from the provenance of the generated resources and the
+// notifications raised by the provider. It returns nil when the provider does
+// not implement SourceObjectLister.
+func buildInventory(providerName string, provider Provider, sources Provenance, providerNotifications []notifications.Notification) ([]InventoryEntry, error) {
+	lister, ok := provider.(SourceObjectLister)
+	if !ok {
+		return nil, nil
+	}
+
+	generated := map[sourceKey][]string{}
+	for kind, provenance := range map[string]map[types.NamespacedName][]intermediate.SourceReference{"Gateway": sources.Gateways, "HTTPRoute": sources.HTTPRoutes} {
+		for key, references := range provenance {
+			for _, reference := range references {
+				source := sourceKey{kind: reference.Kind, NamespacedName: types.NamespacedName{Namespace: reference.Namespace, Name: reference.Name}}
+				generated[source] = append(generated[source], fmt.Sprintf("%s/%s/%s", kind, key.Namespace, key.Name))
+			}
+		}
+	}
+
+	failed := map[sourceKey]bool{}
+	warnings := map[sourceKey]int{}
+	blockers := map[sourceKey]int{}
+	for _, notification := range providerNotifications {
+		for _, obj := range notification.CallingObjects {
+			gvk, err := apiutil.GVKForObject(obj, clientgoscheme.Scheme)
+			if err != nil {
+				continue
+			}
+			key := sourceKey{kind: gvk.Kind, NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}}
+			switch notification.Type {
+			case notifications.ErrorNotification:
+				failed[key] = true
+			case notifications.WarningNotification:
+				warnings[key]++
+			case notifications.BlockerNotification:
+				blockers[key]++
+			}
+		}
+	}
+
+	var inventory []InventoryEntry
+	for _, obj := range lister.SourceObjects() {
+		gvk, err := apiutil.GVKForObject(obj, clientgoscheme.Scheme)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the kind of %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+		key := sourceKey{kind: gvk.Kind, NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}}
+		entry := InventoryEntry{
+			Provider:           providerName,
+			Group:              gvk.Group,
+			Version:            gvk.Version,
+			Kind:               gvk.Kind,
+			Namespace:          obj.GetNamespace(),
+			Name:               obj.GetName(),
+			ResourceVersion:    obj.GetResourceVersion(),
+			Disposition:        DispositionRead,
+			GeneratedResources: generated[key],
+			Warnings:           warnings[key],
+			Blockers:           blockers[key],
+		}
+		slices.Sort(entry.GeneratedResources)
+		switch {
+		case failed[key]:
+			entry.Disposition = DispositionFailed
+		case blockers[key] > 0:
+			entry.Disposition = DispositionBlocked
+		case len(entry.GeneratedResources) > 0:
+			entry.Disposition = DispositionConverted
+		}
+		inventory = append(inventory, entry)
+	}
+	sortInventory(inventory)
+	return inventory, nil
+}
+
+// sortInventory sorts the inventory by provider, group, kind, namespace and name.
+func sortInventory(inventory []InventoryEntry) {
+	slices.SortFunc(inventory, func(a, b InventoryEntry) int {
+		return cmp.Or(cmp.Compare(a.Provider, b.Provider), cmp.Compare(a.Group, b.Group), cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+}
+
+// Inventory returns the inventories of the providers, sorted.
+func Inventory(gatewayResources []GatewayResources) []InventoryEntry {
+	inventory := []InventoryEntry{}
+	for _, r := range gatewayResources {
+		inventory = append(inventory, r.Inventory...)
+	}
+	sortInventory(inventory)
+	return inventory
+}
+
+// WriteInventory writes the inventory to the file as JSON.
+func WriteInventory(path string, inventory []InventoryEntry) error {
+	data, err := json.MarshalIndent(inventory, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the inventory: %w", err)
+	}
+	if err = os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write the inventory to %s: %w", path, err)
+	}
+	return nil
+}
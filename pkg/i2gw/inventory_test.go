@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listingProvider is a Provider listing the source objects it read.
+type listingProvider struct {
+	Provider
+	objects []client.Object
+}
+
+func (p listingProvider) SourceObjects() []client.Object {
+	return p.objects
+}
+
+func Test_buildInventory(t *testing.T) {
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: "default", Name: name, ResourceVersion: "42"}
+	}
+	cafe := &networkingv1.Ingress{ObjectMeta: objectMeta("cafe")}
+	broken := &networkingv1.Ingress{ObjectMeta: objectMeta("broken")}
+	tea := &apiv1.Service{ObjectMeta: objectMeta("tea")}
+
+	sources := Provenance{
+		Gateways: map[types.NamespacedName][]intermediate.SourceReference{
+			{Namespace: "default", Name: "nginx"}: {{Kind: "Ingress", Namespace: "default", Name: "cafe"}},
+		},
+		HTTPRoutes: map[types.NamespacedName][]intermediate.SourceReference{
+			{Namespace: "default", Name: "cafe-example-com"}:   {{Kind: "Ingress", Namespace: "default", Name: "cafe"}},
+			{Namespace: "default", Name: "broken-example-com"}: {{Kind: "Ingress", Namespace: "default", Name: "broken"}},
+		},
+	}
+	providerNotifications := []notifications.Notification{
+		{Type: notifications.WarningNotification, CallingObjects: []client.Object{cafe, tea}},
+		{Type: notifications.WarningNotification, CallingObjects: []client.Object{cafe}},
+		{Type: notifications.ErrorNotification, CallingObjects: []client.Object{broken}},
+		{Type: notifications.BlockerNotification, CallingObjects: []client.Object{tea}},
+	}
+
+	inventory, err := buildInventory("nginx", listingProvider{objects: []client.Object{tea, cafe, broken}}, sources, providerNotifications)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []InventoryEntry{
+		{Provider: "nginx", Version: "v1", Kind: "Service", Namespace: "default", Name: "tea", ResourceVersion: "42", Disposition: DispositionBlocked, Warnings: 1, Blockers: 1},
+		{Provider: "nginx", Group: "networking.k8s.io", Version: "v1", Kind: "Ingress", Namespace: "default", Name: "broken", ResourceVersion: "42",
+			Disposition: DispositionFailed, GeneratedResources: []string{"HTTPRoute/default/broken-example-com"}},
+		{Provider: "nginx", Group: "networking.k8s.io", Version: "v1", Kind: "Ingress", Namespace: "default", Name: "cafe", ResourceVersion: "42",
+			Disposition: DispositionConverted, GeneratedResources: []string{"Gateway/default/nginx", "HTTPRoute/default/cafe-example-com"}, Warnings: 2},
+	}
+	if diff := cmp.Diff(expected, inventory); diff != "" {
+		t.Errorf("Unexpected inventory (-want +got):\n%s", diff)
+	}
+
+	inventory, err = buildInventory("nginx", listingProvider{}.Provider, sources, providerNotifications)
+	if err != nil || inventory != nil {
+		t.Errorf("Expected no inventory for a provider not listing its source objects, got: %v, %v", inventory, err)
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// MappingRule maps the fields of the source resources, e.g. the annotations of
+// organization-specific conventions, to the HTTPRoutes generated from them. The
+// expressions are kubectl JSONPath templates evaluated against the source
+// resource, e.g. {.metadata.annotations.example\.com/team}.
+type MappingRule struct {
+	// Kind restricts the rule to the source resources of the kind, e.g. Ingress.
+	Kind string `json:"kind,omitempty"`
+
+	// When restricts the rule to the source resources for which the template
+	// is not empty, and equal to Equals when set.
+	When   string `json:"when,omitempty"`
+	Equals string `json:"equals,omitempty"`
+
+	// Value is the template of the value set on the generated routes. Rules
+	// whose value is empty are skipped.
+	Value string `json:"value"`
+
+	// Exactly one of the following targets is set.
+
+	// Label sets the label of the generated routes.
+	Label string `json:"label,omitempty"`
+	// Annotation sets the annotation of the generated routes.
+	Annotation string `json:"annotation,omitempty"`
+	// RequestHeader sets the request header with a RequestHeaderModifier filter
+	// on every rule of the generated routes.
+	RequestHeader string `json:"requestHeader,omitempty"`
+	// ResponseHeader sets the response header with a ResponseHeaderModifier
+	// filter on every rule of the generated routes.
+	ResponseHeader string `json:"responseHeader,omitempty"`
+}
+
+// validate checks the templates and the target of the rule.
+func (m MappingRule) validate() error {
+	for field, template := range map[string]string{"when": m.When, "value": m.Value} {
+		if template == "" {
+			continue
+		}
+		if err := jsonpath.New(field).Parse(template); err != nil {
+			return fmt.Errorf("invalid %s template %q: %w", field, template, err)
+		}
+	}
+	if m.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+
+	targets := 0
+	for _, target := range []string{m.Label, m.Annotation, m.RequestHeader, m.ResponseHeader} {
+		if target != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return fmt.Errorf("exactly one of label, annotation, requestHeader and responseHeader is required")
+	}
+	var errs []string
+	switch {
+	case m.Label != "":
+		errs = validation.IsQualifiedName(m.Label)
+	case m.Annotation != "":
+		errs = validation.IsQualifiedName(m.Annotation)
+	case m.RequestHeader != "":
+		errs = validation.IsHTTPHeaderName(m.RequestHeader)
+	case m.ResponseHeader != "":
+		errs = validation.IsHTTPHeaderName(m.ResponseHeader)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid target: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// ApplyMappings applies the mapping rules to the HTTPRoutes of the IR, evaluating
+// them against the source resources the routes were generated from. Only the
+// source objects listed by the provider, see SourceObjectLister, are mapped.
+// Values which are not valid for their target are reported and skipped.
+func ApplyMappings(ir *intermediate.IR, rules []MappingRule, sourceObjects []client.Object, providerName string) error {
+	if len(rules) == 0 || len(sourceObjects) == 0 {
+		return nil
+	}
+
+	sources := map[sourceKey]map[string]interface{}{}
+	for _, obj := range sourceObjects {
+		gvk, err := apiutil.GVKForObject(obj, clientgoscheme.Scheme)
+		if err != nil {
+			return fmt.Errorf("failed to get the kind of %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		sources[sourceKey{kind: gvk.Kind, NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}}] = content
+	}
+
+	return intermediate.WalkHTTPRoutes(ir, func(routeKey types.NamespacedName, routeContext *intermediate.HTTPRouteContext) error {
+		for _, reference := range sortedSources(routeContext.Sources) {
+			source, ok := sources[sourceKey{kind: reference.Kind, NamespacedName: types.NamespacedName{Namespace: reference.Namespace, Name: reference.Name}}]
+			if !ok {
+				continue
+			}
+			for i, rule := range rules {
+				if rule.Kind != "" && rule.Kind != reference.Kind {
+					continue
+				}
+				if rule.When != "" {
+					condition, err := evaluateTemplate(rule.When, source)
+					if err != nil {
+						return fmt.Errorf("mappings[%d]: %w", i, err)
+					}
+					if condition == "" || (rule.Equals != "" && condition != rule.Equals) {
+						continue
+					}
+				}
+				value, err := evaluateTemplate(rule.Value, source)
+				if err != nil {
+					return fmt.Errorf("mappings[%d]: %w", i, err)
+				}
+				if value == "" {
+					continue
+				}
+				if err := rule.apply(&routeContext.HTTPRoute, value); err != nil {
+					notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification,
+						fmt.Sprintf("mappings[%d] was not applied to HTTPRoute %s: %v", i, routeKey, err), &routeContext.HTTPRoute), providerName)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// evaluateTemplate evaluates the JSONPath template against the source resource.
+// Missing fields evaluate to an empty string.
+func evaluateTemplate(template string, source map[string]interface{}) (string, error) {
+	parser := jsonpath.New("mapping").AllowMissingKeys(true)
+	if err := parser.Parse(template); err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := parser.Execute(&buf, source); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// apply sets the value on the target of the rule.
+func (m MappingRule) apply(httpRoute *gatewayv1.HTTPRoute, value string) error {
+	switch {
+	case m.Label != "":
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q for label %s: %s", value, m.Label, strings.Join(errs, ", "))
+		}
+		if httpRoute.Labels == nil {
+			httpRoute.Labels = map[string]string{}
+		}
+		httpRoute.Labels[m.Label] = value
+	case m.Annotation != "":
+		if httpRoute.Annotations == nil {
+			httpRoute.Annotations = map[string]string{}
+		}
+		httpRoute.Annotations[m.Annotation] = value
+	case m.RequestHeader != "":
+		for i := range httpRoute.Spec.Rules {
+			setHeader(&httpRoute.Spec.Rules[i], gatewayv1.HTTPRouteFilterRequestHeaderModifier, m.RequestHeader, value)
+		}
+	case m.ResponseHeader != "":
+		for i := range httpRoute.Spec.Rules {
+			setHeader(&httpRoute.Spec.Rules[i], gatewayv1.HTTPRouteFilterResponseHeaderModifier, m.ResponseHeader, value)
+		}
+	}
+	return nil
+}
+
+// setHeader sets the header with the header modifier filter of the type of the
+// rule, which is added when the rule has none.
+func setHeader(rule *gatewayv1.HTTPRouteRule, filterType gatewayv1.HTTPRouteFilterType, name, value string) {
+	header := gatewayv1.HTTPHeader{Name: gatewayv1.HTTPHeaderName(name), Value: value}
+	for _, filter := range rule.Filters {
+		if filter.Type != filterType {
+			continue
+		}
+		modifier := filter.RequestHeaderModifier
+		if filterType == gatewayv1.HTTPRouteFilterResponseHeaderModifier {
+			modifier = filter.ResponseHeaderModifier
+		}
+		if modifier == nil {
+			continue
+		}
+		for j, existing := range modifier.Set {
+			if strings.EqualFold(string(existing.Name), name) {
+				modifier.Set[j] = header
+				return
+			}
+		}
+		modifier.Set = append(modifier.Set, header)
+		return
+	}
+
+	modifier := &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{header}}
+	filter := gatewayv1.HTTPRouteFilter{Type: filterType}
+	if filterType == gatewayv1.HTTPRouteFilterRequestHeaderModifier {
+		filter.RequestHeaderModifier = modifier
+	} else {
+		filter.ResponseHeaderModifier = modifier
+	}
+	rule.Filters = append(rule.Filters, filter)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_ReadOverridesFromFile_mappings(t *testing.T) {
+	testCases := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{{
+		name: "valid mappings",
+		content: `
+mappings:
+- when: '{.metadata.annotations.example\.com/team}'
+  value: '{.metadata.annotations.example\.com/team}'
+  label: example.com/team
+- kind: Ingress
+  value: 'ingress2gateway/{.metadata.name}'
+  requestHeader: X-Migrated-From
+`,
+	}, {
+		name: "invalid template",
+		content: `
+mappings:
+- value: '{.metadata.annotations'
+  label: team
+`,
+		expectedError: "invalid value template",
+	}, {
+		name: "several targets",
+		content: `
+mappings:
+- value: '{.metadata.name}'
+  label: team
+  annotation: team
+`,
+		expectedError: "exactly one of",
+	}, {
+		name: "invalid header name",
+		content: `
+mappings:
+- value: '{.metadata.name}'
+  responseHeader: 'X Team'
+`,
+		expectedError: "invalid target",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "overrides.yaml")
+			if err := os.WriteFile(path, []byte(tc.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := ReadOverridesFromFile(path)
+			if tc.expectedError == "" && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if tc.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedError)) {
+				t.Errorf("Expected an error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func Test_ApplyMappings(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	payments := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "default",
+		Name:        "payments",
+		Annotations: map[string]string{"example.com/team": "payments", "example.com/cost-center": "cc 42"},
+	}}
+	legacy := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "legacy"}}
+	paymentsKey := types.NamespacedName{Namespace: "default", Name: "payments-example-com"}
+	legacyKey := types.NamespacedName{Namespace: "default", Name: "legacy-example-com"}
+	route := func(source string) intermediate.HTTPRouteContext {
+		return intermediate.HTTPRouteContext{
+			HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{
+				{},
+				{Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "X-Existing", Value: "1"}}},
+				}}},
+			}}},
+			Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: source}},
+		}
+	}
+	ir := intermediate.IR{HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+		paymentsKey: route("payments"),
+		legacyKey:   route("legacy"),
+	}}
+	rules := []MappingRule{
+		{When: `{.metadata.annotations.example\.com/team}`, Value: `{.metadata.annotations.example\.com/team}`, Label: "example.com/team"},
+		{Value: `{.metadata.annotations.example\.com/cost-center}`, Label: "example.com/cost-center"},
+		{Kind: "Ingress", Value: `ingress/{.metadata.name}`, RequestHeader: "X-Migrated-From"},
+		{Kind: "Service", Value: `{.metadata.name}`, Annotation: "example.com/service"},
+		{When: `{.metadata.annotations.example\.com/team}`, Equals: "search", Value: "search", ResponseHeader: "X-Team"},
+	}
+
+	if err := ApplyMappings(&ir, rules, []client.Object{payments, legacy}, "nginx"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	paymentsRoute := ir.HTTPRoutes[paymentsKey].HTTPRoute
+	if diff := cmp.Diff(map[string]string{"example.com/team": "payments"}, paymentsRoute.Labels); diff != "" {
+		t.Errorf("Unexpected labels (-want +got):\n%s", diff)
+	}
+	if paymentsRoute.Annotations != nil {
+		t.Errorf("Expected no annotation, got %v", paymentsRoute.Annotations)
+	}
+	expectedFilters := [][]gatewayv1.HTTPRouteFilter{
+		{{
+			Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "X-Migrated-From", Value: "ingress/payments"}}},
+		}},
+		{{
+			Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{
+				{Name: "X-Existing", Value: "1"},
+				{Name: "X-Migrated-From", Value: "ingress/payments"},
+			}},
+		}},
+	}
+	for i, rule := range paymentsRoute.Spec.Rules {
+		if diff := cmp.Diff(expectedFilters[i], rule.Filters); diff != "" {
+			t.Errorf("Unexpected filters of rule %d (-want +got):\n%s", i, diff)
+		}
+	}
+
+	if labels := ir.HTTPRoutes[legacyKey].Labels; labels != nil {
+		t.Errorf("Expected no label on the route of the Ingress without annotations, got %v", labels)
+	}
+
+	var invalidValue bool
+	for _, n := range notifications.NotificationAggr.Notifications["nginx"] {
+		if strings.Contains(n.Message, `invalid value "cc 42" for label example.com/cost-center`) {
+			invalidValue = true
+		}
+	}
+	if !invalidValue {
+		t.Errorf("Expected the invalid label value to be reported, got %v", notifications.NotificationAggr.Notifications["nginx"])
+	}
+}
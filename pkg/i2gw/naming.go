@@ -0,0 +1,323 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NamingOptions holds the Go templates naming the generated resources instead
+// of the providers. An empty template keeps the names of the providers.
+type NamingOptions struct {
+	// GatewayTemplate names the Gateways, with the GatewayNameData variables.
+	GatewayTemplate string
+
+	// RouteTemplate names the routes, with the RouteNameData variables.
+	RouteTemplate string
+}
+
+// GatewayNameData holds the variables of the Gateway name templates.
+type GatewayNameData struct {
+	// Namespace is the namespace of the Gateway.
+	Namespace string
+
+	// Class is the GatewayClass of the Gateway, e.g. the ingress class of the
+	// Ingresses it was converted from.
+	Class string
+
+	// Name is the name the provider generated for the Gateway.
+	Name string
+}
+
+// RouteNameData holds the variables of the route name templates.
+type RouteNameData struct {
+	// Namespace is the namespace of the route.
+	Namespace string
+
+	// Kind is the kind of the route, e.g. HTTPRoute or TCPRoute.
+	Kind string
+
+	// Source is the name of the first resource the route was generated from,
+	// or the name generated by the provider when the route has no source.
+	Source string
+
+	// Host is the first hostname of the route, its special characters replaced
+	// with dashes, or all-hosts for a route without hostname.
+	Host string
+
+	// Name is the name the provider generated for the route.
+	Name string
+}
+
+// namer names the resources of the IR with the templates of NamingOptions.
+type namer struct {
+	gatewayTemplate *template.Template
+	routeTemplate   *template.Template
+}
+
+// ValidateNamingOptions returns an error when a template of the options is
+// invalid.
+func ValidateNamingOptions(options NamingOptions) error {
+	_, err := newNamer(options)
+	return err
+}
+
+// newNamer parses the templates of the options, and checks they generate a
+// valid name for sample data.
+func newNamer(options NamingOptions) (*namer, error) {
+	var n namer
+	var err error
+	if options.GatewayTemplate != "" {
+		n.gatewayTemplate, err = parseNameTemplate("gateway", options.GatewayTemplate, GatewayNameData{Namespace: "default", Class: "nginx", Name: "nginx"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if options.RouteTemplate != "" {
+		n.routeTemplate, err = parseNameTemplate("route", options.RouteTemplate, RouteNameData{Namespace: "default", Kind: "HTTPRoute", Source: "web", Host: "example-com", Name: "web-example-com"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &n, nil
+}
+
+// parseNameTemplate parses the template of a kind of resources, and executes it
+// with the sample data.
+func parseNameTemplate(kind, text string, sample any) (*template.Template, error) {
+	tmpl, err := template.New(kind + "-name").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s name template %q: %w", kind, text, err)
+	}
+	if _, err = executeNameTemplate(tmpl, sample); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// executeNameTemplate executes the template and validates the resulting name.
+func executeNameTemplate(tmpl *template.Template, data any) (string, error) {
+	var name strings.Builder
+	if err := tmpl.Execute(&name, data); err != nil {
+		return "", fmt.Errorf("invalid %s template %q: %w", tmpl.Name(), tmpl.Root.String(), err)
+	}
+	if errs := validation.IsDNS1123Subdomain(name.String()); len(errs) > 0 {
+		return "", fmt.Errorf("%s template %q generated the invalid name %q for %+v: %s", tmpl.Name(), tmpl.Root.String(), name.String(), data, strings.Join(errs, ", "))
+	}
+	return name.String(), nil
+}
+
+// apply renames the Gateways, then the routes of the IR.
+func (n *namer) apply(ir *intermediate.IR) error {
+	if n.gatewayTemplate != nil {
+		if err := renameGateways(ir, n.gatewayTemplate); err != nil {
+			return err
+		}
+	}
+	if n.routeTemplate != nil {
+		return renameRoutes(ir, n.routeTemplate)
+	}
+	return nil
+}
+
+// renameGateways renames the Gateways of the IR with the template, and the
+// parentRefs of the routes referencing them, so that the routes stay attached
+// to the renamed Gateways. An error is returned when the template generates an
+// invalid name, or the same name for several Gateways of a namespace.
+func renameGateways(ir *intermediate.IR, tmpl *template.Template) error {
+	renamed, err := newNames(ir.Gateways, tmpl, func(key types.NamespacedName, gatewayContext intermediate.GatewayContext) any {
+		return GatewayNameData{Namespace: key.Namespace, Class: string(gatewayContext.Spec.GatewayClassName), Name: key.Name}
+	})
+	if err != nil {
+		return err
+	}
+	ir.Gateways = renameObjects(ir.Gateways, renamed, func(gatewayContext *intermediate.GatewayContext, name string) {
+		gatewayContext.Name = name
+	})
+
+	// The parentRefs are copied, since routes may share them.
+	rename := func(namespace string, parentRefs []gatewayv1.ParentReference) []gatewayv1.ParentReference {
+		parentRefs = slices.Clone(parentRefs)
+		for i, parentRef := range parentRefs {
+			if isGatewayParentRef(parentRef) {
+				if name, ok := renamed[ParentGatewayKey(namespace, parentRef)]; ok {
+					parentRefs[i].Name = gatewayv1.ObjectName(name)
+				}
+			}
+		}
+		return parentRefs
+	}
+	_ = intermediate.WalkHTTPRoutes(ir, func(key types.NamespacedName, routeContext *intermediate.HTTPRouteContext) error {
+		routeContext.Spec.ParentRefs = rename(key.Namespace, routeContext.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkGRPCRoutes(ir, func(key types.NamespacedName, route *gatewayv1.GRPCRoute) error {
+		route.Spec.ParentRefs = rename(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkTLSRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.TLSRoute) error {
+		route.Spec.ParentRefs = rename(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkTCPRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.TCPRoute) error {
+		route.Spec.ParentRefs = rename(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	_ = intermediate.WalkUDPRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.UDPRoute) error {
+		route.Spec.ParentRefs = rename(key.Namespace, route.Spec.ParentRefs)
+		return nil
+	})
+	return nil
+}
+
+// renameRoutes renames the routes of every kind of the IR with the template.
+// An error is returned when the template generates an invalid name, or the
+// same name for several routes of a kind in a namespace.
+func renameRoutes(ir *intermediate.IR, tmpl *template.Template) error {
+	httpRoutes, err := newNames(ir.HTTPRoutes, tmpl, func(key types.NamespacedName, routeContext intermediate.HTTPRouteContext) any {
+		source := key.Name
+		if len(routeContext.Sources) > 0 {
+			source = routeContext.Sources[0].Name
+		}
+		return routeNameData(key, "HTTPRoute", source, routeContext.Spec.Hostnames)
+	})
+	if err != nil {
+		return err
+	}
+	grpcRoutes, err := newNames(ir.GRPCRoutes, tmpl, func(key types.NamespacedName, route gatewayv1.GRPCRoute) any {
+		return routeNameData(key, "GRPCRoute", key.Name, route.Spec.Hostnames)
+	})
+	if err != nil {
+		return err
+	}
+	tlsRoutes, err := newNames(ir.TLSRoutes, tmpl, func(key types.NamespacedName, route gatewayv1alpha2.TLSRoute) any {
+		return routeNameData(key, "TLSRoute", key.Name, route.Spec.Hostnames)
+	})
+	if err != nil {
+		return err
+	}
+	tcpRoutes, err := newNames(ir.TCPRoutes, tmpl, func(key types.NamespacedName, _ gatewayv1alpha2.TCPRoute) any {
+		return routeNameData(key, "TCPRoute", key.Name, nil)
+	})
+	if err != nil {
+		return err
+	}
+	udpRoutes, err := newNames(ir.UDPRoutes, tmpl, func(key types.NamespacedName, _ gatewayv1alpha2.UDPRoute) any {
+		return routeNameData(key, "UDPRoute", key.Name, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	ir.HTTPRoutes = renameObjects(ir.HTTPRoutes, httpRoutes, func(routeContext *intermediate.HTTPRouteContext, name string) {
+		routeContext.Name = name
+	})
+	ir.GRPCRoutes = renameObjects(ir.GRPCRoutes, grpcRoutes, func(route *gatewayv1.GRPCRoute, name string) {
+		route.Name = name
+	})
+	ir.TLSRoutes = renameObjects(ir.TLSRoutes, tlsRoutes, func(route *gatewayv1alpha2.TLSRoute, name string) {
+		route.Name = name
+	})
+	ir.TCPRoutes = renameObjects(ir.TCPRoutes, tcpRoutes, func(route *gatewayv1alpha2.TCPRoute, name string) {
+		route.Name = name
+	})
+	ir.UDPRoutes = renameObjects(ir.UDPRoutes, udpRoutes, func(route *gatewayv1alpha2.UDPRoute, name string) {
+		route.Name = name
+	})
+	return nil
+}
+
+// routeNameData returns the variables of the route name templates.
+func routeNameData(key types.NamespacedName, kind, source string, hostnames []gatewayv1.Hostname) RouteNameData {
+	var host string
+	if len(hostnames) > 0 {
+		host = string(hostnames[0])
+	}
+	return RouteNameData{Namespace: key.Namespace, Kind: kind, Source: source, Host: hostLabel(host), Name: key.Name}
+}
+
+var nonAlphanumeric = regexp.MustCompile("[^a-zA-Z0-9]+")
+
+// hostLabel replaces the special characters of the hostname with dashes, as
+// the providers do in the names of the routes.
+func hostLabel(host string) string {
+	label := strings.Trim(nonAlphanumeric.ReplaceAllString(host, "-"), "-")
+	if label == "" {
+		return "all-hosts"
+	}
+	return label
+}
+
+// newNames executes the template for every object, in the order of their keys,
+// and returns their new names. An error is returned when the template fails,
+// or generates the same name for several objects of a namespace.
+func newNames[T any](objects map[types.NamespacedName]T, tmpl *template.Template, data func(types.NamespacedName, T) any) (map[types.NamespacedName]string, error) {
+	keys := make([]types.NamespacedName, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+
+	names := make(map[types.NamespacedName]string, len(keys))
+	renamedFrom := make(map[types.NamespacedName]types.NamespacedName, len(keys))
+	for _, key := range keys {
+		name, err := executeNameTemplate(tmpl, data(key, objects[key]))
+		if err != nil {
+			return nil, err
+		}
+		newKey := types.NamespacedName{Namespace: key.Namespace, Name: name}
+		if other, ok := renamedFrom[newKey]; ok {
+			return nil, fmt.Errorf("%s template %q generated the name %s for both %s and %s", tmpl.Name(), tmpl.Root.String(), newKey, other, key)
+		}
+		renamedFrom[newKey] = key
+		names[key] = name
+	}
+	return names, nil
+}
+
+// renameObjects returns the objects under their new names.
+func renameObjects[T any](objects map[types.NamespacedName]T, names map[types.NamespacedName]string, setName func(*T, string)) map[types.NamespacedName]T {
+	if objects == nil {
+		return nil
+	}
+	renamed := make(map[types.NamespacedName]T, len(objects))
+	for key, object := range objects {
+		setName(&object, names[key])
+		renamed[types.NamespacedName{Namespace: key.Namespace, Name: names[key]}] = object
+	}
+	return renamed
+}
+
+// isGatewayParentRef tells whether the parentRef references a Gateway, the
+// default group and kind of parentRefs.
+func isGatewayParentRef(parentRef gatewayv1.ParentReference) bool {
+	return (parentRef.Group == nil || *parentRef.Group == gatewayv1.GroupName) &&
+		(parentRef.Kind == nil || *parentRef.Kind == "Gateway")
+}
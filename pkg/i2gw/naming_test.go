@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestValidateNamingOptions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       NamingOptions
+		expectedError bool
+	}{
+		{name: "none"},
+		{name: "gateway variables", options: NamingOptions{GatewayTemplate: "{{.Namespace}}-{{.Class}}"}},
+		{name: "constant gateway", options: NamingOptions{GatewayTemplate: "shared"}},
+		{name: "route variables", options: NamingOptions{RouteTemplate: "{{.Namespace}}-{{.Source}}-{{.Host}}"}},
+		{name: "route name", options: NamingOptions{RouteTemplate: "{{.Name}}-route"}},
+		{name: "unterminated action", options: NamingOptions{GatewayTemplate: "{{.Namespace"}, expectedError: true},
+		{name: "unknown variable", options: NamingOptions{RouteTemplate: "{{.Class}}"}, expectedError: true},
+		{name: "invalid name", options: NamingOptions{GatewayTemplate: "{{.Namespace}}_{{.Class}}"}, expectedError: true},
+		{name: "uppercase kind", options: NamingOptions{RouteTemplate: "{{.Source}}-{{.Kind}}"}, expectedError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateNamingOptions(tc.options)
+			if tc.expectedError != (err != nil) {
+				t.Errorf("Expected an error: %t, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestRenameGateways(t *testing.T) {
+	gateway := func(namespace, name string) intermediate.GatewayContext {
+		return intermediate.GatewayContext{Gateway: gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       gatewayv1.GatewaySpec{GatewayClassName: "nginx"},
+		}}
+	}
+	// The routes share their parentRefs, as some providers generate them.
+	generatedRef := []gatewayv1.ParentReference{{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("a-example-com-http"))}}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			{Namespace: "team-a", Name: "nginx"}: gateway("team-a", "nginx"),
+			{Namespace: "team-b", Name: "nginx"}: gateway("team-b", "nginx"),
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "team-a", Name: "web"}: {HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "web"},
+				Spec:       gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef}},
+			}},
+			{Namespace: "team-b", Name: "web"}: {HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "web"},
+				Spec: gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{
+					{Namespace: ptr.To(gatewayv1.Namespace("team-a")), Name: "nginx"},
+					{Name: "external"},
+				}}},
+			}},
+		},
+		TCPRoutes: map[types.NamespacedName]gatewayv1alpha2.TCPRoute{
+			{Namespace: "team-b", Name: "db"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "db"},
+				Spec:       gatewayv1alpha2.TCPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef}},
+			},
+		},
+	}
+
+	n, err := newNamer(NamingOptions{GatewayTemplate: "{{.Namespace}}-{{.Class}}"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err = n.apply(&ir); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var gateways []string
+	for key, gatewayContext := range ir.Gateways {
+		if key.Name != gatewayContext.Name {
+			t.Errorf("Expected Gateway %s to be named %s, got %s", key, key.Name, gatewayContext.Name)
+		}
+		gateways = append(gateways, key.String())
+	}
+	if diff := cmp.Diff([]string{"team-a/team-a-nginx", "team-b/team-b-nginx"}, gateways, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Unexpected Gateways (-want +got):\n%s", diff)
+	}
+
+	expectedHTTPParentRefs := map[types.NamespacedName][]gatewayv1.ParentReference{
+		{Namespace: "team-a", Name: "web"}: {{Name: "team-a-nginx", SectionName: ptr.To(gatewayv1.SectionName("a-example-com-http"))}},
+		{Namespace: "team-b", Name: "web"}: {
+			{Namespace: ptr.To(gatewayv1.Namespace("team-a")), Name: "team-a-nginx"},
+			{Name: "external"},
+		},
+	}
+	for key, expected := range expectedHTTPParentRefs {
+		if diff := cmp.Diff(expected, ir.HTTPRoutes[key].Spec.ParentRefs); diff != "" {
+			t.Errorf("Unexpected parentRefs of HTTPRoute %s (-want +got):\n%s", key, diff)
+		}
+	}
+	expectedTCPParentRefs := []gatewayv1.ParentReference{{Name: "team-b-nginx", SectionName: ptr.To(gatewayv1.SectionName("a-example-com-http"))}}
+	if diff := cmp.Diff(expectedTCPParentRefs, ir.TCPRoutes[types.NamespacedName{Namespace: "team-b", Name: "db"}].Spec.ParentRefs); diff != "" {
+		t.Errorf("Unexpected parentRefs of the TCPRoute (-want +got):\n%s", diff)
+	}
+}
+
+func TestRenameRoutes(t *testing.T) {
+	ir := intermediate.IR{
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "default", Name: "cafe-cafe-example-com"}: {
+				HTTPRoute: gatewayv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe-cafe-example-com"},
+					Spec:       gatewayv1.HTTPRouteSpec{Hostnames: []gatewayv1.Hostname{"cafe.example.com"}},
+				},
+				Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "cafe"}},
+			},
+			{Namespace: "default", Name: "cafe-all-hosts"}: {
+				HTTPRoute: gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe-all-hosts"}},
+				Sources:   []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "cafe"}},
+			},
+		},
+		TCPRoutes: map[types.NamespacedName]gatewayv1alpha2.TCPRoute{
+			{Namespace: "default", Name: "db-tcproute"}: {ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db-tcproute"}},
+		},
+	}
+
+	n, err := newNamer(NamingOptions{RouteTemplate: "{{.Source}}-{{.Host}}"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err = n.apply(&ir); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var routes []string
+	for key, routeContext := range ir.HTTPRoutes {
+		if key.Name != routeContext.Name {
+			t.Errorf("Expected HTTPRoute %s to be named %s, got %s", key, key.Name, routeContext.Name)
+		}
+		routes = append(routes, key.String())
+	}
+	for key, route := range ir.TCPRoutes {
+		if key.Name != route.Name {
+			t.Errorf("Expected TCPRoute %s to be named %s, got %s", key, key.Name, route.Name)
+		}
+		routes = append(routes, key.String())
+	}
+	expected := []string{"default/cafe-all-hosts", "default/cafe-cafe-example-com", "default/db-tcproute-all-hosts"}
+	if diff := cmp.Diff(expected, routes, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Unexpected routes (-want +got):\n%s", diff)
+	}
+}
+
+func TestNamingCollisions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options NamingOptions
+		ir      intermediate.IR
+	}{{
+		name:    "gateways",
+		options: NamingOptions{GatewayTemplate: "{{.Namespace}}-gateway"},
+		ir: intermediate.IR{Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			{Namespace: "default", Name: "nginx"}:    {},
+			{Namespace: "default", Name: "internal"}: {},
+		}},
+	}, {
+		name:    "routes",
+		options: NamingOptions{RouteTemplate: "{{.Source}}"},
+		ir: intermediate.IR{HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "default", Name: "cafe-a-example-com"}: {Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "cafe"}}},
+			{Namespace: "default", Name: "cafe-b-example-com"}: {Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "cafe"}}},
+		}},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, err := newNamer(tc.options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err = n.apply(&tc.ir); err == nil {
+				t.Errorf("Expected a collision error, got %+v", tc.ir)
+			}
+		})
+	}
+}
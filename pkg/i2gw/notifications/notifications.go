@@ -17,7 +17,9 @@ limitations under the License.
 package notifications
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
@@ -27,30 +29,91 @@ import (
 )
 
 func init() {
-	NotificationAggr = NotificationAggregator{Notifications: map[string][]Notification{}}
+	NotificationAggr = NotificationAggregator{Notifications: map[string][]Notification{}, SecurityFindings: map[string][]SecurityFinding{}}
 }
 
 const (
 	InfoNotification    MessageType = "INFO"
 	WarningNotification MessageType = "WARNING"
 	ErrorNotification   MessageType = "ERROR"
+	// BlockerNotification reports a behavior of the source the target Gateway
+	// implementation cannot provide, which blocks the migration of the
+	// affected traffic, while the generated resources are valid.
+	BlockerNotification MessageType = "BLOCKER"
 )
 
 type MessageType string
 
+// severities are the message types, from the least to the most severe.
+var severities = []MessageType{InfoNotification, WarningNotification, ErrorNotification, BlockerNotification}
+
+// ParseMessageType returns the message type of the case-insensitive name, e.g.
+// "blocker".
+func ParseMessageType(name string) (MessageType, error) {
+	mType := MessageType(strings.ToUpper(name))
+	if !slices.Contains(severities, mType) {
+		return "", fmt.Errorf("%s is not a notification type, one of %v", name, severities)
+	}
+	return mType, nil
+}
+
+// AtLeast reports whether the message type is at least as severe as min.
+func (t MessageType) AtLeast(min MessageType) bool {
+	return slices.Index(severities, t) >= slices.Index(severities, min)
+}
+
 type Notification struct {
 	Type           MessageType
 	Message        string
 	CallingObjects []client.Object
 }
 
+// SecurityFinding is a reference to a security feature, such as a WAF or DoS
+// protection policy, which has no Gateway API equivalent and must be migrated
+// manually.
+type SecurityFinding struct {
+	// Feature is the name of the security feature, e.g. "App Protect WAF".
+	Feature string
+	// Reference is the policy or configuration referenced by the source object.
+	Reference string
+	// Routes are the generated routes which lost the protection.
+	Routes         []string
+	CallingObjects []client.Object
+}
+
 type NotificationAggregator struct {
-	mutex         sync.Mutex
-	Notifications map[string][]Notification
+	mutex            sync.Mutex
+	Notifications    map[string][]Notification
+	SecurityFindings map[string][]SecurityFinding
 }
 
 var NotificationAggr NotificationAggregator
 
+// Reset drops the notifications and security findings dispatched so far, e.g.
+// between the conversions of several clusters.
+func (na *NotificationAggregator) Reset() {
+	na.mutex.Lock()
+	na.Notifications = map[string][]Notification{}
+	na.SecurityFindings = map[string][]SecurityFinding{}
+	na.mutex.Unlock()
+}
+
+// CountAtLeast returns the number of notifications dispatched so far which are at
+// least as severe as min.
+func (na *NotificationAggregator) CountAtLeast(min MessageType) int {
+	na.mutex.Lock()
+	defer na.mutex.Unlock()
+	count := 0
+	for _, msgs := range na.Notifications {
+		for _, n := range msgs {
+			if n.Type.AtLeast(min) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 // DispatchNotification is used to send a notification to the NotificationAggregator
 func (na *NotificationAggregator) DispatchNotification(notification Notification, ProviderName string) {
 	na.mutex.Lock()
@@ -58,8 +121,20 @@ func (na *NotificationAggregator) DispatchNotification(notification Notification
 	na.mutex.Unlock()
 }
 
+// DispatchSecurityFinding is used to report a security feature requiring manual
+// migration to the NotificationAggregator
+func (na *NotificationAggregator) DispatchSecurityFinding(finding SecurityFinding, ProviderName string) {
+	na.mutex.Lock()
+	if na.SecurityFindings == nil {
+		na.SecurityFindings = map[string][]SecurityFinding{}
+	}
+	na.SecurityFindings[ProviderName] = append(na.SecurityFindings[ProviderName], finding)
+	na.mutex.Unlock()
+}
+
 // CreateNotificationTables takes all generated notifications and returns a map[string]string
-// that displays the notifications in a tabular format based on provider
+// that displays the notifications in a tabular format based on provider. Security findings
+// are appended to the provider's table as a checklist.
 func (na *NotificationAggregator) CreateNotificationTables() map[string]string {
 	notificationTablesMap := make(map[string]string)
 
@@ -71,8 +146,12 @@ func (na *NotificationAggregator) CreateNotificationTables() map[string]string {
 		t.SetColWidth(200)
 		t.SetRowLine(true)
 
-		for _, n := range msgs {
-			row := []string{string(n.Type), n.Message, convertObjectsToStr(n.CallingObjects)}
+		for _, n := range aggregateNotifications(msgs) {
+			message := n.message
+			if n.count > 1 {
+				message = fmt.Sprintf("%s (%d occurrences)", message, n.count)
+			}
+			row := []string{string(n.mType), message, truncateObjects(n.objects)}
 			t.Append(row)
 		}
 
@@ -81,9 +160,97 @@ func (na *NotificationAggregator) CreateNotificationTables() map[string]string {
 		notificationTablesMap[provider] = providerTable.String()
 	}
 
+	for provider, findings := range na.SecurityFindings {
+		if len(findings) == 0 {
+			continue
+		}
+		notificationTablesMap[provider] += createSecurityFindingsTable(provider, findings)
+	}
+
 	return notificationTablesMap
 }
 
+// maxListedObjects is the number of calling objects listed for an aggregated
+// notification before the remaining ones are only counted.
+const maxListedObjects = 10
+
+// aggregatedNotification groups the notifications with the same type and message.
+type aggregatedNotification struct {
+	mType   MessageType
+	message string
+	count   int
+	objects []string
+}
+
+// aggregateNotifications groups identical notifications, in the order they were
+// first dispatched, and lists each of their calling objects once. Blockers are
+// listed first, so they are not lost among the other notifications.
+func aggregateNotifications(msgs []Notification) []aggregatedNotification {
+	type notificationKey struct {
+		mType   MessageType
+		message string
+	}
+
+	var aggregated []aggregatedNotification
+	indexByKey := map[notificationKey]int{}
+	for _, n := range msgs {
+		key := notificationKey{mType: n.Type, message: n.Message}
+		i, ok := indexByKey[key]
+		if !ok {
+			i = len(aggregated)
+			indexByKey[key] = i
+			aggregated = append(aggregated, aggregatedNotification{mType: n.Type, message: n.Message})
+		}
+		aggregated[i].count++
+		for _, o := range n.CallingObjects {
+			object := convertObjectsToStr([]client.Object{o})
+			if !slices.Contains(aggregated[i].objects, object) {
+				aggregated[i].objects = append(aggregated[i].objects, object)
+			}
+		}
+	}
+	slices.SortStableFunc(aggregated, func(a, b aggregatedNotification) int {
+		return cmp.Compare(blockerRank(a.mType), blockerRank(b.mType))
+	})
+	return aggregated
+}
+
+// blockerRank sorts the blockers before the other message types.
+func blockerRank(t MessageType) int {
+	if t == BlockerNotification {
+		return 0
+	}
+	return 1
+}
+
+// truncateObjects joins the objects, listing at most maxListedObjects of them.
+func truncateObjects(objects []string) string {
+	if len(objects) <= maxListedObjects {
+		return strings.Join(objects, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(objects[:maxListedObjects], ", "), len(objects)-maxListedObjects)
+}
+
+// createSecurityFindingsTable renders the security findings of a provider as a
+// checklist which can be handed over to the team owning the security policies.
+func createSecurityFindingsTable(provider string, findings []SecurityFinding) string {
+	findingsTable := strings.Builder{}
+
+	t := tablewriter.NewWriter(&findingsTable)
+	t.SetHeader([]string{"Done", "Security Feature", "Reference", "Source Object", "Affected Routes"})
+	t.SetColWidth(200)
+	t.SetRowLine(true)
+
+	for _, f := range findings {
+		row := []string{"[ ]", f.Feature, f.Reference, convertObjectsToStr(f.CallingObjects), strings.Join(f.Routes, ", ")}
+		t.Append(row)
+	}
+
+	findingsTable.WriteString(fmt.Sprintf("Security features requiring manual migration from %v:\n", strings.ToUpper(provider)))
+	t.Render()
+	return findingsTable.String()
+}
+
 func convertObjectsToStr(ob []client.Object) string {
 	var sb strings.Builder
 
@@ -17,6 +17,7 @@ limitations under the License.
 package notifications
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -208,3 +209,107 @@ func TestConvertObjectsToStr(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateNotificationTablesWithSecurityFindings(t *testing.T) {
+	na := NotificationAggregator{
+		Notifications: map[string][]Notification{
+			"nginx": {{Type: InfoNotification, Message: "info message"}},
+		},
+	}
+	na.DispatchSecurityFinding(SecurityFinding{
+		Feature:   "App Protect WAF",
+		Reference: "default/waf-policy",
+		Routes:    []string{"HTTPRoute: default/app-foo-com"},
+		CallingObjects: []client.Object{
+			&networkingv1.Ingress{
+				TypeMeta:   metav1.TypeMeta{Kind: "Ingress"},
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			},
+		},
+	}, "nginx")
+	na.DispatchSecurityFinding(SecurityFinding{Feature: "App Protect DoS", Reference: "default/dos"}, "apisix")
+
+	result := na.CreateNotificationTables()
+
+	assert.Equal(t, `Notifications from NGINX:
++--------------+--------------+----------------+
+| MESSAGE TYPE | NOTIFICATION | CALLING OBJECT |
++--------------+--------------+----------------+
+| INFO         | info message |                |
++--------------+--------------+----------------+
+Security features requiring manual migration from NGINX:
++------+------------------+--------------------+----------------------+--------------------------------+
+| DONE | SECURITY FEATURE |     REFERENCE      |    SOURCE OBJECT     |        AFFECTED ROUTES         |
++------+------------------+--------------------+----------------------+--------------------------------+
+| [ ]  | App Protect WAF  | default/waf-policy | Ingress: default/app | HTTPRoute: default/app-foo-com |
++------+------------------+--------------------+----------------------+--------------------------------+
+`, result["nginx"])
+	assert.Equal(t, `Security features requiring manual migration from APISIX:
++------+------------------+-------------+---------------+-----------------+
+| DONE | SECURITY FEATURE |  REFERENCE  | SOURCE OBJECT | AFFECTED ROUTES |
++------+------------------+-------------+---------------+-----------------+
+| [ ]  | App Protect DoS  | default/dos |               |                 |
++------+------------------+-------------+---------------+-----------------+
+`, result["apisix"])
+}
+
+func TestAggregateNotifications(t *testing.T) {
+	ingress := func(name string) client.Object {
+		return &networkingv1.Ingress{
+			TypeMeta:   metav1.TypeMeta{Kind: "Ingress"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		}
+	}
+
+	var msgs []Notification
+	for i := 0; i < 12; i++ {
+		msgs = append(msgs, NewNotification(WarningNotification, "field not converted", ingress(fmt.Sprintf("ingress-%02d", i))))
+	}
+	msgs = append(msgs,
+		NewNotification(InfoNotification, "field not converted", ingress("ingress-00")),
+		NewNotification(WarningNotification, "field not converted", ingress("ingress-00")),
+	)
+
+	aggregated := aggregateNotifications(msgs)
+	if len(aggregated) != 2 {
+		t.Fatalf("Expected 2 aggregated notifications, got %d", len(aggregated))
+	}
+	assert.Equal(t, WarningNotification, aggregated[0].mType)
+	assert.Equal(t, 13, aggregated[0].count)
+	assert.Len(t, aggregated[0].objects, 12)
+	assert.Equal(t, "Ingress: default/ingress-00, Ingress: default/ingress-01, Ingress: default/ingress-02, Ingress: default/ingress-03, "+
+		"Ingress: default/ingress-04, Ingress: default/ingress-05, Ingress: default/ingress-06, Ingress: default/ingress-07, "+
+		"Ingress: default/ingress-08, Ingress: default/ingress-09 and 2 more", truncateObjects(aggregated[0].objects))
+	assert.Equal(t, InfoNotification, aggregated[1].mType)
+	assert.Equal(t, 1, aggregated[1].count)
+}
+
+func TestAggregateNotificationsBlockersFirst(t *testing.T) {
+	msgs := []Notification{
+		NewNotification(InfoNotification, "info"),
+		NewNotification(WarningNotification, "warning"),
+		NewNotification(BlockerNotification, "first blocker"),
+		NewNotification(ErrorNotification, "error"),
+		NewNotification(BlockerNotification, "second blocker"),
+	}
+
+	var messages []string
+	for _, n := range aggregateNotifications(msgs) {
+		messages = append(messages, n.message)
+	}
+	assert.Equal(t, []string{"first blocker", "second blocker", "info", "warning", "error"}, messages)
+}
+
+func TestMessageTypeAtLeast(t *testing.T) {
+	mType, err := ParseMessageType("error")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assert.True(t, BlockerNotification.AtLeast(mType))
+	assert.True(t, ErrorNotification.AtLeast(mType))
+	assert.False(t, WarningNotification.AtLeast(mType))
+
+	if _, err := ParseMessageType("fatal"); err == nil {
+		t.Errorf("Expected an error for an unknown notification type")
+	}
+}
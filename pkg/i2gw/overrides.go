@@ -0,0 +1,317 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// Overrides holds user supplied per-source-resource tweaks which are applied
+// to the IR after conversion and before the Gateway API resources are emitted.
+type Overrides struct {
+	Overrides []ResourceOverride `json:"overrides"`
+
+	// Mappings are applied to the HTTPRoutes generated from every source
+	// resource.
+	Mappings []MappingRule `json:"mappings,omitempty"`
+
+	// GatewayAttachments attach the generated routes to pre-existing Gateways
+	// instead of the generated ones.
+	GatewayAttachments []GatewayAttachment `json:"gatewayAttachments,omitempty"`
+}
+
+// ResourceOverride declares the tweaks applied to every HTTPRoute generated
+// from the referenced source resource.
+type ResourceOverride struct {
+	// Source identifies the provider resource, e.g. an Ingress.
+	Source OverrideSource `json:"source"`
+
+	// GatewayName replaces the name of the parentRefs of the generated routes.
+	// The generated Gateways they referenced are renamed, or merged into the
+	// generated Gateway of that name.
+	GatewayName string `json:"gatewayName,omitempty"`
+
+	// RouteName replaces the name of the generated route. It is only applied
+	// when the source produced a single HTTPRoute.
+	RouteName string `json:"routeName,omitempty"`
+
+	// ExtraHostnames are appended to the hostnames of the generated routes.
+	ExtraHostnames []string `json:"extraHostnames,omitempty"`
+
+	// ListenerPort attaches the generated routes to dedicated HTTP listeners
+	// on that port, one per hostname.
+	ListenerPort int32 `json:"listenerPort,omitempty"`
+}
+
+// OverrideSource references the source resource an override applies to.
+type OverrideSource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ReadOverridesFromFile reads and validates an overrides file.
+func ReadOverridesFromFile(filename string) (*Overrides, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overrides file %v: %w", filename, err)
+	}
+
+	var overrides Overrides
+	if err = kubeyaml.UnmarshalStrict(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse overrides file %v: %w", filename, err)
+	}
+
+	for i, o := range overrides.Overrides {
+		if o.Source.Kind == "" || o.Source.Namespace == "" || o.Source.Name == "" {
+			return nil, fmt.Errorf("overrides[%d]: source kind, namespace and name are required", i)
+		}
+		if o.ListenerPort < 0 || o.ListenerPort > 65535 {
+			return nil, fmt.Errorf("overrides[%d]: listenerPort %d is out of range", i, o.ListenerPort)
+		}
+	}
+	for i, m := range overrides.Mappings {
+		if err = m.validate(); err != nil {
+			return nil, fmt.Errorf("mappings[%d]: %w", i, err)
+		}
+	}
+	for i, a := range overrides.GatewayAttachments {
+		if _, err = a.target(); err != nil {
+			return nil, fmt.Errorf("gatewayAttachments[%d]: %w", i, err)
+		}
+	}
+	return &overrides, nil
+}
+
+// ApplyOverrides applies the overrides to the routes and gateways of the IR,
+// and returns the indexes of the overrides matching a source resource of the
+// IR. An error is returned when a renamed route collides with another route,
+// or a forced listener port is used by a listener of another protocol.
+func ApplyOverrides(ir *intermediate.IR, overrides *Overrides) (sets.Set[int], error) {
+	matched := sets.New[int]()
+	if overrides == nil {
+		return matched, nil
+	}
+	renamedGateways := sets.New[types.NamespacedName]()
+	for i, o := range overrides.Overrides {
+		var routeKeys []types.NamespacedName
+		for key, routeContext := range ir.HTTPRoutes {
+			if generatedFrom(routeContext, o.Source) {
+				routeKeys = append(routeKeys, key)
+			}
+		}
+		if len(routeKeys) == 0 {
+			continue
+		}
+		matched.Insert(i)
+		if o.RouteName != "" && len(routeKeys) == 1 {
+			renamedKey := types.NamespacedName{Namespace: routeKeys[0].Namespace, Name: o.RouteName}
+			if _, exists := ir.HTTPRoutes[renamedKey]; exists && renamedKey != routeKeys[0] {
+				return nil, fmt.Errorf("overrides[%d]: cannot rename HTTPRoute %s to %s: %s already exists", i, routeKeys[0], renamedKey, renamedKey)
+			}
+		}
+
+		err := intermediate.WalkHTTPRoutes(ir, func(_ types.NamespacedName, routeContext *intermediate.HTTPRouteContext) error {
+			if !generatedFrom(*routeContext, o.Source) {
+				return nil
+			}
+			for _, hostname := range o.ExtraHostnames {
+				if !slices.Contains(routeContext.Spec.Hostnames, gatewayv1.Hostname(hostname)) {
+					routeContext.Spec.Hostnames = append(routeContext.Spec.Hostnames, gatewayv1.Hostname(hostname))
+				}
+			}
+			if o.GatewayName != "" {
+				for i := range routeContext.Spec.ParentRefs {
+					gatewayKey := ParentGatewayKey(routeContext.Namespace, routeContext.Spec.ParentRefs[i])
+					routeContext.Spec.ParentRefs[i].Name = gatewayv1.ObjectName(o.GatewayName)
+					if renameGateway(ir, gatewayKey, ParentGatewayKey(routeContext.Namespace, routeContext.Spec.ParentRefs[i])) {
+						renamedGateways.Insert(gatewayKey)
+					}
+				}
+			}
+			if o.ListenerPort != 0 {
+				if err := forceListenerPort(ir, &routeContext.HTTPRoute, gatewayv1.PortNumber(o.ListenerPort)); err != nil {
+					return err
+				}
+			}
+			if o.RouteName != "" && len(routeKeys) == 1 {
+				routeContext.Name = o.RouteName
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("overrides[%d]: %w", i, err)
+		}
+	}
+
+	// The renamed Gateways are not generated anymore, unless the routes of
+	// other sources still reference them.
+	referenced := referencedGateways(ir)
+	for key := range renamedGateways {
+		if !referenced.Has(key) {
+			delete(ir.Gateways, key)
+		}
+	}
+	return matched, nil
+}
+
+// reportUnmatchedOverrides warns about the overrides matching no source
+// resource of the providers, e.g. because of a typo in their source.
+func reportUnmatchedOverrides(overrides *Overrides, matched sets.Set[int], providerNames []string) {
+	if overrides == nil {
+		return
+	}
+	for i, o := range overrides.Overrides {
+		if matched.Has(i) {
+			continue
+		}
+		message := fmt.Sprintf("overrides[%d] was not applied: no route was generated from %s %s/%s", i, o.Source.Kind, o.Source.Namespace, o.Source.Name)
+		for _, providerName := range providerNames {
+			notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification, message), providerName)
+		}
+	}
+}
+
+// generatedFrom returns whether the HTTPRoute was generated from the given
+// source resource.
+func generatedFrom(routeContext intermediate.HTTPRouteContext, source OverrideSource) bool {
+	return slices.ContainsFunc(routeContext.Sources, func(s intermediate.SourceReference) bool {
+		return s.Kind == source.Kind && s.Namespace == source.Namespace && s.Name == source.Name
+	})
+}
+
+// renameGateway makes the generated Gateway available under the new key: a
+// copy of it is created when no Gateway has that key, and its listeners are
+// added to the existing Gateway otherwise. It returns false when the Gateway
+// was not generated, e.g. a pre-existing one, or already has the new key.
+func renameGateway(ir *intermediate.IR, key, newKey types.NamespacedName) bool {
+	gatewayContext, ok := ir.Gateways[key]
+	if !ok || key == newKey {
+		return false
+	}
+	renamed, exists := ir.Gateways[newKey]
+	if !exists {
+		renamed = gatewayContext
+		renamed.Gateway = *gatewayContext.Gateway.DeepCopy()
+		renamed.Name = newKey.Name
+		ir.Gateways[newKey] = renamed
+		return true
+	}
+	for _, listener := range gatewayContext.Spec.Listeners {
+		if !slices.ContainsFunc(renamed.Spec.Listeners, func(l gatewayv1.Listener) bool { return l.Name == listener.Name }) {
+			renamed.Spec.Listeners = append(renamed.Spec.Listeners, *listener.DeepCopy())
+		}
+	}
+	ir.Gateways[newKey] = renamed
+	return true
+}
+
+// forceListenerPort attaches the route to dedicated HTTP listeners on the port,
+// one per hostname of the route, on every parent Gateway generated in the IR.
+// The listeners are created when missing, so that the listeners the routes of
+// other sources attach to keep their port. The parentRefs to the listeners of
+// other protocols are kept.
+func forceListenerPort(ir *intermediate.IR, httpRoute *gatewayv1.HTTPRoute, port gatewayv1.PortNumber) error {
+	var parentRefs []gatewayv1.ParentReference
+	addParentRef := func(parentRef gatewayv1.ParentReference) {
+		if !slices.ContainsFunc(parentRefs, func(p gatewayv1.ParentReference) bool { return reflect.DeepEqual(p, parentRef) }) {
+			parentRefs = append(parentRefs, parentRef)
+		}
+	}
+
+	for _, parentRef := range httpRoute.Spec.ParentRefs {
+		gatewayKey := ParentGatewayKey(httpRoute.Namespace, parentRef)
+		gatewayContext, ok := ir.Gateways[gatewayKey]
+		if !ok {
+			addParentRef(parentRef)
+			continue
+		}
+
+		for _, listener := range gatewayContext.Spec.Listeners {
+			if listener.Protocol == gatewayv1.HTTPProtocolType {
+				continue
+			}
+			if parentRef.SectionName != nil {
+				if *parentRef.SectionName == listener.Name {
+					addParentRef(parentRef)
+				}
+				continue
+			}
+			if ListenerAcceptsHostnames(listener, httpRoute.Spec.Hostnames) {
+				listenerRef := *parentRef.DeepCopy()
+				listenerRef.SectionName = ptr.To(listener.Name)
+				addParentRef(listenerRef)
+			}
+		}
+
+		hostnames := httpRoute.Spec.Hostnames
+		if len(hostnames) == 0 {
+			hostnames = []gatewayv1.Hostname{""}
+		}
+		for _, hostname := range hostnames {
+			listenerName, err := ensurePortListener(&gatewayContext.Gateway, hostname, port)
+			if err != nil {
+				return err
+			}
+			listenerRef := *parentRef.DeepCopy()
+			listenerRef.SectionName = ptr.To(listenerName)
+			listenerRef.Port = nil
+			addParentRef(listenerRef)
+		}
+		ir.Gateways[gatewayKey] = gatewayContext
+	}
+	httpRoute.Spec.ParentRefs = parentRefs
+	return nil
+}
+
+// ensurePortListener returns the name of the HTTP listener of the Gateway for
+// the hostname and port, which is added when missing, e.g. foo-example-com-http-8080.
+func ensurePortListener(gateway *gatewayv1.Gateway, hostname gatewayv1.Hostname, port gatewayv1.PortNumber) (gatewayv1.SectionName, error) {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Port != port {
+			continue
+		}
+		if listener.Protocol != gatewayv1.HTTPProtocolType {
+			return "", fmt.Errorf("port %d is used by the %s listener %s of Gateway %s/%s", port, listener.Protocol, listener.Name, gateway.Namespace, gateway.Name)
+		}
+		if ptr.Deref(listener.Hostname, "") == hostname {
+			return listener.Name, nil
+		}
+	}
+
+	name := gatewayv1.SectionName(fmt.Sprintf("%s-http-%d", hostLabel(string(hostname)), port))
+	if slices.ContainsFunc(gateway.Spec.Listeners, func(l gatewayv1.Listener) bool { return l.Name == name }) {
+		return "", fmt.Errorf("cannot add the listener %s on port %d to Gateway %s/%s: a listener already has that name", name, port, gateway.Namespace, gateway.Name)
+	}
+	listener := gatewayv1.Listener{Name: name, Port: port, Protocol: gatewayv1.HTTPProtocolType}
+	if hostname != "" {
+		listener.Hostname = ptr.To(hostname)
+	}
+	gateway.Spec.Listeners = append(gateway.Spec.Listeners, listener)
+	return name, nil
+}
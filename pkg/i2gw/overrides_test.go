@@ -0,0 +1,302 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_ReadOverridesFromFile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		content       string
+		expectedError bool
+	}{{
+		name: "valid overrides",
+		content: `
+overrides:
+- source:
+    kind: Ingress
+    namespace: default
+    name: example
+  gatewayName: shared
+  extraHostnames: ["alias.example.com"]
+  listenerPort: 8080
+`,
+	}, {
+		name: "missing source name",
+		content: `
+overrides:
+- source:
+    kind: Ingress
+  gatewayName: shared
+`,
+		expectedError: true,
+	}, {
+		name: "missing source namespace",
+		content: `
+overrides:
+- source:
+    kind: Ingress
+    name: example
+  gatewayName: shared
+`,
+		expectedError: true,
+	}, {
+		name: "unknown field",
+		content: `
+overrides:
+- source:
+    kind: Ingress
+    name: example
+  gateway: shared
+`,
+		expectedError: true,
+	}, {
+		name: "port out of range",
+		content: `
+overrides:
+- source:
+    kind: Ingress
+    name: example
+  listenerPort: 70000
+`,
+		expectedError: true,
+	}, {
+		name: "valid gateway attachments",
+		content: `
+gatewayAttachments:
+- gateway: infra/shared
+- namespace: team-a
+  gateway: infra/team-a:https
+`,
+	}, {
+		name: "invalid gateway attachment",
+		content: `
+gatewayAttachments:
+- namespace: team-a
+  gateway: shared
+`,
+		expectedError: true,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "overrides.yaml")
+			if err := os.WriteFile(filename, []byte(tc.content), 0o600); err != nil {
+				t.Fatalf("failed to write overrides file: %v", err)
+			}
+			_, err := ReadOverridesFromFile(filename)
+			if tc.expectedError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tc.expectedError && err != nil {
+				t.Errorf("Expected no error but got %v", err)
+			}
+		})
+	}
+}
+
+func Test_ApplyOverrides(t *testing.T) {
+	routeKey := types.NamespacedName{Namespace: "default", Name: "example-foo-com"}
+	otherRouteKey := types.NamespacedName{Namespace: "default", Name: "other-foo-com"}
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	hostname := gatewayv1.Hostname("foo.com")
+	route := func(key types.NamespacedName, source string) intermediate.HTTPRouteContext {
+		return intermediate.HTTPRouteContext{
+			HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx"}}},
+					Hostnames:       []gatewayv1.Hostname{hostname},
+				},
+			},
+			Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: source}},
+		}
+	}
+
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			gatewayKey: {Gateway: gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+					{Name: "foo-com-http", Hostname: &hostname, Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+					{Name: "foo-com-https", Hostname: &hostname, Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+				}},
+			}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			routeKey:      route(routeKey, "example"),
+			otherRouteKey: route(otherRouteKey, "other"),
+		},
+	}
+
+	matched, err := ApplyOverrides(&ir, &Overrides{Overrides: []ResourceOverride{{
+		Source:         OverrideSource{Kind: "Ingress", Namespace: "default", Name: "example"},
+		GatewayName:    "shared",
+		RouteName:      "custom",
+		ExtraHostnames: []string{"alias.foo.com", "foo.com"},
+		ListenerPort:   8080,
+	}, {
+		Source:      OverrideSource{Kind: "Ingress", Namespace: "default", Name: "missing"},
+		GatewayName: "unused",
+	}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{0}, sets.List(matched)); diff != "" {
+		t.Errorf("Unexpected matched overrides (-want +got):\n%s", diff)
+	}
+
+	if _, ok := ir.HTTPRoutes[routeKey]; ok {
+		t.Errorf("Expected HTTPRoute %s to be renamed", routeKey)
+	}
+	httpRoute, ok := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "custom"}]
+	if !ok {
+		t.Fatalf("Expected HTTPRoute default/custom to exist")
+	}
+	if httpRoute.Name != "custom" {
+		t.Errorf("Expected HTTPRoute name to be custom, got %s", httpRoute.Name)
+	}
+	if diff := cmp.Diff([]gatewayv1.Hostname{"foo.com", "alias.foo.com"}, httpRoute.Spec.Hostnames); diff != "" {
+		t.Errorf("Unexpected hostnames (-want +got):\n%s", diff)
+	}
+	expectedParentRefs := []gatewayv1.ParentReference{
+		{Name: "shared", SectionName: ptr.To[gatewayv1.SectionName]("foo-com-https")},
+		{Name: "shared", SectionName: ptr.To[gatewayv1.SectionName]("foo-com-http-8080")},
+		{Name: "shared", SectionName: ptr.To[gatewayv1.SectionName]("alias-foo-com-http-8080")},
+	}
+	if diff := cmp.Diff(expectedParentRefs, httpRoute.Spec.ParentRefs); diff != "" {
+		t.Errorf("Unexpected parentRefs (-want +got):\n%s", diff)
+	}
+
+	listenerPorts := func(key types.NamespacedName) map[gatewayv1.SectionName]gatewayv1.PortNumber {
+		ports := map[gatewayv1.SectionName]gatewayv1.PortNumber{}
+		for _, listener := range ir.Gateways[key].Spec.Listeners {
+			ports[listener.Name] = listener.Port
+		}
+		return ports
+	}
+	// The Gateway is still referenced by the route of the other Ingress, whose
+	// listener keeps its port.
+	if diff := cmp.Diff(map[gatewayv1.SectionName]gatewayv1.PortNumber{"foo-com-http": 80, "foo-com-https": 443}, listenerPorts(gatewayKey)); diff != "" {
+		t.Errorf("Unexpected listeners of Gateway %s (-want +got):\n%s", gatewayKey, diff)
+	}
+	expectedShared := map[gatewayv1.SectionName]gatewayv1.PortNumber{"foo-com-http": 80, "foo-com-https": 443, "foo-com-http-8080": 8080, "alias-foo-com-http-8080": 8080}
+	if diff := cmp.Diff(expectedShared, listenerPorts(types.NamespacedName{Namespace: "default", Name: "shared"})); diff != "" {
+		t.Errorf("Unexpected listeners of Gateway default/shared (-want +got):\n%s", diff)
+	}
+}
+
+func Test_ApplyOverridesRenamesGateway(t *testing.T) {
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			gatewayKey: {Gateway: gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+				Spec:       gatewayv1.GatewaySpec{GatewayClassName: "nginx", Listeners: []gatewayv1.Listener{{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType}}},
+			}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "default", Name: "example"}: {
+				HTTPRoute: gatewayv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"},
+					Spec:       gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx"}}}},
+				},
+				Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "example"}},
+			},
+		},
+	}
+
+	_, err := ApplyOverrides(&ir, &Overrides{Overrides: []ResourceOverride{{
+		Source:      OverrideSource{Kind: "Ingress", Namespace: "default", Name: "example"},
+		GatewayName: "shared",
+	}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := ir.Gateways[gatewayKey]; ok {
+		t.Errorf("Expected Gateway %s to be renamed", gatewayKey)
+	}
+	gateway, ok := ir.Gateways[types.NamespacedName{Namespace: "default", Name: "shared"}]
+	if !ok {
+		t.Fatalf("Expected Gateway default/shared to exist, got %v", ir.Gateways)
+	}
+	if gateway.Name != "shared" || gateway.Spec.GatewayClassName != "nginx" || len(gateway.Spec.Listeners) != 1 {
+		t.Errorf("Expected Gateway default/shared to be a copy of %s, got %v", gatewayKey, gateway.Gateway)
+	}
+}
+
+func Test_ApplyOverridesErrors(t *testing.T) {
+	newIR := func() intermediate.IR {
+		route := func(name string) intermediate.HTTPRouteContext {
+			return intermediate.HTTPRouteContext{
+				HTTPRoute: gatewayv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+					Spec:       gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx"}}}},
+				},
+				Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: name}},
+			}
+		}
+		return intermediate.IR{
+			Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+				{Namespace: "default", Name: "nginx"}: {Gateway: gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+					Spec:       gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType}}},
+				}},
+			},
+			HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+				{Namespace: "default", Name: "a"}: route("a"),
+				{Namespace: "default", Name: "b"}: route("b"),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		override      ResourceOverride
+		expectedError string
+	}{{
+		name:          "route name collision",
+		override:      ResourceOverride{Source: OverrideSource{Kind: "Ingress", Namespace: "default", Name: "a"}, RouteName: "b"},
+		expectedError: "overrides[0]: cannot rename HTTPRoute default/a to default/b: default/b already exists",
+	}, {
+		name:          "listener port of another protocol",
+		override:      ResourceOverride{Source: OverrideSource{Kind: "Ingress", Namespace: "default", Name: "a"}, ListenerPort: 443},
+		expectedError: "overrides[0]: port 443 is used by the HTTPS listener https of Gateway default/nginx",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ir := newIR()
+			_, err := ApplyOverrides(&ir, &Overrides{Overrides: []ResourceOverride{tc.override}})
+			if err == nil || err.Error() != tc.expectedError {
+				t.Errorf("Expected error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Provenance lists the provider resources the generated Gateways and
+// HTTPRoutes were generated from.
+type Provenance struct {
+	Gateways   map[types.NamespacedName][]intermediate.SourceReference
+	HTTPRoutes map[types.NamespacedName][]intermediate.SourceReference
+
+	// Manifests holds the manifests of the sources, keyed by their digest.
+	Manifests map[string][]byte
+}
+
+// SourceRetention controls how much of the source resources is retained in
+// the provenance comments.
+type SourceRetention string
+
+const (
+	// SourceRetentionNone names the source resources only.
+	SourceRetentionNone SourceRetention = "none"
+	// SourceRetentionDigest adds the digest of the source manifests.
+	SourceRetentionDigest SourceRetention = "digest"
+	// SourceRetentionManifest adds the digest and the source manifests.
+	SourceRetentionManifest SourceRetention = "manifest"
+)
+
+// SourceRetentions lists the supported SourceRetention values.
+var SourceRetentions = []SourceRetention{SourceRetentionNone, SourceRetentionDigest, SourceRetentionManifest}
+
+// ProvenanceComments returns one YAML comment line per source resource.
+func ProvenanceComments(sources []intermediate.SourceReference) []string {
+	return Provenance{}.Comments(sources, SourceRetentionNone)
+}
+
+// Comments returns one YAML comment line per source resource, followed by the
+// digest or the manifest of the source depending on the retention. The
+// manifest is commented out as indented JSON, so it can be diffed with the
+// input.
+func (p Provenance) Comments(sources []intermediate.SourceReference, retention SourceRetention) []string {
+	comments := make([]string, 0, len(sources))
+	for _, source := range sources {
+		comment := fmt.Sprintf("# Generated from %s %s/%s", source.Kind, source.Namespace, source.Name)
+		if retention != SourceRetentionNone && source.Digest != "" {
+			comment = fmt.Sprintf("%s (%s)", comment, source.Digest)
+		}
+		comments = append(comments, comment)
+
+		manifest, ok := p.Manifests[source.Digest]
+		if retention != SourceRetentionManifest || !ok {
+			continue
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, manifest, "", "  "); err != nil {
+			continue
+		}
+		for _, line := range strings.Split(indented.String(), "\n") {
+			comments = append(comments, "#   "+line)
+		}
+	}
+	return comments
+}
+
+// provenanceFromIR collects the sources of every HTTPRoute in the IR. A Gateway
+// is attributed the sources of all the HTTPRoutes attached to it.
+func provenanceFromIR(ir intermediate.IR) Provenance {
+	provenance := Provenance{
+		Gateways:   make(map[types.NamespacedName][]intermediate.SourceReference),
+		HTTPRoutes: make(map[types.NamespacedName][]intermediate.SourceReference),
+		Manifests:  make(map[string][]byte),
+	}
+	for key, routeContext := range ir.HTTPRoutes {
+		if len(routeContext.Sources) == 0 {
+			continue
+		}
+		provenance.HTTPRoutes[key] = sortedSources(routeContext.Sources)
+		for _, source := range routeContext.Sources {
+			if manifest, ok := ir.SourceManifests[source.Digest]; ok {
+				provenance.Manifests[source.Digest] = manifest
+			}
+		}
+
+		for _, parentRef := range routeContext.Spec.ParentRefs {
+			if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+				continue
+			}
+			gatewayKey := types.NamespacedName{Namespace: key.Namespace, Name: string(parentRef.Name)}
+			if parentRef.Namespace != nil {
+				gatewayKey.Namespace = string(*parentRef.Namespace)
+			}
+			if _, ok := ir.Gateways[gatewayKey]; !ok {
+				continue
+			}
+			provenance.Gateways[gatewayKey] = sortedSources(append(provenance.Gateways[gatewayKey], routeContext.Sources...))
+		}
+	}
+	return provenance
+}
+
+// sortedSources returns the sources sorted and without duplicates.
+func sortedSources(sources []intermediate.SourceReference) []intermediate.SourceReference {
+	sorted := slices.Clone(sources)
+	slices.SortFunc(sorted, compareSources)
+	return slices.Compact(sorted)
+}
+
+func compareSources(a, b intermediate.SourceReference) int {
+	return cmp.Or(
+		strings.Compare(a.Kind, b.Kind),
+		strings.Compare(a.Namespace, b.Namespace),
+		strings.Compare(a.Name, b.Name),
+	)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_provenanceFromIR(t *testing.T) {
+	foo := intermediate.SourceReference{Kind: "Ingress", Namespace: "default", Name: "foo"}
+	bar := intermediate.SourceReference{Kind: "Ingress", Namespace: "default", Name: "bar"}
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	fooRouteKey := types.NamespacedName{Namespace: "default", Name: "foo-example-com"}
+	barRouteKey := types.NamespacedName{Namespace: "default", Name: "bar-example-com"}
+	parentRefs := []gatewayv1.ParentReference{{Name: "nginx"}}
+
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{gatewayKey: {}},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			fooRouteKey: {
+				HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs}}},
+				Sources:   []intermediate.SourceReference{foo, foo},
+			},
+			barRouteKey: {
+				HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs}}},
+				Sources:   []intermediate.SourceReference{bar},
+			},
+			{Namespace: "default", Name: "unknown"}: {},
+		},
+	}
+
+	expected := Provenance{
+		Gateways: map[types.NamespacedName][]intermediate.SourceReference{gatewayKey: {bar, foo}},
+		HTTPRoutes: map[types.NamespacedName][]intermediate.SourceReference{
+			fooRouteKey: {foo},
+			barRouteKey: {bar},
+		},
+		Manifests: map[string][]byte{},
+	}
+
+	if diff := cmp.Diff(expected, provenanceFromIR(ir)); diff != "" {
+		t.Errorf("Unexpected provenance (-want +got):\n%s", diff)
+	}
+
+	comments := ProvenanceComments(expected.Gateways[gatewayKey])
+	if diff := cmp.Diff([]string{"# Generated from Ingress default/bar", "# Generated from Ingress default/foo"}, comments); diff != "" {
+		t.Errorf("Unexpected comments (-want +got):\n%s", diff)
+	}
+}
+
+func TestProvenanceCommentsSourceRetention(t *testing.T) {
+	manifest := []byte(`{"kind":"Ingress","metadata":{"name":"foo"}}`)
+	foo := intermediate.SourceReference{Kind: "Ingress", Namespace: "default", Name: "foo", Digest: intermediate.ManifestDigest(manifest)}
+	provenance := Provenance{Manifests: map[string][]byte{foo.Digest: manifest}}
+
+	testCases := []struct {
+		retention SourceRetention
+		expected  []string
+	}{
+		{
+			retention: SourceRetentionNone,
+			expected:  []string{"# Generated from Ingress default/foo"},
+		},
+		{
+			retention: SourceRetentionDigest,
+			expected:  []string{"# Generated from Ingress default/foo (" + foo.Digest + ")"},
+		},
+		{
+			retention: SourceRetentionManifest,
+			expected: []string{
+				"# Generated from Ingress default/foo (" + foo.Digest + ")",
+				"#   {",
+				`#     "kind": "Ingress",`,
+				`#     "metadata": {`,
+				`#       "name": "foo"`,
+				"#     }",
+				"#   }",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.retention), func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, provenance.Comments([]intermediate.SourceReference{foo}, tc.retention)); diff != "" {
+				t.Errorf("Unexpected comments (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -37,6 +37,11 @@ import (
 // func at startup.
 var ProviderConstructorByName = map[ProviderName]ProviderConstructor{}
 
+// ProviderConfigParserByName is a map of ProviderConfigParser functions by a
+// provider name. Providers accepting a section in the --config file add their
+// parser at startup.
+var ProviderConfigParserByName = map[ProviderName]ProviderConfigParser{}
+
 // ProviderName is a string alias that stores the concrete Provider name.
 type ProviderName string
 
@@ -44,6 +49,11 @@ type ProviderName string
 // implementations of the Provider interface.
 type ProviderConstructor func(conf *ProviderConf) Provider
 
+// ProviderConfigParser validates the JSON section of the --config file of a
+// provider and returns the values of the provider-specific flags it sets, by
+// flag name.
+type ProviderConfigParser func(data []byte) (map[string]string, error)
+
 // ProviderConf contains all the configuration required for every concrete
 // Provider implementation.
 type ProviderConf struct {
@@ -72,6 +82,14 @@ type CustomResourceReader interface {
 	ReadResourcesFromFile(ctx context.Context, filename string) error
 }
 
+// ObjectReader is implemented by the providers reading their resources from
+// objects already decoded, e.g. the objects admitted by the webhook.
+type ObjectReader interface {
+	// ReadResourcesFromObjects reads custom resources associated with
+	// the underlying Provider implementation from the objects.
+	ReadResourcesFromObjects(ctx context.Context, objects []*unstructured.Unstructured) error
+}
+
 // The ResourcesToIRConverter interface specifies conversion functions from Ingress
 // and extensions into IR.
 type ResourcesToIRConverter interface {
@@ -114,6 +132,20 @@ type GatewayResources struct {
 	ReferenceGrants    map[types.NamespacedName]gatewayv1beta1.ReferenceGrant
 
 	GatewayExtensions []unstructured.Unstructured
+
+	// Sources lists the provider resources each Gateway and HTTPRoute was
+	// generated from.
+	Sources Provenance
+
+	// Inventory lists the source objects read by the provider with their
+	// disposition. It is empty when the provider does not implement
+	// SourceObjectLister.
+	Inventory []InventoryEntry
+
+	// AnnotationUsage lists the annotations of the provider used by the source
+	// objects. It is empty when the provider does not implement
+	// AnnotationUsageReporter.
+	AnnotationUsage []AnnotationUsage
 }
 
 // FeatureParser is a function that reads the Ingresses, and applies
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ReadProviderConfigFile reads the YAML or JSON configuration file, which has a
+// section per provider, and returns the values of the provider-specific flags
+// set by every section, by provider and flag name.
+func ReadProviderConfigFile(path string) (map[string]map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	data, err = kubeyaml.ToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	sections := map[string]json.RawMessage{}
+	if err = json.Unmarshal(data, &sections); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	flags := make(map[string]map[string]string, len(sections))
+	for provider, section := range sections {
+		parse, ok := ProviderConfigParserByName[ProviderName(provider)]
+		if !ok {
+			return nil, fmt.Errorf("config file %s: %s is not a provider with a configuration section", path, provider)
+		}
+		if flags[provider], err = parse(section); err != nil {
+			return nil, fmt.Errorf("config file %s: invalid %s section: %w", path, provider, err)
+		}
+	}
+	return flags, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -56,15 +57,23 @@ func (r *resourceReader) readResourcesFromCluster(ctx context.Context) (*storage
 
 func (r *resourceReader) readResourcesFromFile(filename string) (*storage, error) {
 	// read cilium related resources from file.
+	objects, err := common.ExtractObjectsFromPath(filename, r.conf.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return r.readResourcesFromObjects(objects)
+}
+
+func (r *resourceReader) readResourcesFromObjects(objects []*unstructured.Unstructured) (*storage, error) {
 	storage := newResourcesStorage()
 
-	ingresses, err := common.ReadIngressesFromFile(filename, r.conf.Namespace, sets.New[string](CiliumIngressClass))
+	ingresses, err := common.ReadIngressesFromObjects(objects, sets.New[string](CiliumIngressClass))
 	if err != nil {
 		return nil, err
 	}
 	storage.Ingresses = ingresses
 
-	services, err := common.ReadServicesFromFile(filename, r.conf.Namespace)
+	services, err := common.ReadServicesFromObjects(objects)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ package common
 
 import (
 	"cmp"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
@@ -39,8 +40,10 @@ import (
 // consideration any provider specific logic.
 func ToIR(ingresses []networkingv1.Ingress, servicePorts map[types.NamespacedName]map[string]int32, options i2gw.ProviderImplementationSpecificOptions) (intermediate.IR, field.ErrorList) {
 	aggregator := ingressAggregator{
-		ruleGroups:   map[ruleGroupKey]*ingressRuleGroup{},
-		servicePorts: servicePorts,
+		ruleGroups:      map[ruleGroupKey]*ingressRuleGroup{},
+		servicePorts:    servicePorts,
+		sourceDigests:   map[types.NamespacedName]string{},
+		sourceManifests: map[string][]byte{},
 	}
 
 	var errs field.ErrorList
@@ -56,10 +59,21 @@ func ToIR(ingresses []networkingv1.Ingress, servicePorts map[types.NamespacedNam
 		return intermediate.IR{}, errs
 	}
 
+	sourcesByRouteKey := aggregator.sourcesByRouteKey()
 	routeByKey := make(map[types.NamespacedName]intermediate.HTTPRouteContext)
 	for _, route := range routes {
 		key := types.NamespacedName{Namespace: route.Namespace, Name: route.Name}
-		routeByKey[key] = intermediate.HTTPRouteContext{HTTPRoute: route}
+		if existing, ok := routeByKey[key]; ok {
+			// Hostnames sanitized to the same name, e.g. foo-bar.com and
+			// foo.bar.com, would otherwise silently drop the rules of one.
+			errs = append(errs, field.Duplicate(field.NewPath("HTTPRoute", key.String()),
+				fmt.Sprintf("hostnames %v and %v generate the same HTTPRoute name", existing.Spec.Hostnames, route.Spec.Hostnames)))
+			continue
+		}
+		routeByKey[key] = intermediate.HTTPRouteContext{HTTPRoute: route, Sources: sourcesByRouteKey[key]}
+	}
+	if len(errs) > 0 {
+		return intermediate.IR{}, errs
 	}
 
 	gatewayByKey := make(map[types.NamespacedName]intermediate.GatewayContext)
@@ -79,6 +93,7 @@ func ToIR(ingresses []networkingv1.Ingress, servicePorts map[types.NamespacedNam
 		GRPCRoutes:         make(map[types.NamespacedName]gatewayv1.GRPCRoute),
 		BackendTLSPolicies: make(map[types.NamespacedName]gatewayv1alpha3.BackendTLSPolicy),
 		ReferenceGrants:    make(map[types.NamespacedName]gatewayv1beta1.ReferenceGrant),
+		SourceManifests:    aggregator.sourceManifests,
 	}, nil
 }
 
@@ -120,6 +135,10 @@ type ingressAggregator struct {
 	ruleGroups      map[ruleGroupKey]*ingressRuleGroup
 	defaultBackends []ingressDefaultBackend
 	servicePorts    map[types.NamespacedName]map[string]int32
+	// sourceDigests holds the digest of the manifest of every Ingress, and
+	// sourceManifests the manifests by digest.
+	sourceDigests   map[types.NamespacedName]string
+	sourceManifests map[string][]byte
 }
 
 type pathMatchKey string
@@ -131,6 +150,8 @@ type ingressRuleGroup struct {
 	host         string
 	tls          []networkingv1.IngressTLS
 	rules        []ingressRule
+	// sources holds the names of the Ingresses contributing rules to the group.
+	sources []string
 }
 
 type ingressRule struct {
@@ -152,6 +173,11 @@ type ingressPath struct {
 }
 
 func (a *ingressAggregator) addIngress(ingress networkingv1.Ingress) {
+	if manifest, err := sourceManifest(ingress); err == nil {
+		digest := intermediate.ManifestDigest(manifest)
+		a.sourceDigests[types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}] = digest
+		a.sourceManifests[digest] = manifest
+	}
 	ingressClass := GetIngressClass(ingress)
 	for _, rule := range ingress.Spec.Rules {
 		a.addIngressRule(ingress.Namespace, ingress.Name, ingressClass, rule, ingress.Spec)
@@ -182,6 +208,49 @@ func (a *ingressAggregator) addIngressRule(namespace, name, ingressClass string,
 		rg.tls = append(rg.tls, iSpec.TLS...)
 	}
 	rg.rules = append(rg.rules, ingressRule{rule: rule})
+	if !slices.Contains(rg.sources, name) {
+		rg.sources = append(rg.sources, name)
+	}
+}
+
+// sourcesByRouteKey returns the Ingresses each generated HTTPRoute originates
+// from, keyed by the HTTPRoute namespaced name.
+func (a *ingressAggregator) sourcesByRouteKey() map[types.NamespacedName][]intermediate.SourceReference {
+	sources := map[types.NamespacedName][]intermediate.SourceReference{}
+	for _, rg := range a.ruleGroups {
+		key := types.NamespacedName{Namespace: rg.namespace, Name: RouteName(rg.name, rg.host)}
+		for _, name := range rg.sources {
+			sources[key] = append(sources[key], a.sourceReference(rg.namespace, name))
+		}
+	}
+	for _, db := range a.defaultBackends {
+		key := types.NamespacedName{Namespace: db.namespace, Name: fmt.Sprintf("%s-default-backend", db.name)}
+		sources[key] = append(sources[key], a.sourceReference(db.namespace, db.name))
+	}
+	for _, refs := range sources {
+		slices.SortFunc(refs, func(a, b intermediate.SourceReference) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+	}
+	return sources
+}
+
+func (a *ingressAggregator) sourceReference(namespace, name string) intermediate.SourceReference {
+	return intermediate.SourceReference{
+		Kind:      "Ingress",
+		Namespace: namespace,
+		Name:      name,
+		Digest:    a.sourceDigests[types.NamespacedName{Namespace: namespace, Name: name}],
+	}
+}
+
+// sourceManifest returns the JSON manifest of the Ingress as read, without its
+// status and managed fields, which change without the Ingress being edited.
+func sourceManifest(ingress networkingv1.Ingress) ([]byte, error) {
+	ingress = *ingress.DeepCopy()
+	ingress.Status = networkingv1.IngressStatus{}
+	ingress.ManagedFields = nil
+	return json.Marshal(ingress)
 }
 
 func (a *ingressAggregator) toHTTPRoutesAndGateways(options i2gw.ProviderImplementationSpecificOptions) ([]gatewayv1.HTTPRoute, []gatewayv1.Gateway, field.ErrorList) {
@@ -276,20 +345,20 @@ func (a *ingressAggregator) toHTTPRoutesAndGateways(options i2gw.ProviderImpleme
 			gatewaysByKey[gwKey] = gateway
 		}
 		for _, listener := range listeners {
-			var listenerNamePrefix string
-			if listener.Hostname != nil && *listener.Hostname != "" {
-				listenerNamePrefix = fmt.Sprintf("%s-", NameFromHost(string(*listener.Hostname)))
+			var hostname string
+			if listener.Hostname != nil {
+				hostname = string(*listener.Hostname)
 			}
 
 			gateway.Spec.Listeners = append(gateway.Spec.Listeners, gatewayv1.Listener{
-				Name:     gatewayv1.SectionName(fmt.Sprintf("%shttp", listenerNamePrefix)),
+				Name:     ListenerName(hostname, gatewayv1.HTTPProtocolType),
 				Hostname: listener.Hostname,
 				Port:     80,
 				Protocol: gatewayv1.HTTPProtocolType,
 			})
 			if listener.TLS != nil {
 				gateway.Spec.Listeners = append(gateway.Spec.Listeners, gatewayv1.Listener{
-					Name:     gatewayv1.SectionName(fmt.Sprintf("%shttps", listenerNamePrefix)),
+					Name:     ListenerName(hostname, gatewayv1.HTTPSProtocolType),
 					Hostname: listener.Hostname,
 					Port:     443,
 					Protocol: gatewayv1.HTTPSProtocolType,
@@ -301,6 +370,7 @@ func (a *ingressAggregator) toHTTPRoutesAndGateways(options i2gw.ProviderImpleme
 
 	var gateways []gatewayv1.Gateway
 	for _, gw := range gatewaysByKey {
+		DisambiguateListenerNames(gw)
 		gateways = append(gateways, *gw)
 	}
 
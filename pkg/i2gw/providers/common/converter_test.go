@@ -601,3 +601,96 @@ func Test_ToIR(t *testing.T) {
 		})
 	}
 }
+
+func Test_ToIRSourceManifests(t *testing.T) {
+	ingress := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "foo",
+			Namespace:     "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: PtrTo("nginx"),
+			Rules: []networkingv1.IngressRule{{
+				Host: "example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: PtrTo(networkingv1.PathTypePrefix),
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: "foo",
+							Port: networkingv1.ServiceBackendPort{Number: 80},
+						}},
+					}},
+				}},
+			}},
+		},
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}},
+		}},
+	}
+
+	ir, errs := ToIR([]networkingv1.Ingress{ingress}, nil, i2gw.ProviderImplementationSpecificOptions{})
+	if len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	sources := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "foo-example-com"}].Sources
+	if len(sources) != 1 || sources[0].Digest == "" {
+		t.Fatalf("Expected a single source with a digest, got %+v", sources)
+	}
+	manifest, ok := ir.SourceManifests[sources[0].Digest]
+	if !ok {
+		t.Fatalf("Expected the manifest of digest %s to be retained", sources[0].Digest)
+	}
+	if digest := intermediate.ManifestDigest(manifest); digest != sources[0].Digest {
+		t.Errorf("Expected the manifest digest to be %s, got %s", sources[0].Digest, digest)
+	}
+
+	// The status and managed fields change without the Ingress being edited.
+	ingress.Status = networkingv1.IngressStatus{}
+	ingress.ManagedFields = nil
+	unchanged, _ := ToIR([]networkingv1.Ingress{ingress}, nil, i2gw.ProviderImplementationSpecificOptions{})
+	if diff := cmp.Diff(ir.SourceManifests, unchanged.SourceManifests); diff != "" {
+		t.Errorf("Expected the manifest to ignore status and managed fields (-want +got):\n%s", diff)
+	}
+}
+
+func Test_ToIRNameCollisions(t *testing.T) {
+	ingress := func(name string, hosts ...string) networkingv1.Ingress {
+		ingress := networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       networkingv1.IngressSpec{IngressClassName: PtrTo("nginx")},
+		}
+		for _, host := range hosts {
+			ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: PtrTo(networkingv1.PathTypePrefix),
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: name,
+							Port: networkingv1.ServiceBackendPort{Number: 80},
+						}},
+					}},
+				}},
+			})
+		}
+		return ingress
+	}
+
+	ir, errs := ToIR([]networkingv1.Ingress{ingress("a", "foo-bar.com"), ingress("b", "foo.bar.com")}, nil, i2gw.ProviderImplementationSpecificOptions{})
+	if len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	listeners := ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Spec.Listeners
+	if len(listeners) != 2 || listeners[0].Name == listeners[1].Name {
+		t.Errorf("Expected 2 listeners with distinct names, got %+v", listeners)
+	}
+
+	_, errs = ToIR([]networkingv1.Ingress{ingress("a", "foo-bar.com", "foo.bar.com")}, nil, i2gw.ProviderImplementationSpecificOptions{})
+	if len(errs) != 1 || errs[0].Type != field.ErrorTypeDuplicate {
+		t.Errorf("Expected a duplicate HTTPRoute error, got %v", errs)
+	}
+}
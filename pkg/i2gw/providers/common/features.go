@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// SharedFeatureParsers returns the FeatureParsers which apply to the IR built by
+// ToIR regardless of the provider. Providers converting Ingresses with ToIR run
+// them after their own FeatureParsers.
+func SharedFeatureParsers(providerName i2gw.ProviderName) []i2gw.FeatureParser {
+	return []i2gw.FeatureParser{
+		NewRouteMergeFeature(providerName),
+	}
+}
+
+// NewRouteMergeFeature returns a FeatureParser reporting the HTTPRoutes merging
+// the rules of several Ingresses sharing a host. The HTTPRoute is named after
+// the first of them, so the other Ingresses have no HTTPRoute of their own.
+func NewRouteMergeFeature(providerName i2gw.ProviderName) i2gw.FeatureParser {
+	return func(_ []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+		keys := make([]types.NamespacedName, 0, len(ir.HTTPRoutes))
+		for key, httpRouteContext := range ir.HTTPRoutes {
+			if len(httpRouteContext.Sources) > 1 {
+				keys = append(keys, key)
+			}
+		}
+		slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+			return strings.Compare(a.String(), b.String())
+		})
+
+		for _, key := range keys {
+			httpRouteContext := ir.HTTPRoutes[key]
+			names := make([]string, 0, len(httpRouteContext.Sources))
+			for _, source := range httpRouteContext.Sources {
+				names = append(names, source.Name)
+			}
+			message := fmt.Sprintf("HTTPRoute %s merges the rules of Ingresses %s sharing its host", key, strings.Join(names, ", "))
+			notifications.NotificationAggr.DispatchNotification(
+				notifications.NewNotification(notifications.InfoNotification, message, &httpRouteContext.HTTPRoute), string(providerName))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRouteMergeFeature(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	ir := intermediate.IR{
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "default", Name: "first-example-com"}: {
+				Sources: []intermediate.SourceReference{
+					{Kind: "Ingress", Namespace: "default", Name: "first"},
+					{Kind: "Ingress", Namespace: "default", Name: "second"},
+				},
+			},
+			{Namespace: "default", Name: "third-example-org"}: {
+				Sources: []intermediate.SourceReference{
+					{Kind: "Ingress", Namespace: "default", Name: "third"},
+				},
+			},
+		},
+	}
+
+	errs := NewRouteMergeFeature("test")(nil, nil, &ir)
+	require.Empty(t, errs)
+
+	reported := notifications.NotificationAggr.Notifications["test"]
+	require.Len(t, reported, 1)
+	require.Equal(t, notifications.InfoNotification, reported[0].Type)
+	require.Equal(t, "HTTPRoute default/first-example-com merges the rules of Ingresses first, second sharing its host", reported[0].Message)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"slices"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// RequestHeaderModifierFilter returns a RequestHeaderModifier filter setting,
+// adding and removing the headers, in canonical form: see
+// canonicalHeaderFilter.
+func RequestHeaderModifierFilter(set, add []gatewayv1.HTTPHeader, remove []string) gatewayv1.HTTPRouteFilter {
+	return gatewayv1.HTTPRouteFilter{
+		Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: canonicalHeaderFilter(set, add, remove),
+	}
+}
+
+// ResponseHeaderModifierFilter returns a ResponseHeaderModifier filter setting,
+// adding and removing the headers, in canonical form: see
+// canonicalHeaderFilter.
+func ResponseHeaderModifierFilter(set, add []gatewayv1.HTTPHeader, remove []string) gatewayv1.HTTPRouteFilter {
+	return gatewayv1.HTTPRouteFilter{
+		Type:                   gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: canonicalHeaderFilter(set, add, remove),
+	}
+}
+
+// PrefixRewriteFilter returns a URLRewrite filter replacing the matched path
+// prefix.
+func PrefixRewriteFilter(prefix string) gatewayv1.HTTPRouteFilter {
+	return gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterURLRewrite,
+		URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+			Path: &gatewayv1.HTTPPathModifier{
+				Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+				ReplacePrefixMatch: ptr.To(prefix),
+			},
+		},
+	}
+}
+
+// canonicalHeaderFilter returns the header filter with its headers sorted by
+// name, so that filters with the same headers are equal. Empty lists are nil.
+func canonicalHeaderFilter(set, add []gatewayv1.HTTPHeader, remove []string) *gatewayv1.HTTPHeaderFilter {
+	filter := &gatewayv1.HTTPHeaderFilter{}
+	if len(set) > 0 {
+		filter.Set = sortHeaders(slices.Clone(set))
+	}
+	if len(add) > 0 {
+		filter.Add = sortHeaders(slices.Clone(add))
+	}
+	if len(remove) > 0 {
+		filter.Remove = slices.Clone(remove)
+		slices.Sort(filter.Remove)
+	}
+	return filter
+}
+
+func sortHeaders(headers []gatewayv1.HTTPHeader) []gatewayv1.HTTPHeader {
+	slices.SortStableFunc(headers, func(a, b gatewayv1.HTTPHeader) int {
+		return strings.Compare(string(a.Name), string(b.Name))
+	})
+	return headers
+}
+
+// AddHTTPFilter adds a copy of the filter to the filters, unless they already
+// have an identical one. Gateway API accepts a single RequestHeaderModifier and
+// ResponseHeaderModifier filter per rule, so a header modifier is merged into
+// the one of the same type the filters have, as mergeHeaderFilters does. The
+// merged filter is a copy, since filters may be shared between rules.
+func AddHTTPFilter(filters []gatewayv1.HTTPRouteFilter, filter gatewayv1.HTTPRouteFilter) []gatewayv1.HTTPRouteFilter {
+	for i := range filters {
+		if apiequality.Semantic.DeepEqual(filters[i], filter) {
+			return filters
+		}
+		if filters[i].Type != filter.Type {
+			continue
+		}
+		switch {
+		case filter.Type == gatewayv1.HTTPRouteFilterRequestHeaderModifier && filters[i].RequestHeaderModifier != nil && filter.RequestHeaderModifier != nil:
+			filters[i].RequestHeaderModifier = mergeHeaderFilters(*filters[i].RequestHeaderModifier, *filter.RequestHeaderModifier)
+			return filters
+		case filter.Type == gatewayv1.HTTPRouteFilterResponseHeaderModifier && filters[i].ResponseHeaderModifier != nil && filter.ResponseHeaderModifier != nil:
+			filters[i].ResponseHeaderModifier = mergeHeaderFilters(*filters[i].ResponseHeaderModifier, *filter.ResponseHeaderModifier)
+			return filters
+		}
+	}
+	return append(filters, *filter.DeepCopy())
+}
+
+// DeduplicateHTTPFilters returns the filters without the duplicates, and with
+// their header modifiers of the same type merged, as AddHTTPFilter does. The
+// first filter of each kind keeps its position.
+func DeduplicateHTTPFilters(filters []gatewayv1.HTTPRouteFilter) []gatewayv1.HTTPRouteFilter {
+	if len(filters) < 2 {
+		return filters
+	}
+	deduplicated := make([]gatewayv1.HTTPRouteFilter, 0, len(filters))
+	for _, filter := range filters {
+		deduplicated = AddHTTPFilter(deduplicated, filter)
+	}
+	return deduplicated
+}
+
+// mergeHeaderFilters returns the canonical header filter setting, adding and
+// removing the headers of both filters. Header names are compared
+// case-insensitively. The headers next sets replace those base sets or adds,
+// while the headers next adds only replace those base adds: a header base sets
+// stays set.
+func mergeHeaderFilters(base, next gatewayv1.HTTPHeaderFilter) *gatewayv1.HTTPHeaderFilter {
+	configuredIn := func(headers []gatewayv1.HTTPHeader) func(gatewayv1.HTTPHeader) bool {
+		return func(h gatewayv1.HTTPHeader) bool {
+			return slices.ContainsFunc(headers, func(n gatewayv1.HTTPHeader) bool { return strings.EqualFold(string(n.Name), string(h.Name)) })
+		}
+	}
+	set := append(slices.DeleteFunc(slices.Clone(base.Set), configuredIn(next.Set)), next.Set...)
+	add := slices.DeleteFunc(slices.Clone(base.Add), configuredIn(next.Set))
+	add = append(slices.DeleteFunc(add, configuredIn(next.Add)), next.Add...)
+	remove := slices.Clone(base.Remove)
+	for _, name := range next.Remove {
+		if !slices.ContainsFunc(remove, func(r string) bool { return strings.EqualFold(r, name) }) {
+			remove = append(remove, name)
+		}
+	}
+	return canonicalHeaderFilter(set, add, remove)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestHeaderModifierFiltersAreCanonical(t *testing.T) {
+	a := RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-B", Value: "b"}, {Name: "X-A", Value: "a"}}, nil, []string{"X-D", "X-C"})
+	b := RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-A", Value: "a"}, {Name: "X-B", Value: "b"}}, nil, []string{"X-C", "X-D"})
+	require.Equal(t, a, b)
+	require.Nil(t, a.RequestHeaderModifier.Add)
+	require.Equal(t, []string{"X-C", "X-D"}, a.RequestHeaderModifier.Remove)
+}
+
+func TestAddHTTPFilter(t *testing.T) {
+	setFoo := RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "1"}}, nil, nil)
+	rewrite := PrefixRewriteFilter("/")
+
+	testCases := []struct {
+		name     string
+		filters  []gatewayv1.HTTPRouteFilter
+		filter   gatewayv1.HTTPRouteFilter
+		expected []gatewayv1.HTTPRouteFilter
+	}{
+		{
+			name:     "added",
+			filter:   rewrite,
+			expected: []gatewayv1.HTTPRouteFilter{rewrite},
+		},
+		{
+			name:     "identical filter skipped",
+			filters:  []gatewayv1.HTTPRouteFilter{rewrite, setFoo},
+			filter:   RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "1"}}, nil, nil),
+			expected: []gatewayv1.HTTPRouteFilter{rewrite, setFoo},
+		},
+		{
+			name:    "header modifiers merged",
+			filters: []gatewayv1.HTTPRouteFilter{setFoo},
+			filter:  RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Bar", Value: "2"}}, nil, []string{"X-Baz"}),
+			expected: []gatewayv1.HTTPRouteFilter{
+				RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Bar", Value: "2"}, {Name: "X-Foo", Value: "1"}}, nil, []string{"X-Baz"}),
+			},
+		},
+		{
+			name:    "headers of the added filter replace those of the same name",
+			filters: []gatewayv1.HTTPRouteFilter{RequestHeaderModifierFilter(nil, []gatewayv1.HTTPHeader{{Name: "host", Value: "a"}}, nil)},
+			filter:  RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "Host", Value: "b"}}, nil, nil),
+			expected: []gatewayv1.HTTPRouteFilter{
+				RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "Host", Value: "b"}}, nil, nil),
+			},
+		},
+		{
+			name:    "set headers kept when the added filter adds them",
+			filters: []gatewayv1.HTTPRouteFilter{setFoo},
+			filter:  RequestHeaderModifierFilter(nil, []gatewayv1.HTTPHeader{{Name: "x-foo", Value: "2"}}, nil),
+			expected: []gatewayv1.HTTPRouteFilter{
+				RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "1"}}, []gatewayv1.HTTPHeader{{Name: "x-foo", Value: "2"}}, nil),
+			},
+		},
+		{
+			name:    "added headers replaced by those added later",
+			filters: []gatewayv1.HTTPRouteFilter{RequestHeaderModifierFilter(nil, []gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "1"}}, nil)},
+			filter:  RequestHeaderModifierFilter(nil, []gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "2"}}, nil),
+			expected: []gatewayv1.HTTPRouteFilter{
+				RequestHeaderModifierFilter(nil, []gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "2"}}, nil),
+			},
+		},
+		{
+			name:    "set headers replaced by those set later",
+			filters: []gatewayv1.HTTPRouteFilter{setFoo},
+			filter:  RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "2"}}, nil, nil),
+			expected: []gatewayv1.HTTPRouteFilter{
+				RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "2"}}, nil, nil),
+			},
+		},
+		{
+			name:     "request and response header modifiers not merged",
+			filters:  []gatewayv1.HTTPRouteFilter{setFoo},
+			filter:   ResponseHeaderModifierFilter(nil, nil, []string{"Server"}),
+			expected: []gatewayv1.HTTPRouteFilter{setFoo, ResponseHeaderModifierFilter(nil, nil, []string{"Server"})},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, AddHTTPFilter(tc.filters, tc.filter))
+		})
+	}
+}
+
+func TestAddHTTPFilterCopiesSharedFilters(t *testing.T) {
+	shared := RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "1"}}, nil, nil)
+	rules := [][]gatewayv1.HTTPRouteFilter{AddHTTPFilter(nil, shared), AddHTTPFilter(nil, shared)}
+
+	rules[0] = AddHTTPFilter(rules[0], RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "X-Bar", Value: "2"}}, nil, nil))
+	require.Len(t, shared.RequestHeaderModifier.Set, 1)
+	require.Len(t, rules[1][0].RequestHeaderModifier.Set, 1)
+	require.Len(t, rules[0][0].RequestHeaderModifier.Set, 2)
+}
+
+func TestDeduplicateHTTPFilters(t *testing.T) {
+	hsts := ResponseHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=1"}}, nil, nil)
+	rewrite := PrefixRewriteFilter("/")
+
+	require.Equal(t, []gatewayv1.HTTPRouteFilter{hsts, rewrite}, DeduplicateHTTPFilters([]gatewayv1.HTTPRouteFilter{hsts, rewrite, hsts, rewrite}))
+	require.Nil(t, DeduplicateHTTPFilters(nil))
+
+	// The header modifiers of the same type are merged in the position of the first.
+	server := ResponseHeaderModifierFilter(nil, nil, []string{"Server"})
+	merged := ResponseHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=1"}}, nil, []string{"Server"})
+	require.Equal(t, []gatewayv1.HTTPRouteFilter{merged, rewrite}, DeduplicateHTTPFilters([]gatewayv1.HTTPRouteFilter{hsts, rewrite, server}))
+
+	// A filter shared with other rules is not modified by the merge.
+	filters := []gatewayv1.HTTPRouteFilter{hsts, server}
+	DeduplicateHTTPFilters(filters)
+	require.Equal(t, ResponseHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=1"}}, nil, nil), filters[0])
+}
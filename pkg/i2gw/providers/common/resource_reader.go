@@ -17,12 +17,11 @@ limitations under the License.
 package common
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"io"
-	"os"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 
 	apiv1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -30,7 +29,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
-	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,21 +51,21 @@ func ReadIngressesFromCluster(ctx context.Context, client client.Client, ingress
 }
 
 func ReadIngressesFromFile(filename, namespace string, ingressClasses sets.Set[string]) (map[types.NamespacedName]*networkingv1.Ingress, error) {
-	stream, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read file %v: %w", filename, err)
-	}
-
-	unstructuredObjects, err := ExtractObjectsFromReader(bytes.NewReader(stream), namespace)
+	unstructuredObjects, err := ExtractObjectsFromPath(filename, namespace)
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract objects: %w", err)
+		return nil, err
 	}
+	return ReadIngressesFromObjects(unstructuredObjects, ingressClasses)
+}
 
+// ReadIngressesFromObjects returns the Ingresses of the given classes found
+// among the objects.
+func ReadIngressesFromObjects(unstructuredObjects []*unstructured.Unstructured, ingressClasses sets.Set[string]) (map[types.NamespacedName]*networkingv1.Ingress, error) {
 	ingresses := map[types.NamespacedName]*networkingv1.Ingress{}
 	for _, f := range unstructuredObjects {
 		if !f.GroupVersionKind().Empty() && f.GroupVersionKind().Kind == "Ingress" {
 			var ingress networkingv1.Ingress
-			err = runtime.DefaultUnstructuredConverter.
+			err := runtime.DefaultUnstructuredConverter.
 				FromUnstructured(f.UnstructuredContent(), &ingress)
 			if err != nil {
 				return nil, err
@@ -98,21 +96,20 @@ func ReadServicesFromCluster(ctx context.Context, client client.Client) (map[typ
 }
 
 func ReadServicesFromFile(filename, namespace string) (map[types.NamespacedName]*apiv1.Service, error) {
-	stream, err := os.ReadFile(filename)
+	unstructuredObjects, err := ExtractObjectsFromPath(filename, namespace)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file %v: %w", filename, err)
-	}
-
-	unstructuredObjects, err := ExtractObjectsFromReader(bytes.NewReader(stream), namespace)
-	if err != nil {
-		return nil, fmt.Errorf("failed to extract objects: %w", err)
+		return nil, err
 	}
+	return ReadServicesFromObjects(unstructuredObjects)
+}
 
+// ReadServicesFromObjects returns the Services found among the objects.
+func ReadServicesFromObjects(unstructuredObjects []*unstructured.Unstructured) (map[types.NamespacedName]*apiv1.Service, error) {
 	services := map[types.NamespacedName]*apiv1.Service{}
 	for _, f := range unstructuredObjects {
 		if !f.GroupVersionKind().Empty() && f.GroupVersionKind().Kind == "Service" {
 			var service apiv1.Service
-			err = runtime.DefaultUnstructuredConverter.
+			err := runtime.DefaultUnstructuredConverter.
 				FromUnstructured(f.UnstructuredContent(), &service)
 			if err != nil {
 				return nil, err
@@ -124,50 +121,15 @@ func ReadServicesFromFile(filename, namespace string) (map[types.NamespacedName]
 	return services, nil
 }
 
+// ExtractObjectsFromPath extracts all objects from the input file, or from the
+// YAML and JSON files of the input directory tree. The extraction lives in the
+// i2gw package, which reads the input files too and cannot import this one.
+func ExtractObjectsFromPath(path, namespace string) ([]*unstructured.Unstructured, error) {
+	return i2gw.ExtractObjectsFromPath(path, namespace)
+}
+
 // ExtractObjectsFromReader extracts all objects from a reader,
 // which is created from YAML or JSON input files.
-// It retrieves all objects, including nested ones if they are contained within a list.
-// The function takes a namespace parameter to optionally return only namespaced resources.
 func ExtractObjectsFromReader(reader io.Reader, namespace string) ([]*unstructured.Unstructured, error) {
-	d := kubeyaml.NewYAMLOrJSONDecoder(reader, 4096)
-	var objs []*unstructured.Unstructured
-	for {
-		u := &unstructured.Unstructured{}
-		if err := d.Decode(&u); err != nil {
-			if errors.Is(err, io.EOF) {
-				break
-			}
-			return objs, fmt.Errorf("failed to unmarshal manifest: %w", err)
-		}
-		if u == nil {
-			continue
-		}
-		if namespace != "" && u.GetNamespace() != namespace {
-			continue
-		}
-		objs = append(objs, u)
-	}
-
-	finalObjs := []*unstructured.Unstructured{}
-	for _, obj := range objs {
-		tmpObjs := []*unstructured.Unstructured{}
-		if obj.IsList() {
-			err := obj.EachListItem(func(object runtime.Object) error {
-				unstructuredObj, ok := object.(*unstructured.Unstructured)
-				if ok {
-					tmpObjs = append(tmpObjs, unstructuredObj)
-					return nil
-				}
-				return fmt.Errorf("resource list item has unexpected type")
-			})
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			tmpObjs = append(tmpObjs, obj)
-		}
-		finalObjs = append(finalObjs, tmpObjs...)
-	}
-
-	return finalObjs, nil
+	return i2gw.ExtractObjectsFromReader(reader, namespace)
 }
@@ -93,6 +93,74 @@ func Test_ExtractObjectsFromReader(t *testing.T) {
 	}
 }
 
+func Test_ExtractObjectsFromPath(t *testing.T) {
+	ingress1 := ingress(443, "ingress1", "namespace1")
+	ingress2 := ingress(80, "ingress2", "namespace2")
+	ingressNoNamespace := ingress(80, "ingress-no-namespace", "")
+
+	service1 := service(443, "https", "service1", "namespace1")
+	service2 := service(80, "http", "service2", "namespace2")
+	serviceNoNamespace := service(80, "http", "service-no-namespace", "")
+
+	testCases := []struct {
+		name            string
+		path            string
+		namespace       string
+		wantIngressList []networkingv1.Ingress
+		wantServiceList []apiv1.Service
+	}{
+		{
+			name:            "Test single input file",
+			path:            "testdata/input-file.yaml",
+			namespace:       "",
+			wantIngressList: []networkingv1.Ingress{ingress1, ingress2, ingressNoNamespace},
+			wantServiceList: []apiv1.Service{service1, service2, serviceNoNamespace},
+		}, {
+			name:            "Test directory with json and yaml input files",
+			path:            "testdata",
+			namespace:       "",
+			wantIngressList: []networkingv1.Ingress{ingress1, ingress2, ingressNoNamespace, ingress1, ingress2, ingressNoNamespace},
+			wantServiceList: []apiv1.Service{service1, service2, serviceNoNamespace, service1, service2, serviceNoNamespace},
+		}, {
+			name:            "Test directory with namespace1 flag",
+			path:            "testdata",
+			namespace:       "namespace1",
+			wantIngressList: []networkingv1.Ingress{ingress1, ingress1},
+			wantServiceList: []apiv1.Service{service1, service1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unstructuredObjects, err := ExtractObjectsFromPath(tc.path, tc.namespace)
+			if err != nil {
+				t.Fatalf("failed to extract objects: %s", err)
+			}
+			gotIngressList, err := ingressListFromUnstructured(unstructuredObjects)
+			if err != nil {
+				t.Errorf("got unexpected error: %v", err)
+			}
+			gotServiceList, err := serviceListFromUnstructured(unstructuredObjects)
+			if err != nil {
+				t.Errorf("got unexpected error: %v", err)
+			}
+			if len(gotIngressList.Items) != len(tc.wantIngressList) {
+				t.Fatalf("Expected %d Ingresses, got %d", len(tc.wantIngressList), len(gotIngressList.Items))
+			}
+			if len(gotServiceList.Items) != len(tc.wantServiceList) {
+				t.Fatalf("Expected %d Services, got %d", len(tc.wantServiceList), len(gotServiceList.Items))
+			}
+
+			compareIngressLists(t, gotIngressList, tc.wantIngressList)
+			compareServiceLists(t, gotServiceList, tc.wantServiceList)
+		})
+	}
+
+	if _, err := ExtractObjectsFromPath("testdata/does-not-exist.yaml", ""); err == nil {
+		t.Errorf("Expected error for missing path but got none")
+	}
+}
+
 func ingress(port int32, name, namespace string) networkingv1.Ingress {
 	iPrefix := networkingv1.PathTypePrefix
 	ingressClassName := fmt.Sprintf("ingressClass-%s", name)
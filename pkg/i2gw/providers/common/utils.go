@@ -17,6 +17,8 @@ limitations under the License.
 package common
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strings"
@@ -111,6 +113,101 @@ func RouteName(ingressName, host string) string {
 	return fmt.Sprintf("%s-%s", ingressName, NameFromHost(host))
 }
 
+// HTTPRouteKey returns the key of the HTTPRoute generated by ToIR for the rules
+// of the Ingress with the given host. The rules sharing their namespace,
+// ingress class and host are merged into an HTTPRoute named after the first of
+// the Ingresses defining them.
+func HTTPRouteKey(ingresses []networkingv1.Ingress, ingress networkingv1.Ingress, host string) types.NamespacedName {
+	ingressClass := GetIngressClass(ingress)
+	for _, other := range ingresses {
+		if other.Namespace != ingress.Namespace || GetIngressClass(other) != ingressClass {
+			continue
+		}
+		for _, rule := range other.Spec.Rules {
+			if rule.Host == host {
+				return types.NamespacedName{Namespace: ingress.Namespace, Name: RouteName(other.Name, host)}
+			}
+		}
+	}
+	return types.NamespacedName{Namespace: ingress.Namespace, Name: RouteName(ingress.Name, host)}
+}
+
+// ListenerName returns the name of the Gateway listener generated by ToIR for
+// the hostname and protocol, e.g. foo-example-com-https.
+func ListenerName(hostname string, protocol gatewayv1.ProtocolType) gatewayv1.SectionName {
+	name := strings.ToLower(string(protocol))
+	if hostname != "" {
+		name = fmt.Sprintf("%s-%s", NameFromHost(hostname), name)
+	}
+	return gatewayv1.SectionName(name)
+}
+
+// PortListenerName returns the name of the Gateway listener generated for the
+// hostname, protocol and custom port, e.g. foo-example-com-http-8080. Without
+// hostname, the listener is named after all-hosts.
+func PortListenerName(hostname string, protocol gatewayv1.ProtocolType, port gatewayv1.PortNumber) gatewayv1.SectionName {
+	return gatewayv1.SectionName(fmt.Sprintf("%s-%s-%d", NameFromHost(hostname), strings.ToLower(string(protocol)), port))
+}
+
+// SharedListenerName returns the name of the Gateway listener without hostname
+// shared by the hosts of the port, e.g. http-8080. On the default port of the
+// protocol, it is the name ListenerName gives to the listener without hostname.
+func SharedListenerName(protocol gatewayv1.ProtocolType, port gatewayv1.PortNumber) gatewayv1.SectionName {
+	name := ListenerName("", protocol)
+	if (protocol == gatewayv1.HTTPProtocolType && port == 80) || (protocol == gatewayv1.HTTPSProtocolType && port == 443) {
+		return name
+	}
+	return gatewayv1.SectionName(fmt.Sprintf("%s-%d", name, port))
+}
+
+// AliasListenerName returns the name of the copy, for the alias hostname, of a
+// listener of the host, replacing the host part of its name, e.g.
+// foo-example-com-https-8443 becomes bar-example-com-https-8443.
+func AliasListenerName(name gatewayv1.SectionName, host, alias string) gatewayv1.SectionName {
+	return gatewayv1.SectionName(NameFromHost(alias) + "-" + strings.TrimPrefix(string(name), NameFromHost(host)+"-"))
+}
+
+// DisambiguateListenerNames renames the listeners of the Gateway sharing their
+// name with a listener of another hostname, e.g. foo-bar.com and foo.bar.com, or
+// *.example.com and example.com, whose names are the same once sanitized. The
+// name of every such listener is suffixed with a hash of its hostname, e.g.
+// foo-bar-com-http-1a2b3c4d, so the names are deterministic and unique.
+func DisambiguateListenerNames(gateway *gatewayv1.Gateway) {
+	hostnamesByName := map[gatewayv1.SectionName]map[string]bool{}
+	for _, listener := range gateway.Spec.Listeners {
+		if hostnamesByName[listener.Name] == nil {
+			hostnamesByName[listener.Name] = map[string]bool{}
+		}
+		hostnamesByName[listener.Name][listenerHostname(listener)] = true
+	}
+
+	for i, listener := range gateway.Spec.Listeners {
+		if len(hostnamesByName[listener.Name]) < 2 {
+			continue
+		}
+		sum := sha256.Sum256([]byte(listenerHostname(listener)))
+		gateway.Spec.Listeners[i].Name = gatewayv1.SectionName(fmt.Sprintf("%s-%s", listener.Name, hex.EncodeToString(sum[:4])))
+	}
+}
+
+// FindListenerName returns the name of the listener of the Gateway with the
+// hostname and protocol, which differs from ListenerName when disambiguated.
+func FindListenerName(gateway gatewayv1.Gateway, hostname string, protocol gatewayv1.ProtocolType) gatewayv1.SectionName {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Protocol == protocol && listenerHostname(listener) == hostname {
+			return listener.Name
+		}
+	}
+	return ListenerName(hostname, protocol)
+}
+
+func listenerHostname(listener gatewayv1.Listener) string {
+	if listener.Hostname == nil {
+		return ""
+	}
+	return string(*listener.Hostname)
+}
+
 func ToBackendRef(namespace string, ib networkingv1.IngressBackend, servicePorts map[types.NamespacedName]map[string]int32, path *field.Path) (*gatewayv1.BackendRef, *field.Error) {
 	if ib.Service != nil {
 		if ib.Service.Port.Name == "" {
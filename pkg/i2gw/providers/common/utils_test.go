@@ -921,3 +921,117 @@ func TestCreateBackendTLSPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPRouteKey(t *testing.T) {
+	newIngress := func(name, class string, hosts ...string) networkingv1.Ingress {
+		ingress := networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       networkingv1.IngressSpec{IngressClassName: PtrTo(class)},
+		}
+		for _, host := range hosts {
+			ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{Host: host})
+		}
+		return ingress
+	}
+	ingresses := []networkingv1.Ingress{
+		newIngress("first", "nginx", "foo.example.com"),
+		newIngress("second", "nginx", "bar.example.com", "foo.example.com"),
+		newIngress("third", "other", "foo.example.com"),
+	}
+
+	testCases := []struct {
+		name     string
+		ingress  networkingv1.Ingress
+		host     string
+		expected string
+	}{
+		{
+			name:     "first ingress of the host",
+			ingress:  ingresses[0],
+			host:     "foo.example.com",
+			expected: "first-foo-example-com",
+		},
+		{
+			name:     "host merged into the route of the first ingress",
+			ingress:  ingresses[1],
+			host:     "foo.example.com",
+			expected: "first-foo-example-com",
+		},
+		{
+			name:     "host of a single ingress",
+			ingress:  ingresses[1],
+			host:     "bar.example.com",
+			expected: "second-bar-example-com",
+		},
+		{
+			name:     "host of another ingress class",
+			ingress:  ingresses[2],
+			host:     "foo.example.com",
+			expected: "third-foo-example-com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, types.NamespacedName{Namespace: "default", Name: tc.expected}, HTTPRouteKey(ingresses, tc.ingress, tc.host))
+		})
+	}
+}
+
+func TestListenerName(t *testing.T) {
+	require.Equal(t, gatewayv1.SectionName("foo-example-com-http"), ListenerName("foo.example.com", gatewayv1.HTTPProtocolType))
+	require.Equal(t, gatewayv1.SectionName("foo-example-com-https"), ListenerName("foo.example.com", gatewayv1.HTTPSProtocolType))
+	require.Equal(t, gatewayv1.SectionName("http"), ListenerName("", gatewayv1.HTTPProtocolType))
+	require.Equal(t, gatewayv1.SectionName("foo-example-com-https-8443"), PortListenerName("foo.example.com", gatewayv1.HTTPSProtocolType, 8443))
+	require.Equal(t, gatewayv1.SectionName("all-hosts-http-8080"), PortListenerName("", gatewayv1.HTTPProtocolType, 8080))
+	require.Equal(t, gatewayv1.SectionName("http"), SharedListenerName(gatewayv1.HTTPProtocolType, 80))
+	require.Equal(t, gatewayv1.SectionName("http-8080"), SharedListenerName(gatewayv1.HTTPProtocolType, 8080))
+	require.Equal(t, gatewayv1.SectionName("https"), SharedListenerName(gatewayv1.HTTPSProtocolType, 443))
+	require.Equal(t, gatewayv1.SectionName("bar-example-com-https"), AliasListenerName(ListenerName("foo.example.com", gatewayv1.HTTPSProtocolType), "foo.example.com", "bar.example.com"))
+	require.Equal(t, gatewayv1.SectionName("bar-example-com-http-8080"), AliasListenerName(PortListenerName("foo.example.com", gatewayv1.HTTPProtocolType, 8080), "foo.example.com", "bar.example.com"))
+}
+
+func TestDisambiguateListenerNames(t *testing.T) {
+	listener := func(hostname string, protocol gatewayv1.ProtocolType) gatewayv1.Listener {
+		l := gatewayv1.Listener{Name: ListenerName(hostname, protocol), Protocol: protocol}
+		if hostname != "" {
+			l.Hostname = PtrTo(gatewayv1.Hostname(hostname))
+		}
+		return l
+	}
+	gateway := gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+		listener("foo-bar.com", gatewayv1.HTTPProtocolType),
+		listener("foo.bar.com", gatewayv1.HTTPProtocolType),
+		listener("foo--bar.com", gatewayv1.HTTPProtocolType),
+		listener("foo.bar.com", gatewayv1.HTTPSProtocolType),
+		listener("*.example.com", gatewayv1.HTTPSProtocolType),
+		listener("example.com", gatewayv1.HTTPSProtocolType),
+		listener("other.example.com", gatewayv1.HTTPProtocolType),
+		listener("", gatewayv1.HTTPProtocolType),
+	}}}
+
+	DisambiguateListenerNames(&gateway)
+
+	names := map[gatewayv1.SectionName]bool{}
+	for _, l := range gateway.Spec.Listeners {
+		require.False(t, names[l.Name], "duplicate listener name %s", l.Name)
+		names[l.Name] = true
+	}
+	// Listeners whose names do not collide keep them.
+	require.Equal(t, gatewayv1.SectionName("foo-bar-com-https"), gateway.Spec.Listeners[3].Name)
+	require.Equal(t, gatewayv1.SectionName("other-example-com-http"), gateway.Spec.Listeners[6].Name)
+	require.Equal(t, gatewayv1.SectionName("http"), gateway.Spec.Listeners[7].Name)
+	// Colliding listeners are suffixed with a hash of their hostname.
+	require.Regexp(t, `^foo-bar-com-http-[0-9a-f]{8}$`, gateway.Spec.Listeners[0].Name)
+	require.Regexp(t, `^example-com-https-[0-9a-f]{8}$`, gateway.Spec.Listeners[4].Name)
+
+	// The names are deterministic, and found by hostname.
+	again := gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+		listener("foo.bar.com", gatewayv1.HTTPProtocolType),
+		listener("foo-bar.com", gatewayv1.HTTPProtocolType),
+	}}}
+	DisambiguateListenerNames(&again)
+	require.Equal(t, gateway.Spec.Listeners[1].Name, again.Spec.Listeners[0].Name)
+	require.Equal(t, gateway.Spec.Listeners[1].Name, FindListenerName(gateway, "foo.bar.com", gatewayv1.HTTPProtocolType))
+	require.Equal(t, gatewayv1.SectionName("missing-example-com-http"), FindListenerName(gateway, "missing.example.com", gatewayv1.HTTPProtocolType))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
@@ -80,6 +81,16 @@ func (p *Provider) ReadResourcesFromFile(_ context.Context, filename string) err
 	return nil
 }
 
+// ReadResourcesFromObjects reads resources from the decoded objects
+func (p *Provider) ReadResourcesFromObjects(_ context.Context, objects []*unstructured.Unstructured) error {
+	storage, err := p.reader.readUnstructuredObjects(objects)
+	if err != nil {
+		return fmt.Errorf("failed to read gce resources from objects: %w", err)
+	}
+	p.storage = storage
+	return nil
+}
+
 // ToIR converts stored Ingress GCE API entities to intermediate.IR including the
 // ingress-gce specific features.
 func (p *Provider) ToIR() (intermediate.IR, field.ErrorList) {
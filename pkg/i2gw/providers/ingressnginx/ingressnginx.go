@@ -23,6 +23,7 @@ import (
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -86,3 +87,13 @@ func (p *Provider) ReadResourcesFromFile(_ context.Context, filename string) err
 	p.storage = storage
 	return nil
 }
+
+// ReadResourcesFromObjects reads resources from the decoded objects
+func (p *Provider) ReadResourcesFromObjects(_ context.Context, objects []*unstructured.Unstructured) error {
+	storage, err := p.resourceReader.readResourcesFromObjects(objects)
+	if err != nil {
+		return fmt.Errorf("failed to read resources from objects: %w", err)
+	}
+	p.storage = storage
+	return nil
+}
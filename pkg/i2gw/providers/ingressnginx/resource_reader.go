@@ -21,6 +21,7 @@ import (
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -62,15 +63,23 @@ func (r *resourceReader) readResourcesFromCluster(ctx context.Context) (*storage
 }
 
 func (r *resourceReader) readResourcesFromFile(filename string) (*storage, error) {
+	objects, err := common.ExtractObjectsFromPath(filename, r.conf.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return r.readResourcesFromObjects(objects)
+}
+
+func (r *resourceReader) readResourcesFromObjects(objects []*unstructured.Unstructured) (*storage, error) {
 	storage := newResourcesStorage()
 
-	ingresses, err := common.ReadIngressesFromFile(filename, r.conf.Namespace, sets.New(r.ingressClass))
+	ingresses, err := common.ReadIngressesFromObjects(objects, sets.New(r.ingressClass))
 	if err != nil {
 		return nil, err
 	}
 	storage.Ingresses.FromMap(ingresses)
 
-	services, err := common.ReadServicesFromFile(filename, r.conf.Namespace)
+	services, err := common.ReadServicesFromObjects(objects)
 	if err != nil {
 		return nil, err
 	}
@@ -594,9 +594,16 @@ func (c *resourcesToIRConverter) convertVsHTTPRoutes(virtualService metav1.Objec
 			klog.Infof("ignoring field: %v", httpRouteFieldPath.Child("Fault"))
 		}
 		if httpRoute.GetCorsPolicy() != nil {
-			notify(notifications.InfoNotification, fmt.Sprintf("ignoring field: %v", httpRouteFieldPath.Child("CorsPolicy")), vs)
+			// The CORS filter was added to Gateway API after the version the
+			// generated resources are built with.
+			notify(notifications.WarningNotification, fmt.Sprintf("ignoring field: %v, the generated HTTPRoute does not answer CORS preflight requests nor add the Access-Control-Allow-* headers, "+
+				"configure CORS in the backends or with the policies of the Gateway implementation", httpRouteFieldPath.Child("CorsPolicy")), vs)
 			klog.Infof("ignoring field: %v", httpRouteFieldPath.Child("CorsPolicy"))
 		}
+		if httpRoute.GetMirrorPercentage() != nil || httpRoute.GetMirrorPercent() != nil {
+			notify(notifications.WarningNotification, fmt.Sprintf("ignoring field: %v, the RequestMirror filter mirrors all the requests", httpRouteFieldPath.Child("MirrorPercentage")), vs)
+			klog.Infof("ignoring field: %v", httpRouteFieldPath.Child("MirrorPercentage"))
+		}
 
 		if httpRoute.GetMirror() != nil && len(httpRoute.GetMirrors()) > 0 {
 			errList = append(errList, field.Invalid(httpRouteFieldPath, httpRoute, "HTTP route cannot contain both mirror and mirrors"))
@@ -621,7 +628,9 @@ func (c *resourcesToIRConverter) convertVsHTTPRoutes(virtualService metav1.Objec
 			routeDestinationFieldPath := httpRouteFieldPath.Child("Mirrors").Index(j)
 
 			if mirror.GetPercentage() != nil {
-				notify(notifications.InfoNotification, fmt.Sprintf("ignoring field: %v", routeDestinationFieldPath.Child("Percentage")), vs)
+				// The percentage of the RequestMirror filter was added to Gateway
+				// API after the version the generated resources are built with.
+				notify(notifications.WarningNotification, fmt.Sprintf("ignoring field: %v, the RequestMirror filter mirrors all the requests", routeDestinationFieldPath.Child("Percentage")), vs)
 				klog.Infof("ignoring field: %v", routeDestinationFieldPath.Child("Percentage"))
 			}
 
@@ -19,10 +19,12 @@ package istio
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 	"google.golang.org/protobuf/types/known/durationpb"
 	istiov1beta1 "istio.io/api/networking/v1beta1"
@@ -1391,6 +1393,47 @@ func Test_resourcesToIRConverter_convertVsHTTPRoutes(t *testing.T) {
 	}
 }
 
+func Test_resourcesToIRConverter_convertVsHTTPRoutes_unsupportedFilters(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	virtualService := &istioclientv1beta1.VirtualService{
+		TypeMeta:   metav1.TypeMeta{Kind: "VirtualService"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+	}
+	istioHTTPRoutes := []*istiov1beta1.HTTPRoute{{
+		CorsPolicy:       &istiov1beta1.CorsPolicy{AllowOrigins: []*istiov1beta1.StringMatch{{MatchType: &istiov1beta1.StringMatch_Exact{Exact: "https://example.com"}}}},
+		Mirror:           &istiov1beta1.Destination{Host: "mirror"},
+		MirrorPercentage: &istiov1beta1.Percent{Value: 10},
+	}, {
+		Mirrors: []*istiov1beta1.HTTPMirrorPolicy{{Destination: &istiov1beta1.Destination{Host: "mirror"}, Percentage: &istiov1beta1.Percent{Value: 5}}},
+	}}
+
+	c := &resourcesToIRConverter{ctx: context.WithValue(context.Background(), virtualServiceKey, virtualService)}
+	if _, errList := c.convertVsHTTPRoutes(virtualService.ObjectMeta, istioHTTPRoutes, []string{"example.com"}, field.NewPath("")); len(errList) > 0 {
+		t.Fatalf("Unexpected errors: %v", errList)
+	}
+
+	var warnings []string
+	for _, n := range notifications.NotificationAggr.Notifications[ProviderName] {
+		if n.Type == notifications.WarningNotification {
+			warnings = append(warnings, n.Message)
+		}
+	}
+	expected := []string{
+		"ignoring field: [].Http[0].CorsPolicy, the generated HTTPRoute does not answer CORS preflight requests",
+		"ignoring field: [].Http[0].MirrorPercentage, the RequestMirror filter mirrors all the requests",
+		"ignoring field: [].Http[1].Mirrors[0].Percentage, the RequestMirror filter mirrors all the requests",
+	}
+	if len(warnings) != len(expected) {
+		t.Fatalf("Expected %d warnings, got %v", len(expected), warnings)
+	}
+	for i, prefix := range expected {
+		if !strings.HasPrefix(warnings[i], prefix) {
+			t.Errorf("Expected warning %d to start with %q, got %q", i, prefix, warnings[i])
+		}
+	}
+}
+
 func Test_resourcesToIRConverter_convertVsTLSRoutes(t *testing.T) {
 	type args struct {
 		virtualService *istioclientv1beta1.VirtualService
@@ -19,6 +19,7 @@ package kong
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
@@ -76,3 +77,13 @@ func (p *Provider) ReadResourcesFromFile(_ context.Context, filename string) err
 	p.storage = storage
 	return nil
 }
+
+// ReadResourcesFromObjects reads resources from the decoded objects
+func (p *Provider) ReadResourcesFromObjects(_ context.Context, objects []*unstructured.Unstructured) error {
+	storage, err := p.readResourcesFromObjects(objects)
+	if err != nil {
+		return err
+	}
+	p.storage = storage
+	return nil
+}
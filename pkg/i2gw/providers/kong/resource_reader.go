@@ -17,10 +17,8 @@ limitations under the License.
 package kong
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"os"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -72,21 +70,29 @@ func (r *resourceReader) readResourcesFromCluster(ctx context.Context) (*storage
 }
 
 func (r *resourceReader) readResourcesFromFile(filename string) (*storage, error) {
+	objects, err := common.ExtractObjectsFromPath(filename, r.conf.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return r.readResourcesFromObjects(objects)
+}
+
+func (r *resourceReader) readResourcesFromObjects(objects []*unstructured.Unstructured) (*storage, error) {
 	storage := newResourceStorage()
 
-	ingresses, err := common.ReadIngressesFromFile(filename, r.conf.Namespace, sets.New(KongIngressClass))
+	ingresses, err := common.ReadIngressesFromObjects(objects, sets.New(KongIngressClass))
 	if err != nil {
 		return nil, err
 	}
 	storage.Ingresses = ingresses
 
-	tcpIngresses, err := r.readTCPIngressesFromFile(filename)
+	tcpIngresses, err := r.readTCPIngressesFromObjects(objects)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read TCPIngresses: %w", err)
 	}
 	storage.TCPIngresses = tcpIngresses
 
-	services, err := common.ReadServicesFromFile(filename, r.conf.Namespace)
+	services, err := common.ReadServicesFromObjects(objects)
 	if err != nil {
 		return nil, err
 	}
@@ -121,18 +127,7 @@ func (r *resourceReader) readTCPIngressesFromCluster(ctx context.Context) ([]kon
 	return tcpIngresses, nil
 }
 
-func (r *resourceReader) readTCPIngressesFromFile(filename string) ([]kongv1beta1.TCPIngress, error) {
-	stream, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, err
-	}
-
-	reader := bytes.NewReader(stream)
-	objs, err := common.ExtractObjectsFromReader(reader, r.conf.Namespace)
-	if err != nil {
-		return nil, err
-	}
-
+func (r *resourceReader) readTCPIngressesFromObjects(objs []*unstructured.Unstructured) ([]kongv1beta1.TCPIngress, error) {
 	tcpIngresses := []kongv1beta1.TCPIngress{}
 	for _, f := range objs {
 		if r.conf.Namespace != "" && f.GetNamespace() != r.conf.Namespace {
@@ -141,7 +136,7 @@ func (r *resourceReader) readTCPIngressesFromFile(filename string) ([]kongv1beta
 		if !f.GroupVersionKind().Empty() &&
 			f.GroupVersionKind() == tcpIngressGVK {
 			tcpIngress := &kongv1beta1.TCPIngress{}
-			err = runtime.DefaultUnstructuredConverter.
+			err := runtime.DefaultUnstructuredConverter.
 				FromUnstructured(f.UnstructuredContent(), tcpIngress)
 			if err != nil {
 				return nil, err
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// AuthFeature reports the JWT and basic authentication annotations as migration
+// blockers. Gateway API has no authentication, so the routes generated from the
+// Ingress would serve its paths to unauthenticated clients until an
+// implementation-specific policy replaces the annotations.
+func AuthFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+
+		var methods []string
+		if key := ingress.Annotations[nginxJWTKeyAnnotation]; key != "" {
+			methods = append(methods, fmt.Sprintf("JWT validated with the keys of Secret %s (%s)", key, nginxJWTKeyAnnotation))
+		}
+		if secret := ingress.Annotations[nginxBasicAuthSecretAnnotation]; secret != "" {
+			methods = append(methods, fmt.Sprintf("basic authentication with the credentials of Secret %s (%s)", secret, nginxBasicAuthSecretAnnotation))
+		}
+		if len(methods) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("Ingress %s/%s requires %s, which Gateway API cannot express: the routes generated from it would serve its paths without authentication",
+			ingress.Namespace, ingress.Name, strings.Join(methods, " and "))
+		if routes := routesFromIngress(ingresses, *ingress, ir); len(routes) > 0 {
+			message += ": " + strings.Join(routes, ", ")
+		}
+		notify(notifications.BlockerNotification, message, ingress)
+	}
+
+	return nil
+}
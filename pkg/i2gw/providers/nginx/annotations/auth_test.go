@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestAuthFeature(t *testing.T) {
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		expectedMessage string
+	}{
+		{
+			name:        "no authentication",
+			annotations: map[string]string{nginxJWTRealmAnnotation: "Cafe"},
+		},
+		{
+			name:        "jwt",
+			annotations: map[string]string{nginxJWTKeyAnnotation: "cafe-jwk", nginxJWTRealmAnnotation: "Cafe"},
+			expectedMessage: "Ingress default/app requires JWT validated with the keys of Secret cafe-jwk (nginx.com/jwt-key), which Gateway API cannot express: " +
+				"the routes generated from it would serve its paths without authentication: HTTPRoute: default/app-example-com",
+		},
+		{
+			name:        "jwt and basic authentication",
+			annotations: map[string]string{nginxJWTKeyAnnotation: "cafe-jwk", nginxBasicAuthSecretAnnotation: "cafe-passwd"},
+			expectedMessage: "Ingress default/app requires JWT validated with the keys of Secret cafe-jwk (nginx.com/jwt-key) and basic authentication with the credentials of Secret cafe-passwd (nginx.org/basic-auth-secret), " +
+				"which Gateway API cannot express: the routes generated from it would serve its paths without authentication: HTTPRoute: default/app-example-com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: tt.annotations},
+			}
+			ir := intermediate.IR{
+				HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+					{Namespace: "default", Name: "app-example-com"}: {Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "app"}}},
+				},
+			}
+
+			if errs := AuthFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			blockers := notifications.NotificationAggr.Notifications["nginx"]
+			if tt.expectedMessage == "" {
+				if len(blockers) != 0 {
+					t.Errorf("Expected no notifications, got %v", blockers)
+				}
+				return
+			}
+			if len(blockers) != 1 || blockers[0].Type != notifications.BlockerNotification || blockers[0].Message != tt.expectedMessage {
+				t.Errorf("Expected the blocker %q, got %v", tt.expectedMessage, blockers)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/testgen"
+)
+
+// BenchmarkFeatureParsers measures each feature parser on the IR of a synthetic
+// cluster of 1000 Ingresses using every feature, e.g.:
+//
+//	go test ./pkg/i2gw/providers/nginx/annotations/ -run '^$' -bench FeatureParsers -benchmem
+func BenchmarkFeatureParsers(b *testing.B) {
+	cluster := testgen.Generate(testgen.DefaultOptions(1000))
+	ingresses := make([]networkingv1.Ingress, 0, len(cluster.Ingresses))
+	for _, ingress := range cluster.Ingresses {
+		ingresses = append(ingresses, *ingress)
+	}
+	ingresses = TranslateCommunityAnnotations(ingresses)
+	services := map[types.NamespacedName]*apiv1.Service{}
+	for _, service := range cluster.Services {
+		services[types.NamespacedName{Namespace: service.Namespace, Name: service.Name}] = service
+	}
+	servicePorts := common.GroupServicePortsByPortName(services)
+	// The provider converts the ImplementationSpecific paths to prefixes.
+	options := i2gw.ProviderImplementationSpecificOptions{
+		ToImplementationSpecificHTTPPathTypeMatch: func(path *gatewayv1.HTTPPathMatch) {
+			path.Type = ptr.To(gatewayv1.PathMatchPathPrefix)
+		},
+	}
+
+	for _, feature := range Features(FeatureOptions{GRPCHeuristics: true, ConvertSnippetRedirects: true, HostHeader: UpstreamHostHeader}) {
+		b.Run(parserName(feature.Parser), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+				ir, errs := common.ToIR(ingresses, servicePorts, options)
+				if len(errs) > 0 {
+					b.Fatalf("Unexpected errors: %v", errs.ToAggregate())
+				}
+				b.StartTimer()
+				if errs := feature.Parser(ingresses, servicePorts, &ir); len(errs) > 0 {
+					b.Fatalf("Unexpected errors: %v", errs.ToAggregate())
+				}
+			}
+		})
+	}
+}
+
+// parserName returns the name of the function of the parser, e.g. HSTSFeature,
+// or NewHostHeaderFeature for the parsers it returns.
+func parserName(parser i2gw.FeatureParser) string {
+	// e.g. annotations.NewHostHeaderFeature.func1
+	name := path.Base(runtime.FuncForPC(reflect.ValueOf(parser).Pointer()).Name())
+	return strings.Split(name, ".")[1]
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// TranslateCommunityAnnotations returns copies of the ingresses where the community
+// ingress-nginx annotations of the features this provider converts are translated
+// to their nginx.org equivalents. Annotations already set with the nginx.org prefix
+// take precedence over the translated ones.
+func TranslateCommunityAnnotations(ingresses []networkingv1.Ingress) []networkingv1.Ingress {
+	translated := make([]networkingv1.Ingress, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		if !hasCommunityAnnotations(ingress) {
+			translated = append(translated, ingress)
+			continue
+		}
+		ingress = *ingress.DeepCopy()
+		translateRewriteTarget(&ingress)
+		translateSSLRedirect(&ingress)
+		translateBackendProtocol(&ingress)
+		translateProxySetHeaders(&ingress)
+		translated = append(translated, ingress)
+	}
+	return translated
+}
+
+func hasCommunityAnnotations(ingress networkingv1.Ingress) bool {
+	for key := range ingress.Annotations {
+		if strings.HasPrefix(key, communityPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// setTranslatedAnnotation sets the nginx.org annotation unless it is already set.
+func setTranslatedAnnotation(ingress *networkingv1.Ingress, communityAnnotation, annotation, value string) {
+	if _, exists := ingress.Annotations[annotation]; exists {
+		notify(notifications.InfoNotification, fmt.Sprintf("%s: ignored in favor of %s", communityAnnotation, annotation), ingress)
+		return
+	}
+	ingress.Annotations[annotation] = value
+	notify(notifications.InfoNotification, fmt.Sprintf("%s: translated to %s=%q", communityAnnotation, annotation, value), ingress)
+}
+
+// translateRewriteTarget converts rewrite-target to a nginx.org/rewrites rule for
+// every backend service of the ingress. Regex capture groups cannot be expressed
+// with a prefix rewrite and are reported instead.
+func translateRewriteTarget(ingress *networkingv1.Ingress) {
+	target, exists := ingress.Annotations[communityRewriteTargetAnnotation]
+	if !exists || target == "" {
+		return
+	}
+	if strings.Contains(target, "$") {
+		notify(notifications.WarningNotification, fmt.Sprintf("%s: %q uses regex capture groups which cannot be converted to a prefix rewrite", communityRewriteTargetAnnotation, target), ingress)
+		return
+	}
+
+	var rules []string
+	for _, service := range ingressServiceNames(*ingress) {
+		rules = append(rules, fmt.Sprintf("serviceName=%s rewrite=%s", service, target))
+	}
+	if len(rules) == 0 {
+		return
+	}
+	setTranslatedAnnotation(ingress, communityRewriteTargetAnnotation, nginxRewritesAnnotation, strings.Join(rules, ";"))
+}
+
+func translateSSLRedirect(ingress *networkingv1.Ingress) {
+	for _, communityAnnotation := range []string{communitySSLRedirectAnnotation, communityForceSSLRedirectAnnotation} {
+		if ingress.Annotations[communityAnnotation] == "true" {
+			setTranslatedAnnotation(ingress, communityAnnotation, nginxRedirectToHTTPSAnnotation, "true")
+			return
+		}
+	}
+}
+
+// translateBackendProtocol marks every backend service of the ingress with the
+// nginx.org annotation matching the backend protocol.
+func translateBackendProtocol(ingress *networkingv1.Ingress) {
+	protocol, exists := ingress.Annotations[communityBackendProtocolAnnotation]
+	if !exists {
+		return
+	}
+
+	var targets []string
+	switch strings.ToUpper(protocol) {
+	case "HTTP":
+		return
+	case "HTTPS":
+		targets = []string{nginxSSLServicesAnnotation}
+	case "GRPC":
+		targets = []string{nginxGRPCServicesAnnotation}
+	case "GRPCS":
+		targets = []string{nginxGRPCServicesAnnotation, nginxSSLServicesAnnotation}
+	default:
+		notify(notifications.WarningNotification, fmt.Sprintf("%s: backend protocol %q is not supported", communityBackendProtocolAnnotation, protocol), ingress)
+		return
+	}
+
+	services := ingressServiceNames(*ingress)
+	if len(services) == 0 {
+		return
+	}
+	for _, target := range targets {
+		setTranslatedAnnotation(ingress, communityBackendProtocolAnnotation, target, strings.Join(services, ","))
+	}
+}
+
+// translateProxySetHeaders reports the community proxy-set-headers annotation,
+// which references a ConfigMap this provider does not read.
+func translateProxySetHeaders(ingress *networkingv1.Ingress) {
+	configMap, exists := ingress.Annotations[communityProxySetHeadersAnnotation]
+	if !exists {
+		return
+	}
+	notify(notifications.WarningNotification, fmt.Sprintf("%s: headers are read from ConfigMap %q which is not converted, copy its entries to %s", communityProxySetHeadersAnnotation, configMap, nginxProxySetHeadersAnnotation), ingress)
+}
+
+// ingressServiceNames returns the sorted names of the backend services of the ingress.
+func ingressServiceNames(ingress networkingv1.Ingress) []string {
+	var services []string
+	addService := func(backend networkingv1.IngressBackend) {
+		if backend.Service != nil && !slices.Contains(services, backend.Service.Name) {
+			services = append(services, backend.Service.Name)
+		}
+	}
+	if ingress.Spec.DefaultBackend != nil {
+		addService(*ingress.Spec.DefaultBackend)
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			addService(path.Backend)
+		}
+	}
+	slices.Sort(services)
+	return services
+}
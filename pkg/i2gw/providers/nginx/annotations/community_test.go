@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTranslateCommunityAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]string
+	}{
+		{
+			name:        "no community annotations",
+			annotations: map[string]string{nginxRedirectToHTTPSAnnotation: "true"},
+			expected:    map[string]string{nginxRedirectToHTTPSAnnotation: "true"},
+		},
+		{
+			name:        "rewrite target",
+			annotations: map[string]string{communityRewriteTargetAnnotation: "/"},
+			expected: map[string]string{
+				communityRewriteTargetAnnotation: "/",
+				nginxRewritesAnnotation:          "serviceName=api rewrite=/;serviceName=web rewrite=/",
+			},
+		},
+		{
+			name:        "rewrite target with capture group",
+			annotations: map[string]string{communityRewriteTargetAnnotation: "/$2"},
+			expected:    map[string]string{communityRewriteTargetAnnotation: "/$2"},
+		},
+		{
+			name:        "force ssl redirect",
+			annotations: map[string]string{communityForceSSLRedirectAnnotation: "true"},
+			expected: map[string]string{
+				communityForceSSLRedirectAnnotation: "true",
+				nginxRedirectToHTTPSAnnotation:      "true",
+			},
+		},
+		{
+			name:        "grpcs backend protocol",
+			annotations: map[string]string{communityBackendProtocolAnnotation: "GRPCS"},
+			expected: map[string]string{
+				communityBackendProtocolAnnotation: "GRPCS",
+				nginxGRPCServicesAnnotation:        "api,web",
+				nginxSSLServicesAnnotation:         "api,web",
+			},
+		},
+		{
+			name: "nginx.org annotation takes precedence",
+			annotations: map[string]string{
+				communityBackendProtocolAnnotation: "HTTPS",
+				nginxSSLServicesAnnotation:         "api",
+			},
+			expected: map[string]string{
+				communityBackendProtocolAnnotation: "HTTPS",
+				nginxSSLServicesAnnotation:         "api",
+			},
+		},
+		{
+			name:        "proxy set headers configmap is not translated",
+			annotations: map[string]string{communityProxySetHeadersAnnotation: "default/custom-headers"},
+			expected:    map[string]string{communityProxySetHeadersAnnotation: "default/custom-headers"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/web", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}}},
+								{Path: "/api", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "api"}}},
+							},
+						}},
+					}},
+				},
+			}
+			original := make(map[string]string, len(tt.annotations))
+			for k, v := range tt.annotations {
+				original[k] = v
+			}
+
+			result := TranslateCommunityAnnotations([]networkingv1.Ingress{ingress})
+
+			if !reflect.DeepEqual(result[0].Annotations, tt.expected) {
+				t.Errorf("Expected annotations %v, got %v", tt.expected, result[0].Annotations)
+			}
+			if !reflect.DeepEqual(ingress.Annotations, original) {
+				t.Errorf("Expected input annotations to be left untouched, got %v", ingress.Annotations)
+			}
+		})
+	}
+}
@@ -29,6 +29,26 @@ const (
 	nginxProxyPassHeadersAnnotation = nginxOrgPrefix + "proxy-pass-headers"
 	nginxProxySetHeadersAnnotation  = nginxOrgPrefix + "proxy-set-headers"
 
+	// Proxy buffering annotations
+	nginxProxyBufferingAnnotation  = nginxOrgPrefix + "proxy-buffering"
+	nginxProxyBuffersAnnotation    = nginxOrgPrefix + "proxy-buffers"
+	nginxProxyBufferSizeAnnotation = nginxOrgPrefix + "proxy-buffer-size"
+
+	// Load balancing annotation
+	nginxLBMethodAnnotation = nginxOrgPrefix + "lb-method"
+
+	// Upstream keepalive annotation
+	nginxKeepaliveAnnotation = nginxOrgPrefix + "keepalive"
+
+	// Retry annotations
+	nginxProxyNextUpstreamAnnotation        = nginxOrgPrefix + "proxy-next-upstream"
+	nginxProxyNextUpstreamTriesAnnotation   = nginxOrgPrefix + "proxy-next-upstream-tries"
+	nginxProxyNextUpstreamTimeoutAnnotation = nginxOrgPrefix + "proxy-next-upstream-timeout"
+
+	// Snippet annotations
+	nginxServerSnippetsAnnotation   = nginxOrgPrefix + "server-snippets"
+	nginxLocationSnippetsAnnotation = nginxOrgPrefix + "location-snippets"
+
 	// Port configuration annotations
 	nginxListenPortsAnnotation    = nginxOrgPrefix + "listen-ports"
 	nginxListenPortsSSLAnnotation = nginxOrgPrefix + "listen-ports-ssl"
@@ -41,9 +61,33 @@ const (
 	// Path matching annotations
 	nginxPathRegexAnnotation = nginxOrgPrefix + "path-regex"
 
+	// Authentication annotations
+	nginxComPrefix                 = "nginx.com/"
+	nginxJWTKeyAnnotation          = nginxComPrefix + "jwt-key"
+	nginxJWTRealmAnnotation        = nginxComPrefix + "jwt-realm"
+	nginxJWTTokenAnnotation        = nginxComPrefix + "jwt-token"
+	nginxJWTLoginURLAnnotation     = nginxComPrefix + "jwt-login-url"
+	nginxBasicAuthSecretAnnotation = nginxOrgPrefix + "basic-auth-secret"
+	nginxBasicAuthRealmAnnotation  = nginxOrgPrefix + "basic-auth-realm"
+
 	// Legacy SSL redirect annotation
 	legacySSLRedirectAnnotation = "ingress.kubernetes.io/ssl-redirect"
 
+	// Community ingress-nginx annotations translated to their nginx.org equivalents
+	communityPrefix                     = "nginx.ingress.kubernetes.io/"
+	communityRewriteTargetAnnotation    = communityPrefix + "rewrite-target"
+	communitySSLRedirectAnnotation      = communityPrefix + "ssl-redirect"
+	communityForceSSLRedirectAnnotation = communityPrefix + "force-ssl-redirect"
+	communityBackendProtocolAnnotation  = communityPrefix + "backend-protocol"
+	communityProxySetHeadersAnnotation  = communityPrefix + "proxy-set-headers"
+
+	// NGINX App Protect WAF and DoS annotations
+	appProtectPrefix                = "appprotect.f5.com/"
+	appProtectEnableAnnotation      = appProtectPrefix + "app-protect-enable"
+	appProtectPolicyAnnotation      = appProtectPrefix + "app-protect-policy"
+	appProtectSecurityLogAnnotation = appProtectPrefix + "app-protect-security-log"
+	appProtectDosResourceAnnotation = "appprotectdos.f5.com/app-protect-dos-resource"
+
 	// HSTS header annotation
 	nginxHSTSAnnotation                  = nginxOrgPrefix + "hsts"
 	nginxHSTSIncludeSubdomainsAnnotation = nginxOrgPrefix + "hsts-include-subdomains"
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+)
+
+// SupportLevel tells how much of the behavior of an annotation is converted.
+type SupportLevel string
+
+const (
+	// Supported annotations are converted to Gateway API resources.
+	Supported SupportLevel = "supported"
+	// PartiallySupported annotations are converted in part, or captured and
+	// reported for the Gateway implementation to be configured.
+	PartiallySupported SupportLevel = "partial"
+	// NotSupported annotations are reported and not converted.
+	NotSupported SupportLevel = "unsupported"
+)
+
+// AnnotationSupport is the conversion support of an annotation.
+type AnnotationSupport struct {
+	Annotation  string       `json:"annotation"`
+	Support     SupportLevel `json:"support"`
+	Description string       `json:"description"`
+}
+
+// Feature is a feature parser with the annotations it handles.
+type Feature struct {
+	Parser      i2gw.FeatureParser
+	Annotations []AnnotationSupport
+}
+
+// FeatureOptions are the provider-specific flags changing the behavior of the
+// feature parsers.
+type FeatureOptions struct {
+	GRPCHeuristics          bool
+	ConvertSnippetRedirects bool
+	// HostHeader is the Host header of the requests to the backends, one of
+	// HostHeaders.
+	HostHeader string
+}
+
+// Features returns the annotation features in the order the converter runs their
+// parsers. FilterOrderFeature is not one of them: the converter runs it once all
+// the filters are added.
+func Features(options FeatureOptions) []Feature {
+	return []Feature{
+		{Parser: ListenPortsFeature, Annotations: []AnnotationSupport{
+			{nginxListenPortsAnnotation, Supported, "Gateway HTTP listeners on the custom ports"},
+			{nginxListenPortsSSLAnnotation, Supported, "Gateway HTTPS listeners on the custom ports"},
+		}},
+		{Parser: RewriteTargetFeature, Annotations: []AnnotationSupport{
+			{nginxRewritesAnnotation, Supported, "HTTPRoute URLRewrite filter"},
+		}},
+		{Parser: HeaderManipulationFeature, Annotations: []AnnotationSupport{
+			{nginxProxyHideHeadersAnnotation, Supported, "HTTPRoute ResponseHeaderModifier filter"},
+			{nginxProxySetHeadersAnnotation, Supported, "HTTPRoute RequestHeaderModifier filter"},
+			{nginxProxyPassHeadersAnnotation, NotSupported, "Gateway API forwards the response headers"},
+		}},
+		{Parser: NewHostHeaderFeature(options.HostHeader)},
+		{Parser: PathRegexFeature, Annotations: []AnnotationSupport{
+			{nginxPathRegexAnnotation, Supported, "HTTPRoute RegularExpression path matches"},
+		}},
+		{Parser: ImplementationSpecificPathFeature},
+		{Parser: SSLRedirectFeature, Annotations: []AnnotationSupport{
+			{nginxRedirectToHTTPSAnnotation, Supported, "HTTPRoute RequestRedirect filter"},
+			{legacySSLRedirectAnnotation, Supported, "HTTPRoute RequestRedirect filter"},
+		}},
+		{Parser: HSTSFeature, Annotations: []AnnotationSupport{
+			{nginxHSTSAnnotation, Supported, "HTTPRoute ResponseHeaderModifier filter"},
+			{nginxHSTSMaxAgeAnnotation, Supported, "max-age of the Strict-Transport-Security header"},
+			{nginxHSTSIncludeSubdomainsAnnotation, Supported, "includeSubDomains of the Strict-Transport-Security header"},
+		}},
+		{Parser: WebSocketServicesFeature, Annotations: []AnnotationSupport{
+			{nginxWebSocketServicesAnnotation, Supported, "kubernetes.io/ws appProtocol of the Service ports"},
+		}},
+		{Parser: SSLServicesFeature, Annotations: []AnnotationSupport{
+			{nginxSSLServicesAnnotation, Supported, "BackendTLSPolicy"},
+		}},
+		{Parser: GRPCServicesFeature, Annotations: []AnnotationSupport{
+			{nginxGRPCServicesAnnotation, Supported, "GRPCRoute"},
+		}},
+		{Parser: NewGRPCHeuristicsFeature(options.GRPCHeuristics)},
+		{Parser: ProxyBufferingFeature, Annotations: []AnnotationSupport{
+			{nginxProxyBufferingAnnotation, PartiallySupported, "captured per Service and reported"},
+			{nginxProxyBuffersAnnotation, PartiallySupported, "captured per Service and reported"},
+			{nginxProxyBufferSizeAnnotation, PartiallySupported, "captured per Service and reported"},
+		}},
+		{Parser: LoadBalancingMethodFeature, Annotations: []AnnotationSupport{
+			{nginxLBMethodAnnotation, PartiallySupported, "captured per Service and reported"},
+		}},
+		{Parser: KeepaliveFeature, Annotations: []AnnotationSupport{
+			{nginxKeepaliveAnnotation, PartiallySupported, "captured per Service and reported according to the target implementation"},
+		}},
+		{Parser: ProxyNextUpstreamFeature, Annotations: []AnnotationSupport{
+			{nginxProxyNextUpstreamAnnotation, PartiallySupported, "retry policy captured per Service and reported"},
+			{nginxProxyNextUpstreamTriesAnnotation, PartiallySupported, "retry policy captured per Service and reported"},
+			{nginxProxyNextUpstreamTimeoutAnnotation, PartiallySupported, "retry policy captured per Service and reported"},
+		}},
+		{Parser: NewServerSnippetsFeature(options.ConvertSnippetRedirects), Annotations: []AnnotationSupport{
+			{nginxServerSnippetsAnnotation, PartiallySupported, "server_name aliases added to the hostnames, proxy_set_header Host converted to a RequestHeaderModifier filter, return locations reported, redirects converted with convert-snippet-redirects"},
+			{nginxLocationSnippetsAnnotation, PartiallySupported, "proxy_set_header Host converted to a RequestHeaderModifier filter, other directives reported"},
+		}},
+		{Parser: SecurityFeature, Annotations: []AnnotationSupport{
+			{appProtectEnableAnnotation, NotSupported, "reported as a security finding"},
+			{appProtectPolicyAnnotation, NotSupported, "reported as a security finding"},
+			{appProtectSecurityLogAnnotation, NotSupported, "reported as a security finding"},
+			{appProtectDosResourceAnnotation, NotSupported, "reported as a security finding"},
+		}},
+		{Parser: AuthFeature, Annotations: []AnnotationSupport{
+			{nginxJWTKeyAnnotation, NotSupported, "reported as a migration blocker"},
+			{nginxJWTRealmAnnotation, NotSupported, "reported with nginx.com/jwt-key"},
+			{nginxJWTTokenAnnotation, NotSupported, "reported with nginx.com/jwt-key"},
+			{nginxJWTLoginURLAnnotation, NotSupported, "reported with nginx.com/jwt-key"},
+			{nginxBasicAuthSecretAnnotation, NotSupported, "reported as a migration blocker"},
+			{nginxBasicAuthRealmAnnotation, NotSupported, "reported with nginx.org/basic-auth-secret"},
+		}},
+	}
+}
+
+// CommunityAnnotations returns the support of the community ingress-nginx
+// annotations translated by TranslateCommunityAnnotations.
+func CommunityAnnotations() []AnnotationSupport {
+	return []AnnotationSupport{
+		{communityRewriteTargetAnnotation, PartiallySupported, "translated to nginx.org/rewrites, regex capture groups are reported"},
+		{communitySSLRedirectAnnotation, Supported, "translated to nginx.org/redirect-to-https"},
+		{communityForceSSLRedirectAnnotation, Supported, "translated to nginx.org/redirect-to-https"},
+		{communityBackendProtocolAnnotation, PartiallySupported, "HTTPS, GRPC and GRPCS translated to nginx.org/ssl-services and nginx.org/grpc-services, other protocols are reported"},
+		{communityProxySetHeadersAnnotation, NotSupported, "reported, the ConfigMap is not converted"},
+	}
+}
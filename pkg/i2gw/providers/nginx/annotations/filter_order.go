@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"slices"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// httpFilterOrder is the order in which HTTPRoute filters are emitted. It follows
+// the order NGINX processes a request in: a redirect short-circuits the request,
+// the URI is rewritten before the upstream request headers are built, mirroring
+// happens with the rewritten request, and response headers are changed last.
+// Filter types which are not listed here are kept after the listed ones.
+var httpFilterOrder = []gatewayv1.HTTPRouteFilterType{
+	gatewayv1.HTTPRouteFilterRequestRedirect,
+	gatewayv1.HTTPRouteFilterURLRewrite,
+	gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+	gatewayv1.HTTPRouteFilterRequestMirror,
+	gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+	gatewayv1.HTTPRouteFilterExtensionRef,
+}
+
+// grpcFilterOrder is the GRPCRoute equivalent of httpFilterOrder.
+var grpcFilterOrder = []gatewayv1.GRPCRouteFilterType{
+	gatewayv1.GRPCRouteFilterRequestHeaderModifier,
+	gatewayv1.GRPCRouteFilterRequestMirror,
+	gatewayv1.GRPCRouteFilterResponseHeaderModifier,
+	gatewayv1.GRPCRouteFilterExtensionRef,
+}
+
+// FilterOrderFeature sorts the filters generated by the other annotation features
+// into a deterministic order, once their duplicates are removed. It must run after
+// every feature and provider pass that adds or moves filters, so the converter
+// runs it last.
+//
+// Annotations in this provider apply to the whole Ingress, so filters are placed
+// on route rules. Filters found on backendRefs are sorted in place and never
+// moved to the rule, since they only apply to that backend.
+func FilterOrderFeature(_ []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for key, httpRouteContext := range ir.HTTPRoutes {
+		for i := range httpRouteContext.HTTPRoute.Spec.Rules {
+			rule := &httpRouteContext.HTTPRoute.Spec.Rules[i]
+			rule.Filters = common.DeduplicateHTTPFilters(rule.Filters)
+			sortHTTPFilters(rule.Filters)
+			for j := range rule.BackendRefs {
+				rule.BackendRefs[j].Filters = common.DeduplicateHTTPFilters(rule.BackendRefs[j].Filters)
+				sortHTTPFilters(rule.BackendRefs[j].Filters)
+			}
+		}
+		ir.HTTPRoutes[key] = httpRouteContext
+	}
+
+	for key, grpcRoute := range ir.GRPCRoutes {
+		for i := range grpcRoute.Spec.Rules {
+			rule := &grpcRoute.Spec.Rules[i]
+			sortGRPCFilters(rule.Filters)
+			for j := range rule.BackendRefs {
+				sortGRPCFilters(rule.BackendRefs[j].Filters)
+			}
+		}
+		ir.GRPCRoutes[key] = grpcRoute
+	}
+
+	return nil
+}
+
+// sortHTTPFilters stable sorts the filters by httpFilterOrder so that filters of
+// the same type keep the order in which they were added.
+func sortHTTPFilters(filters []gatewayv1.HTTPRouteFilter) {
+	slices.SortStableFunc(filters, func(a, b gatewayv1.HTTPRouteFilter) int {
+		return filterRank(httpFilterOrder, a.Type) - filterRank(httpFilterOrder, b.Type)
+	})
+}
+
+// sortGRPCFilters stable sorts the filters by grpcFilterOrder.
+func sortGRPCFilters(filters []gatewayv1.GRPCRouteFilter) {
+	slices.SortStableFunc(filters, func(a, b gatewayv1.GRPCRouteFilter) int {
+		return filterRank(grpcFilterOrder, a.Type) - filterRank(grpcFilterOrder, b.Type)
+	})
+}
+
+func filterRank[T comparable](order []T, filterType T) int {
+	if i := slices.Index(order, filterType); i >= 0 {
+		return i
+	}
+	return len(order)
+}
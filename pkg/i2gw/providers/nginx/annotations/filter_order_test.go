@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestFilterOrderFeature(t *testing.T) {
+	hsts := gatewayv1.HTTPRouteFilter{
+		Type:                   gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=1"}}},
+	}
+	hide := gatewayv1.HTTPRouteFilter{
+		Type:                   gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{Remove: []string{"X-Powered-By"}},
+	}
+	setHeaders := gatewayv1.HTTPRouteFilter{
+		Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "X-Foo", Value: "bar"}}},
+	}
+	rewrite := gatewayv1.HTTPRouteFilter{
+		Type:       gatewayv1.HTTPRouteFilterURLRewrite,
+		URLRewrite: &gatewayv1.HTTPURLRewriteFilter{},
+	}
+
+	tests := []struct {
+		name     string
+		filters  []gatewayv1.HTTPRouteFilter
+		expected []gatewayv1.HTTPRouteFilter
+	}{
+		{
+			name:     "rewrite is moved before header modifiers",
+			filters:  []gatewayv1.HTTPRouteFilter{hide, setHeaders, rewrite},
+			expected: []gatewayv1.HTTPRouteFilter{rewrite, setHeaders, hide},
+		},
+		{
+			name:    "header modifiers of the same type are merged",
+			filters: []gatewayv1.HTTPRouteFilter{hide, hsts, setHeaders},
+			expected: []gatewayv1.HTTPRouteFilter{setHeaders, {
+				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set:    []gatewayv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=1"}},
+					Remove: []string{"X-Powered-By"},
+				},
+			}},
+		},
+		{
+			name:     "identical filters are removed",
+			filters:  []gatewayv1.HTTPRouteFilter{setHeaders, rewrite, setHeaders, rewrite},
+			expected: []gatewayv1.HTTPRouteFilter{rewrite, setHeaders},
+		},
+		{
+			name: "no filters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := types.NamespacedName{Namespace: "default", Name: "test-route"}
+			backendFilters := append([]gatewayv1.HTTPRouteFilter(nil), tt.filters...)
+			ir := intermediate.IR{
+				HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+					key: {HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{
+						Rules: []gatewayv1.HTTPRouteRule{{
+							Filters:     append([]gatewayv1.HTTPRouteFilter(nil), tt.filters...),
+							BackendRefs: []gatewayv1.HTTPBackendRef{{Filters: backendFilters}},
+						}},
+					}}},
+				},
+			}
+
+			if errs := FilterOrderFeature(nil, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			rule := ir.HTTPRoutes[key].HTTPRoute.Spec.Rules[0]
+			assertFilterOrder(t, "rule", rule.Filters, tt.expected)
+			// Backend filters are sorted but stay on the backendRef.
+			if len(rule.BackendRefs) != 1 {
+				t.Fatalf("Expected 1 backendRef, got %d", len(rule.BackendRefs))
+			}
+			assertFilterOrder(t, "backendRef", rule.BackendRefs[0].Filters, tt.expected)
+		})
+	}
+}
+
+func assertFilterOrder(t *testing.T, placement string, actual, expected []gatewayv1.HTTPRouteFilter) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d %s filters, got %d", len(expected), placement, len(actual))
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(actual[i], expected[i]) {
+			t.Errorf("Expected %s filter %d to be %+v, got %+v", placement, i, expected[i], actual[i])
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// grpcPathRegexp matches the paths of gRPC requests, /package.Service/Method, or
+// the prefix of all the methods of a service, /package.Service/.
+var grpcPathRegexp = regexp.MustCompile(`^/[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)+(/([A-Za-z_][A-Za-z0-9_]*)?)?$`)
+
+// grpcContentTypeRegexp matches snippet conditions on the gRPC Content-Type, e.g.
+// if ($http_content_type ~ "application/grpc").
+var grpcContentTypeRegexp = regexp.MustCompile(`\$(http_)?content_type\b[^)]*application/grpc`)
+
+// http2PortNamePrefixes are the Service port name prefixes conventionally used for
+// ports serving HTTP/2.
+var http2PortNamePrefixes = []string{"grpc", "h2c", "http2"}
+
+// NewGRPCHeuristicsFeature returns a FeatureParser detecting the gRPC backends of
+// Ingresses without nginx.org/grpc-services. A Service is detected when all its
+// paths look like gRPC methods and it is served over HTTP/2, as told by its port
+// name, or the snippets of the Ingress match the gRPC Content-Type. The detected
+// Services are converted as if listed in nginx.org/grpc-services. The feature does
+// nothing unless enabled.
+func NewGRPCHeuristicsFeature(enabled bool) i2gw.FeatureParser {
+	return func(ingresses []networkingv1.Ingress, servicePorts map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+		if !enabled {
+			return nil
+		}
+
+		var errs field.ErrorList
+		for i := range ingresses {
+			ingress := &ingresses[i]
+			if ingress.Annotations[nginxGRPCServicesAnnotation] != "" {
+				continue
+			}
+
+			services := detectGRPCServices(*ingress, servicePorts)
+			if len(services) == 0 {
+				continue
+			}
+			errs = append(errs, processGRPCServicesAnnotation(ingresses, *ingress, strings.Join(services, ","), ir)...)
+			notify(notifications.InfoNotification, fmt.Sprintf("Services %s detected as gRPC backends and converted to GRPCRoute rules, list them in %s to make it explicit",
+				strings.Join(services, ", "), nginxGRPCServicesAnnotation), ingress)
+		}
+		return errs
+	}
+}
+
+// detectGRPCServices returns the sorted Services of the ingress detected as gRPC
+// backends.
+func detectGRPCServices(ingress networkingv1.Ingress, servicePorts map[types.NamespacedName]map[string]int32) []string {
+	grpcContentType := grpcContentTypeRegexp.MatchString(ingress.Annotations[nginxServerSnippetsAnnotation]) ||
+		grpcContentTypeRegexp.MatchString(ingress.Annotations[nginxLocationSnippetsAnnotation])
+
+	candidates := map[string]bool{}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backend := path.Backend.Service
+			if backend == nil {
+				continue
+			}
+			detected, seen := candidates[backend.Name]
+			if seen && !detected {
+				continue
+			}
+			candidates[backend.Name] = grpcPathRegexp.MatchString(path.Path) &&
+				(grpcContentType || isHTTP2Port(ingress.Namespace, *backend, servicePorts))
+		}
+	}
+
+	var services []string
+	for service, detected := range candidates {
+		if detected {
+			services = append(services, service)
+		}
+	}
+	slices.Sort(services)
+	return services
+}
+
+// isHTTP2Port returns whether the name of the backend port, read from the backend
+// or from the known ports of the Service, is one conventionally used for HTTP/2.
+func isHTTP2Port(namespace string, backend networkingv1.IngressServiceBackend, servicePorts map[types.NamespacedName]map[string]int32) bool {
+	portName := backend.Port.Name
+	if portName == "" {
+		for name, port := range servicePorts[types.NamespacedName{Namespace: namespace, Name: backend.Name}] {
+			if port == backend.Port.Number {
+				portName = name
+				break
+			}
+		}
+	}
+	for _, prefix := range http2PortNamePrefixes {
+		if portName == prefix || strings.HasPrefix(portName, prefix+"-") {
+			return true
+		}
+	}
+	return false
+}
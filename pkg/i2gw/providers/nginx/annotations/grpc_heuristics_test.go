@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+func TestGRPCHeuristicsFeature(t *testing.T) {
+	servicePorts := map[types.NamespacedName]map[string]int32{
+		{Namespace: "default", Name: "grpc-api"}: {"grpc-api": 50051},
+		{Namespace: "default", Name: "web"}:      {"http": 8080},
+	}
+	backendPorts := map[string]int32{"grpc-api": 50051, "web": 8080}
+	newIngress := func(annotations map[string]string, paths map[string]string) networkingv1.Ingress {
+		ingress := networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-ingress", Namespace: "default", Annotations: annotations},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: ptr.To("nginx"),
+				Rules: []networkingv1.IngressRule{{
+					Host:             "example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{}},
+				}},
+			},
+		}
+		for path, service := range paths {
+			ingress.Spec.Rules[0].HTTP.Paths = append(ingress.Spec.Rules[0].HTTP.Paths, networkingv1.HTTPIngressPath{
+				Path:     path,
+				PathType: ptr.To(networkingv1.PathTypePrefix),
+				Backend: networkingv1.IngressBackend{
+					Service: &networkingv1.IngressServiceBackend{
+						Name: service,
+						Port: networkingv1.ServiceBackendPort{Number: backendPorts[service]},
+					},
+				},
+			})
+		}
+		return ingress
+	}
+
+	tests := []struct {
+		name           string
+		enabled        bool
+		ingress        networkingv1.Ingress
+		expectedRoutes int
+	}{
+		{
+			name:           "gRPC method path to HTTP/2 port",
+			enabled:        true,
+			ingress:        newIngress(nil, map[string]string{"/helloworld.Greeter/SayHello": "grpc-api"}),
+			expectedRoutes: 1,
+		},
+		{
+			name:           "gRPC service path to HTTP/2 port",
+			enabled:        true,
+			ingress:        newIngress(nil, map[string]string{"/helloworld.Greeter/": "grpc-api"}),
+			expectedRoutes: 1,
+		},
+		{
+			name:    "gRPC path with Content-Type condition",
+			enabled: true,
+			ingress: newIngress(map[string]string{
+				nginxLocationSnippetsAnnotation: `if ($http_content_type ~ "application/grpc") { set $grpc 1; }`,
+			}, map[string]string{"/helloworld.Greeter/SayHello": "web"}),
+			expectedRoutes: 1,
+		},
+		{
+			name:    "gRPC path to HTTP/1 port",
+			enabled: true,
+			ingress: newIngress(nil, map[string]string{"/helloworld.Greeter/SayHello": "web"}),
+		},
+		{
+			name:    "non-gRPC path to HTTP/2 port",
+			enabled: true,
+			ingress: newIngress(nil, map[string]string{"/api/v1": "grpc-api"}),
+		},
+		{
+			name:    "Service with a non-gRPC path",
+			enabled: true,
+			ingress: newIngress(nil, map[string]string{"/helloworld.Greeter/SayHello": "grpc-api", "/": "grpc-api"}),
+		},
+		{
+			name:    "explicit grpc-services annotation",
+			enabled: true,
+			ingress: newIngress(map[string]string{nginxGRPCServicesAnnotation: "web"},
+				map[string]string{"/helloworld.Greeter/SayHello": "grpc-api"}),
+		},
+		{
+			name:    "heuristics disabled",
+			ingress: newIngress(nil, map[string]string{"/helloworld.Greeter/SayHello": "grpc-api"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingresses := []networkingv1.Ingress{tt.ingress}
+			ir, errs := common.ToIR(ingresses, servicePorts, i2gw.ProviderImplementationSpecificOptions{})
+			require.Empty(t, errs)
+
+			require.Empty(t, NewGRPCHeuristicsFeature(tt.enabled)(ingresses, servicePorts, &ir))
+			require.Len(t, ir.GRPCRoutes, tt.expectedRoutes)
+			if tt.expectedRoutes > 0 {
+				require.Empty(t, ir.HTTPRoutes, "the gRPC rules should be removed from the HTTPRoute")
+			}
+		})
+	}
+}
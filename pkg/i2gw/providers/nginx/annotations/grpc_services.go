@@ -17,10 +17,14 @@ limitations under the License.
 package annotations
 
 import (
+	"strings"
+
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
@@ -28,6 +32,11 @@ import (
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
+// h2cAppProtocol is the appProtocol of Service ports serving HTTP/2 over cleartext,
+// defined by the Kubernetes Service API. Many Gateway implementations only proxy
+// gRPC to cleartext backends declaring it.
+const h2cAppProtocol = "kubernetes.io/h2c"
+
 // GRPCServicesFeature processes nginx.org/grpc-services annotation
 func GRPCServicesFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
 	var errs field.ErrorList
@@ -36,7 +45,7 @@ func GRPCServicesFeature(ingresses []networkingv1.Ingress, _ map[types.Namespace
 	for _, rg := range ruleGroups {
 		for _, rule := range rg.Rules {
 			if grpcServices, exists := rule.Ingress.Annotations[nginxGRPCServicesAnnotation]; exists && grpcServices != "" {
-				errs = append(errs, processGRPCServicesAnnotation(rule.Ingress, grpcServices, ir)...)
+				errs = append(errs, processGRPCServicesAnnotation(ingresses, rule.Ingress, grpcServices, ir)...)
 			}
 		}
 	}
@@ -47,7 +56,7 @@ func GRPCServicesFeature(ingresses []networkingv1.Ingress, _ map[types.Namespace
 // processGRPCServicesAnnotation handles gRPC backend services
 //
 //nolint:unparam // ErrorList return type maintained for consistency
-func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices string, ir *intermediate.IR) field.ErrorList {
+func processGRPCServicesAnnotation(ingresses []networkingv1.Ingress, ingress networkingv1.Ingress, grpcServices string, ir *intermediate.IR) field.ErrorList {
 	var errs field.ErrorList //nolint:unparam // ErrorList return type maintained for consistency
 
 	// Parse comma-separated service names that should use gRPC
@@ -62,9 +71,13 @@ func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices st
 		ir.GRPCRoutes = make(map[types.NamespacedName]gatewayv1.GRPCRoute)
 	}
 
-	// Mark services as gRPC in provider-specific IR
-	if ir.Services == nil {
-		ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+	// Mark cleartext gRPC services as h2c in provider-specific IR, gRPC over TLS
+	// is configured with a BackendTLSPolicy by the ssl-services annotation.
+	sslServiceSet := sets.New(splitAndTrimCommaList(ingress.Annotations[nginxSSLServicesAnnotation])...)
+	for _, backend := range ingressServiceBackends(ingress, sets.New(services...)) {
+		if !sslServiceSet.Has(backend.Name) {
+			setServiceAppProtocol(ir, ingress.Namespace, backend, h2cAppProtocol)
+		}
 	}
 
 	// Process each ingress rule that uses gRPC services
@@ -73,14 +86,10 @@ func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices st
 			continue
 		}
 
-		routeName := common.RouteName(ingress.Name, rule.Host)
-		routeKey := types.NamespacedName{
-			Namespace: ingress.Namespace,
-			Name:      routeName,
-		}
+		// The gRPC rules share the route of the Ingresses merged on the host.
+		routeKey := common.HTTPRouteKey(ingresses, ingress, rule.Host)
 
 		var grpcRouteRules []gatewayv1.GRPCRouteRule
-		var remainingHTTPRules []gatewayv1.HTTPRouteRule
 
 		// Get existing HTTPRoute to copy filters and check for rules
 		httpRouteContext, httpRouteExists := ir.HTTPRoutes[routeKey]
@@ -90,19 +99,8 @@ func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices st
 			serviceName := path.Backend.Service.Name
 			if _, exists := grpcServiceSet[serviceName]; exists {
 				// This path uses a gRPC service - create GRPCRoute rule
-				grpcMatch := gatewayv1.GRPCRouteMatch{}
-
-				// Convert HTTP path to gRPC service/method match
-				if path.Path != "" {
-					service, method := common.ParseGRPCServiceMethod(path.Path)
-					if service != "" {
-						grpcMatch.Method = &gatewayv1.GRPCMethodMatch{
-							Service: &service,
-						}
-						if method != "" {
-							grpcMatch.Method.Method = &method
-						}
-					}
+				grpcMatch := gatewayv1.GRPCRouteMatch{
+					Method: grpcMethodMatch(path.Path, ingress.Annotations[nginxPathRegexAnnotation]),
 				}
 
 				// Create backend reference
@@ -140,6 +138,17 @@ func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices st
 
 		// Create GRPCRoute if we have any gRPC rules
 		if len(grpcRouteRules) > 0 {
+			if existing, exists := ir.GRPCRoutes[routeKey]; exists {
+				existing.Spec.Rules = append(existing.Spec.Rules, grpcRouteRules...)
+				ir.GRPCRoutes[routeKey] = existing
+				removeGRPCRulesFromHTTPRoute(ir, routeKey, grpcServiceSet)
+				continue
+			}
+
+			gatewayName := common.GetIngressClass(ingress)
+			if gatewayName == "" {
+				gatewayName = NginxIngressClass
+			}
 			var hostnames []gatewayv1.Hostname
 			if rule.Host != "" {
 				hostnames = []gatewayv1.Hostname{gatewayv1.Hostname(rule.Host)}
@@ -151,19 +160,14 @@ func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices st
 					Kind:       GRPCRouteKind,
 				},
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      routeName,
-					Namespace: ingress.Namespace,
+					Name:      routeKey.Name,
+					Namespace: routeKey.Namespace,
 				},
 				Spec: gatewayv1.GRPCRouteSpec{
 					CommonRouteSpec: gatewayv1.CommonRouteSpec{
 						ParentRefs: []gatewayv1.ParentReference{
 							{
-								Name: func() gatewayv1.ObjectName {
-									if ingress.Spec.IngressClassName != nil {
-										return gatewayv1.ObjectName(*ingress.Spec.IngressClassName)
-									}
-									return NginxIngressClass
-								}(),
+								Name: gatewayv1.ObjectName(gatewayName),
 							},
 						},
 					},
@@ -173,26 +177,29 @@ func processGRPCServicesAnnotation(ingress networkingv1.Ingress, grpcServices st
 			}
 
 			ir.GRPCRoutes[routeKey] = grpcRoute
-
-			// Remove HTTP rules that correspond to gRPC services from the HTTPRoute
-			if httpRouteExists {
-				remainingHTTPRules = common.RemoveGRPCRulesFromHTTPRoute(&httpRouteContext.HTTPRoute, grpcServiceSet)
-
-				// If no rules remain, remove the entire HTTPRoute
-				if len(remainingHTTPRules) == 0 {
-					delete(ir.HTTPRoutes, routeKey)
-				} else {
-					// Update HTTPRoute with remaining rules
-					httpRouteContext.HTTPRoute.Spec.Rules = remainingHTTPRules
-					ir.HTTPRoutes[routeKey] = httpRouteContext
-				}
-			}
+			removeGRPCRulesFromHTTPRoute(ir, routeKey, grpcServiceSet)
 		}
 	}
 
 	return errs
 }
 
+// removeGRPCRulesFromHTTPRoute removes the HTTP rules that correspond to gRPC
+// services from the HTTPRoute, and the HTTPRoute itself if no rules remain.
+func removeGRPCRulesFromHTTPRoute(ir *intermediate.IR, routeKey types.NamespacedName, grpcServiceSet map[string]struct{}) {
+	httpRouteContext, exists := ir.HTTPRoutes[routeKey]
+	if !exists {
+		return
+	}
+	remainingHTTPRules := common.RemoveGRPCRulesFromHTTPRoute(&httpRouteContext.HTTPRoute, grpcServiceSet)
+	if len(remainingHTTPRules) == 0 {
+		delete(ir.HTTPRoutes, routeKey)
+		return
+	}
+	httpRouteContext.HTTPRoute.Spec.Rules = remainingHTTPRules
+	ir.HTTPRoutes[routeKey] = httpRouteContext
+}
+
 // findAndConvertFiltersForGRPCPath finds the HTTP rule that matches the given path and converts its filters to gRPC filters
 func findAndConvertFiltersForGRPCPath(httpRules []gatewayv1.HTTPRouteRule, grpcPath string) []gatewayv1.GRPCRouteFilter {
 	// Find the HTTP rule that contains this path
@@ -229,3 +236,131 @@ func findAndConvertFiltersForGRPCPath(httpRules []gatewayv1.HTTPRouteRule, grpcP
 	}
 	return nil
 }
+
+// grpcMethodMatch converts the path of an Ingress to the method match of a
+// GRPCRoute rule, nil when the path matches every method. The path is split on
+// its first slash after the leading one into the service and the method, which
+// are matched with Exact matches. When the nginx.org/path-regex annotation makes
+// the path a regular expression, they are matched with RegularExpression matches,
+// unless the path is anchored at both ends, has no special characters and is
+// case-sensitive. A part matching any name, e.g. .*, is not matched.
+func grpcMethodMatch(path, pathRegex string) *gatewayv1.GRPCMethodMatch {
+	regex := pathRegex == "true" || pathRegex == "case_sensitive" || pathRegex == "case_insensitive"
+	if !regex {
+		service, method := common.ParseGRPCServiceMethod(path)
+		if service == "" {
+			return nil
+		}
+		match := &gatewayv1.GRPCMethodMatch{
+			Type:    ptr.To(gatewayv1.GRPCMethodMatchExact),
+			Service: &service,
+		}
+		if method != "" {
+			match.Method = &method
+		}
+		return match
+	}
+
+	startAnchored := strings.HasPrefix(path, "^")
+	endAnchored := strings.HasSuffix(path, "$") && !strings.HasSuffix(path, `\$`)
+	path = strings.TrimPrefix(path, "^")
+	if endAnchored {
+		path = strings.TrimSuffix(path, "$")
+	}
+	// The leading slash of a gRPC path starts it, and anchors the service.
+	rooted := strings.HasPrefix(path, "/") || strings.HasPrefix(path, `\/`)
+	path = strings.TrimPrefix(strings.TrimPrefix(path, `\/`), "/")
+	service, method, split := splitRegexPath(path)
+	if isAnyName(service) {
+		service = ""
+	}
+	if isAnyName(method) {
+		method = ""
+	}
+	// NGINX matches the unanchored ends of the regular expression anywhere in
+	// the path.
+	if !startAnchored && !rooted {
+		service = anyPrefix(service)
+	}
+	if !endAnchored {
+		if split {
+			method = anySuffix(method)
+		} else {
+			service = anySuffix(service)
+		}
+	}
+	if service == "" && method == "" {
+		return nil
+	}
+
+	match := &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression)}
+	switch {
+	case pathRegex == "case_insensitive":
+		service, method = caseInsensitive(service), caseInsensitive(method)
+	case startAnchored && endAnchored && !regexCharsRegexp.MatchString(service) && !regexCharsRegexp.MatchString(method):
+		match.Type = ptr.To(gatewayv1.GRPCMethodMatchExact)
+	}
+	if service != "" {
+		match.Service = &service
+	}
+	if method != "" {
+		match.Method = &method
+	}
+	return match
+}
+
+// splitRegexPath splits a regular expression path, without its leading slash, on
+// its first slash, escaped or not, outside of a group or a character class. The
+// split result tells whether the path has such a slash.
+func splitRegexPath(path string) (service, method string, split bool) {
+	depth := 0
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '\\':
+			if depth == 0 && i+1 < len(path) && path[i+1] == '/' {
+				return path[:i], path[i+2:], true
+			}
+			i++
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				return path[:i], path[i+1:], true
+			}
+		}
+	}
+	return path, "", false
+}
+
+// isAnyName tells whether the regular expression matches any service or method
+// name.
+func isAnyName(regex string) bool {
+	return regex == ".*" || regex == ".+"
+}
+
+// anyPrefix lets a non-empty regular expression match after any prefix.
+func anyPrefix(regex string) string {
+	if regex == "" || strings.HasPrefix(regex, ".*") {
+		return regex
+	}
+	return ".*" + regex
+}
+
+// anySuffix lets a non-empty regular expression match before any suffix.
+func anySuffix(regex string) string {
+	if regex == "" || strings.HasSuffix(regex, ".*") {
+		return regex
+	}
+	return regex + ".*"
+}
+
+// caseInsensitive injects the (?i) flag at the beginning of a non-empty regular
+// expression, as nginx.org/path-regex: case_insensitive does for the paths.
+func caseInsensitive(regex string) string {
+	if regex == "" || strings.HasPrefix(regex, "(?i)") {
+		return regex
+	}
+	return "(?i)" + regex
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package annotations
 
 import (
+	"reflect"
 	"testing"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -318,6 +319,14 @@ func TestGRPCServicesWithMixedServices(t *testing.T) {
 	}
 
 	grpcRule := grpcRoute.Spec.Rules[0]
+	expectedMethod := &gatewayv1.GRPCMethodMatch{
+		Type:    ptr.To(gatewayv1.GRPCMethodMatchExact),
+		Service: ptr.To("grpc.service"),
+		Method:  ptr.To("Method"),
+	}
+	if len(grpcRule.Matches) != 1 || !reflect.DeepEqual(grpcRule.Matches[0].Method, expectedMethod) {
+		t.Errorf("Expected the method match %+v, got %+v", expectedMethod, grpcRule.Matches)
+	}
 	if len(grpcRule.BackendRefs) != 1 {
 		t.Errorf("Expected 1 gRPC backend ref, got %d", len(grpcRule.BackendRefs))
 		return
@@ -365,3 +374,185 @@ func TestGRPCServicesWithMixedServices(t *testing.T) {
 		t.Error("GRPCRoute should have ResponseHeaderModifier filter")
 	}
 }
+
+func TestGRPCServicesAppProtocol(t *testing.T) {
+	backend := func(name string) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+			Name: name,
+			Port: networkingv1.ServiceBackendPort{Number: 50051},
+		}}
+	}
+	ingress := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "grpc-ingress",
+			Namespace: "default",
+			Annotations: map[string]string{
+				nginxGRPCServicesAnnotation: "grpc-cleartext,grpc-tls",
+				nginxSSLServicesAnnotation:  "grpc-tls",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ptr.To("nginx"),
+			Rules: []networkingv1.IngressRule{{
+				Host: "grpc.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{Path: "/cleartext.Service", PathType: ptr.To(networkingv1.PathTypePrefix), Backend: backend("grpc-cleartext")},
+						{Path: "/tls.Service", PathType: ptr.To(networkingv1.PathTypePrefix), Backend: backend("grpc-tls")},
+					},
+				}},
+			}},
+		},
+	}
+
+	ir := intermediate.IR{HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{}}
+	if errs := GRPCServicesFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	cleartext := ir.Services[types.NamespacedName{Namespace: "default", Name: "grpc-cleartext"}]
+	if cleartext.Nginx == nil || cleartext.Nginx.AppProtocols["50051"] != h2cAppProtocol {
+		t.Errorf("Expected grpc-cleartext port 50051 to use %s, got %+v", h2cAppProtocol, cleartext.Nginx)
+	}
+	if tls, ok := ir.Services[types.NamespacedName{Namespace: "default", Name: "grpc-tls"}]; ok {
+		t.Errorf("Expected no appProtocol for grpc-tls, got %+v", tls.Nginx)
+	}
+}
+
+func TestGRPCMethodMatch(t *testing.T) {
+	testCases := []struct {
+		name      string
+		path      string
+		pathRegex string
+		expected  *gatewayv1.GRPCMethodMatch
+	}{{
+		name:     "method",
+		path:     "/helloworld.Greeter/SayHello",
+		expected: &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchExact), Service: ptr.To("helloworld.Greeter"), Method: ptr.To("SayHello")},
+	}, {
+		name:     "service",
+		path:     "/helloworld.Greeter/",
+		expected: &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchExact), Service: ptr.To("helloworld.Greeter")},
+	}, {
+		name: "root",
+		path: "/",
+	}, {
+		name:      "anchored regex without special characters",
+		path:      "^/helloworld.Greeter/SayHello$",
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchExact), Service: ptr.To("helloworld.Greeter"), Method: ptr.To("SayHello")},
+	}, {
+		name:      "unanchored regex without special characters",
+		path:      "/helloworld.Greeter/SayHello",
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To("helloworld.Greeter"), Method: ptr.To("SayHello.*")},
+	}, {
+		name:      "regex anchored at the start",
+		path:      "^/helloworld.Greeter",
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To("helloworld.Greeter.*")},
+	}, {
+		name:      "regex anchored at the end",
+		path:      "Greeter/SayHello$",
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To(".*Greeter"), Method: ptr.To("SayHello")},
+	}, {
+		name:      "case insensitive anchored regex without special characters",
+		path:      "^/helloworld.Greeter/SayHello$",
+		pathRegex: "case_insensitive",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To("(?i)helloworld.Greeter"), Method: ptr.To("(?i)SayHello")},
+	}, {
+		name:      "regex methods",
+		path:      `^/helloworld\.Greeter/(SayHello|SayGoodbye)$`,
+		pathRegex: "case_sensitive",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To(`helloworld\.Greeter`), Method: ptr.To("(SayHello|SayGoodbye)")},
+	}, {
+		name:      "regex service of any method",
+		path:      `/helloworld\..*/.*`,
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To(`helloworld\..*`)},
+	}, {
+		name:      "regex method of any service",
+		path:      `/.*/Get[A-Z].*`,
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Method: ptr.To("Get[A-Z].*")},
+	}, {
+		name:      "regex with escaped and grouped slashes",
+		path:      `\/helloworld\.Greeter\/(Say/Hello|List)`,
+		pathRegex: "true",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To(`helloworld\.Greeter`), Method: ptr.To("(Say/Hello|List).*")},
+	}, {
+		name:      "case insensitive regex",
+		path:      `/helloworld\.greeter/say.*`,
+		pathRegex: "case_insensitive",
+		expected:  &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To(`(?i)helloworld\.greeter`), Method: ptr.To("(?i)say.*")},
+	}, {
+		name:      "regex of any path",
+		path:      "/.*",
+		pathRegex: "true",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if match := grpcMethodMatch(tc.path, tc.pathRegex); !reflect.DeepEqual(match, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, match)
+			}
+		})
+	}
+}
+
+func TestGRPCServicesRegexPaths(t *testing.T) {
+	ingress := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "grpc-ingress",
+			Namespace: "default",
+			Annotations: map[string]string{
+				nginxGRPCServicesAnnotation: "grpc-service",
+				nginxPathRegexAnnotation:    "true",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ptr.To("nginx"),
+			Rules: []networkingv1.IngressRule{{
+				Host: "grpc.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     `/helloworld\.Greeter/Say.*`,
+							PathType: ptr.To(networkingv1.PathTypeImplementationSpecific),
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: "grpc-service",
+									Port: networkingv1.ServiceBackendPort{Number: 50051},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	routeKey := types.NamespacedName{Namespace: ingress.Namespace, Name: common.RouteName(ingress.Name, ingress.Spec.Rules[0].Host)}
+	ir := intermediate.IR{
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			routeKey: {HTTPRoute: gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: routeKey.Namespace, Name: routeKey.Name}}},
+		},
+	}
+
+	if errs := GRPCServicesFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	grpcRoute, ok := ir.GRPCRoutes[routeKey]
+	if !ok || len(grpcRoute.Spec.Rules) != 1 || len(grpcRoute.Spec.Rules[0].Matches) != 1 {
+		t.Fatalf("Expected a GRPCRoute with a single match, got %+v", ir.GRPCRoutes)
+	}
+	expected := &gatewayv1.GRPCMethodMatch{
+		Type:    ptr.To(gatewayv1.GRPCMethodMatchRegularExpression),
+		Service: ptr.To(`helloworld\.Greeter`),
+		Method:  ptr.To("Say.*"),
+	}
+	if method := grpcRoute.Spec.Rules[0].Matches[0].Method; !reflect.DeepEqual(method, expected) {
+		t.Errorf("Expected the method match %+v, got %+v", expected, method)
+	}
+}
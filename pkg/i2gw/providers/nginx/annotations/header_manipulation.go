@@ -45,7 +45,7 @@ func HeaderManipulationFeature(ingresses []networkingv1.Ingress, _ map[types.Nam
 
 			// Process proxy-hide-headers annotation
 			if hideHeaders, exists := rule.Ingress.Annotations[nginxProxyHideHeadersAnnotation]; exists && hideHeaders != "" {
-				filter := createResponseHeaderModifier(hideHeaders)
+				filter := createResponseHeaderModifier(hideHeaders, &rule.Ingress)
 				if filter != nil {
 					errs = append(errs, addFilterToHTTPRoute(&httpRouteContext.HTTPRoute, rule.Ingress, *filter)...)
 				}
@@ -53,7 +53,7 @@ func HeaderManipulationFeature(ingresses []networkingv1.Ingress, _ map[types.Nam
 
 			// Process proxy-set-headers annotation
 			if setHeaders, exists := rule.Ingress.Annotations[nginxProxySetHeadersAnnotation]; exists && setHeaders != "" {
-				filter := createRequestHeaderModifier(setHeaders)
+				filter := createRequestHeaderModifier(setHeaders, &rule.Ingress)
 				if filter != nil {
 					errs = append(errs, addFilterToHTTPRoute(&httpRouteContext.HTTPRoute, rule.Ingress, *filter)...)
 				}
@@ -67,7 +67,8 @@ func HeaderManipulationFeature(ingresses []networkingv1.Ingress, _ map[types.Nam
 	return errs
 }
 
-// addFilterToHTTPRoute adds a filter to all HTTPRoute rules
+// addFilterToHTTPRoute adds a filter to all HTTPRoute rules, merged into their
+// filter of the same type when the Ingresses of the route each add one
 //
 //nolint:unparam // ErrorList return type maintained for consistency
 func addFilterToHTTPRoute(httpRoute *gatewayv1.HTTPRoute, _ networkingv1.Ingress, filter gatewayv1.HTTPRouteFilter) field.ErrorList {
@@ -75,59 +76,40 @@ func addFilterToHTTPRoute(httpRoute *gatewayv1.HTTPRoute, _ networkingv1.Ingress
 
 	// Apply filter to all rules
 	for i := range httpRoute.Spec.Rules {
-		if httpRoute.Spec.Rules[i].Filters == nil {
-			httpRoute.Spec.Rules[i].Filters = []gatewayv1.HTTPRouteFilter{}
-		}
-		httpRoute.Spec.Rules[i].Filters = append(httpRoute.Spec.Rules[i].Filters, filter)
+		httpRoute.Spec.Rules[i].Filters = common.AddHTTPFilter(httpRoute.Spec.Rules[i].Filters, filter)
 	}
 
 	return errs
 }
 
 // createResponseHeaderModifier creates a ResponseHeaderModifier filter from comma-separated header names
-func createResponseHeaderModifier(hideHeaders string) *gatewayv1.HTTPRouteFilter {
-	headersToRemove := parseCommaSeparatedHeaders(hideHeaders)
+func createResponseHeaderModifier(hideHeaders string, ingress *networkingv1.Ingress) *gatewayv1.HTTPRouteFilter {
+	headersToRemove := normalizeHeaderNames(parseCommaSeparatedHeaders(hideHeaders), nginxProxyHideHeadersAnnotation, ingress)
 	if len(headersToRemove) == 0 {
 		return nil
 	}
 
-	return &gatewayv1.HTTPRouteFilter{
-		Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
-		ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
-			Remove: headersToRemove,
-		},
-	}
+	filter := common.ResponseHeaderModifierFilter(nil, nil, headersToRemove)
+	return &filter
 }
 
 // createRequestHeaderModifier creates a RequestHeaderModifier filter from proxy-set-headers annotation
-func createRequestHeaderModifier(setHeaders string) *gatewayv1.HTTPRouteFilter {
-	headers := parseSetHeaders(setHeaders)
-	if len(headers) == 0 {
-		return nil
-	}
-
+func createRequestHeaderModifier(setHeaders string, ingress *networkingv1.Ingress) *gatewayv1.HTTPRouteFilter {
 	var headersToSet []gatewayv1.HTTPHeader
-	for name, value := range headers {
-		if value != "" && !strings.Contains(value, "$") {
-			headersToSet = append(headersToSet, gatewayv1.HTTPHeader{
-				Name:  gatewayv1.HTTPHeaderName(name),
-				Value: value,
-			})
+	for _, header := range parseSetHeaders(setHeaders) {
+		if header.Value != "" && !strings.Contains(header.Value, "$") {
+			headersToSet = append(headersToSet, header)
 		}
 		// Note: Headers with NGINX variables cannot be converted to Gateway API
 		// as Gateway API doesn't support dynamic header values
 	}
 
+	headersToSet = normalizeHeaders(headersToSet, nginxProxySetHeadersAnnotation, ingress)
 	if len(headersToSet) == 0 {
 		return nil
 	}
-
-	return &gatewayv1.HTTPRouteFilter{
-		Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
-		RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
-			Set: headersToSet,
-		},
-	}
+	filter := common.RequestHeaderModifierFilter(headersToSet, nil, nil)
+	return &filter
 }
 
 // parseCommaSeparatedHeaders parses a comma-separated list of header names
@@ -135,10 +117,11 @@ func parseCommaSeparatedHeaders(headersList string) []string {
 	return splitAndTrimCommaList(headersList)
 }
 
-// parseSetHeaders parses nginx.org/proxy-set-headers annotation format
+// parseSetHeaders parses nginx.org/proxy-set-headers annotation format, returning
+// the headers in the order they are listed.
 // Supports both header names and header:value pairs
-func parseSetHeaders(setHeaders string) map[string]string {
-	headers := make(map[string]string)
+func parseSetHeaders(setHeaders string) []gatewayv1.HTTPHeader {
+	var headers []gatewayv1.HTTPHeader
 	parts := splitAndTrimCommaList(setHeaders)
 
 	for _, part := range parts {
@@ -149,7 +132,7 @@ func parseSetHeaders(setHeaders string) map[string]string {
 				headerName := strings.TrimSpace(kv[0])
 				headerValue := strings.TrimSpace(kv[1])
 				if headerName != "" {
-					headers[headerName] = headerValue
+					headers = append(headers, gatewayv1.HTTPHeader{Name: gatewayv1.HTTPHeaderName(headerName), Value: headerValue})
 				}
 			}
 		}
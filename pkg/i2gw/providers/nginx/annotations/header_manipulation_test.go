@@ -135,7 +135,10 @@ func TestParseSetHeaders(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseSetHeaders(tt.input)
+			result := map[string]string{}
+			for _, header := range parseSetHeaders(tt.input) {
+				result[string(header.Name)] = header.Value
+			}
 
 			if len(result) != len(tt.expected) {
 				t.Errorf("Expected %d headers, got %d", len(tt.expected), len(result))
@@ -218,7 +221,7 @@ func TestHideHeaders(t *testing.T) {
 				},
 			}
 
-			filter := createResponseHeaderModifier(tt.hideHeaders)
+			filter := createResponseHeaderModifier(tt.hideHeaders, &ingress)
 			if filter == nil {
 				t.Error("Expected filter to be created")
 				return
@@ -356,7 +359,7 @@ func TestSetHeaders(t *testing.T) {
 				},
 			}
 
-			filter := createRequestHeaderModifier(tt.setHeaders)
+			filter := createRequestHeaderModifier(tt.setHeaders, &ingress)
 			var errs field.ErrorList
 			if filter != nil {
 				// Apply filter to first rule (simplified for test)
@@ -634,7 +637,8 @@ func TestCreateResponseHeaderModifier(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := createResponseHeaderModifier(tc.input)
+			ingress := createTestIngress("test-ingress", "default", nil)
+			result := createResponseHeaderModifier(tc.input, &ingress)
 			if !reflect.DeepEqual(result, tc.expectedFilter) {
 				t.Errorf("Expected %+v, got %+v", tc.expectedFilter, result)
 			}
@@ -685,7 +689,8 @@ func TestCreateRequestHeaderModifier(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := createRequestHeaderModifier(tc.input)
+			ingress := createTestIngress("test-ingress", "default", nil)
+			result := createRequestHeaderModifier(tc.input, &ingress)
 
 			// Special handling for multiple headers test due to map iteration order
 			if tc.name == "multiple headers with values" {
@@ -822,13 +827,19 @@ func TestHeaderManipulationWithSourceIngressMapping(t *testing.T) {
 	// Verify filters applied correctly
 	updatedRoute := ir.HTTPRoutes[routeKey].HTTPRoute
 
-	// Both rules should have 3 filters total (from app1: hide + set headers, from app2: set header)
+	// Both rules should have 2 filters (from app1: hide, from app1 and app2: the
+	// set headers merged into a single RequestHeaderModifier)
 	// Since we no longer use source ingress mapping, all filters are applied to all rules
-	if len(updatedRoute.Spec.Rules[0].Filters) != 3 {
-		t.Errorf("Rule 0: expected 3 filters, got %d", len(updatedRoute.Spec.Rules[0].Filters))
-	}
-
-	if len(updatedRoute.Spec.Rules[1].Filters) != 3 {
-		t.Errorf("Rule 1: expected 3 filters, got %d", len(updatedRoute.Spec.Rules[1].Filters))
+	expectedSet := []gatewayv1.HTTPHeader{{Name: "X-App", Value: "app2"}, {Name: "X-Custom-Header", Value: "value1"}}
+	for i, rule := range updatedRoute.Spec.Rules {
+		if len(rule.Filters) != 2 {
+			t.Errorf("Rule %d: expected 2 filters, got %d", i, len(rule.Filters))
+			continue
+		}
+		for _, filter := range rule.Filters {
+			if filter.Type == gatewayv1.HTTPRouteFilterRequestHeaderModifier && !reflect.DeepEqual(filter.RequestHeaderModifier.Set, expectedSet) {
+				t.Errorf("Rule %d: expected set headers %v, got %v", i, expectedSet, filter.RequestHeaderModifier.Set)
+			}
+		}
 	}
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"net/textproto"
+	"regexp"
+	"slices"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// headerNameRegexp matches the RFC 7230 tokens Gateway API accepts as header
+// names.
+var headerNameRegexp = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+\\-.^_`|~]+$")
+
+// maxHeaderNameLength is the maximum length of a Gateway API header name.
+const maxHeaderNameLength = 256
+
+// normalizeHeaderName validates the header name against the constraints of
+// Gateway API and returns it in canonical case, e.g. X-Forwarded-For for
+// x-forwarded-for, as NGINX matches header names case-insensitively.
+func normalizeHeaderName(name string) (string, error) {
+	if len(name) > maxHeaderNameLength {
+		return "", fmt.Errorf("header name %q is longer than %d characters", name, maxHeaderNameLength)
+	}
+	if !headerNameRegexp.MatchString(name) {
+		return "", fmt.Errorf("header name %q is not a valid RFC 7230 token", name)
+	}
+	return textproto.CanonicalMIMEHeaderKey(name), nil
+}
+
+// normalizeHeaderNames returns the header names of the annotation in canonical
+// case, once each, in the order they are first listed. Invalid names are
+// dropped and names listed more than once are reported.
+func normalizeHeaderNames(names []string, annotation string, ingress *networkingv1.Ingress) []string {
+	var normalized []string
+	for _, name := range names {
+		canonical, err := normalizeHeaderName(name)
+		if err != nil {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s: %v, the header is not converted", annotation, err), ingress)
+			continue
+		}
+		if slices.Contains(normalized, canonical) {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s: header %s is listed more than once, header names are case-insensitive", annotation, canonical), ingress)
+			continue
+		}
+		normalized = append(normalized, canonical)
+	}
+	return normalized
+}
+
+// normalizeHeaders returns the headers of the annotation with their names in
+// canonical case, in the order they are first listed. Invalid names are dropped
+// and reported. Since Gateway API sets a header once, the values of a header
+// listed more than once, which NGINX sends as separate fields, are merged into
+// a comma-separated list, the equivalent field of RFC 7230, and reported.
+func normalizeHeaders(headers []gatewayv1.HTTPHeader, annotation string, ingress *networkingv1.Ingress) []gatewayv1.HTTPHeader {
+	var normalized []gatewayv1.HTTPHeader
+	for _, header := range headers {
+		canonical, err := normalizeHeaderName(string(header.Name))
+		if err != nil {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s: %v, the header is not converted", annotation, err), ingress)
+			continue
+		}
+		i := slices.IndexFunc(normalized, func(h gatewayv1.HTTPHeader) bool { return string(h.Name) == canonical })
+		if i < 0 {
+			normalized = append(normalized, gatewayv1.HTTPHeader{Name: gatewayv1.HTTPHeaderName(canonical), Value: header.Value})
+			continue
+		}
+		normalized[i].Value += ", " + header.Value
+		notify(notifications.WarningNotification, fmt.Sprintf("%s: header %s is set more than once, its values are merged into %q", annotation, canonical, normalized[i].Value), ingress)
+	}
+	return normalized
+}
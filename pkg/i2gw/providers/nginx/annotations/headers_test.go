@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestNormalizeHeaderName(t *testing.T) {
+	tests := []struct {
+		name          string
+		expected      string
+		expectedError string
+	}{
+		{name: "x-forwarded-for", expected: "X-Forwarded-For"},
+		{name: "X-REQUEST-ID", expected: "X-Request-Id"},
+		{name: "x-custom_header", expected: "X-Custom_header"},
+		{name: "X Custom", expectedError: `header name "X Custom" is not a valid RFC 7230 token`},
+		{name: "X-Custom:", expectedError: `header name "X-Custom:" is not a valid RFC 7230 token`},
+		{name: strings.Repeat("x", 257), expectedError: "is longer than 256 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeHeaderName(tt.name)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil || got != tt.expected {
+				t.Errorf("Expected %s, got %s (%v)", tt.expected, got, err)
+			}
+		})
+	}
+}
+
+func TestHeaderManipulationNormalization(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	ingress := createTestIngress("test-ingress", "default", nil)
+
+	hide := createResponseHeaderModifier("server,X-Powered-By,SERVER,X Version", &ingress)
+	if hide == nil || !reflect.DeepEqual(hide.ResponseHeaderModifier.Remove, []string{"Server", "X-Powered-By"}) {
+		t.Errorf("Expected the names to remove to be normalized, got %+v", hide)
+	}
+
+	set := createRequestHeaderModifier("x-version: 1,X-Custom: a,X-VERSION: 2,Bad Header: value", &ingress)
+	expected := []gatewayv1.HTTPHeader{{Name: "X-Custom", Value: "a"}, {Name: "X-Version", Value: "1, 2"}}
+	if set == nil || !reflect.DeepEqual(set.RequestHeaderModifier.Set, expected) {
+		t.Errorf("Expected the headers to set to be %v, got %+v", expected, set)
+	}
+
+	var warnings []string
+	for _, n := range notifications.NotificationAggr.Notifications["nginx"] {
+		if n.Type == notifications.WarningNotification {
+			warnings = append(warnings, n.Message)
+		}
+	}
+	expectedWarnings := []string{
+		"nginx.org/proxy-hide-headers: header Server is listed more than once, header names are case-insensitive",
+		`nginx.org/proxy-hide-headers: header name "X Version" is not a valid RFC 7230 token, the header is not converted`,
+		`nginx.org/proxy-set-headers: header X-Version is set more than once, its values are merged into "1, 2"`,
+		`nginx.org/proxy-set-headers: header name "Bad Header" is not a valid RFC 7230 token, the header is not converted`,
+	}
+	if !reflect.DeepEqual(warnings, expectedWarnings) {
+		t.Errorf("Expected warnings %q, got %q", expectedWarnings, warnings)
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// The Host headers the backends of the Ingresses receive.
+const (
+	// PreserveHostHeader forwards the Host header of the client, as NGINX Ingress
+	// Controller does with proxy_set_header Host $host. Gateway API
+	// implementations preserve it as well, so no filter is generated.
+	PreserveHostHeader = "preserve"
+	// UpstreamHostHeader sets the Host header to the host of the backend Service,
+	// as NGINX does with proxy_set_header Host $proxy_host.
+	UpstreamHostHeader = "upstream"
+)
+
+// HostHeaders lists the supported Host headers.
+var HostHeaders = []string{PreserveHostHeader, UpstreamHostHeader}
+
+// hostHeaderRegexp matches the proxy_set_header directive of the Host header,
+// e.g. "proxy_set_header Host $host;".
+var hostHeaderRegexp = regexp.MustCompile(`(?i)proxy_set_header\s+Host\s+("[^"]*"|'[^']*'|[^;\s]+)\s*;`)
+
+// NewHostHeaderFeature returns a FeatureParser setting the Host header of the
+// requests to the backends of the Ingresses. The Host header an Ingress
+// configures with proxy_set_header in its location or server snippets, or with
+// a variable in nginx.org/proxy-set-headers, takes precedence over hostHeader:
+// $host and $http_host preserve the Host header of the client, $proxy_host sets
+// the host of the backend Service and a static value is set as is. The Host
+// header is set with RequestHeaderModifier filters on the rules, or on the
+// backendRefs of the rules routing to several Services.
+func NewHostHeaderFeature(hostHeader string) i2gw.FeatureParser {
+	return func(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+		for i := range ingresses {
+			ingress := &ingresses[i]
+			if hasStaticHostHeader(*ingress) {
+				// Converted by HeaderManipulationFeature.
+				continue
+			}
+
+			value, annotation := ingressHostHeader(*ingress)
+			switch {
+			case annotation == "":
+				value = hostHeader
+			case value == "$host" || value == "$http_host":
+				value = PreserveHostHeader
+			case value == "$proxy_host":
+				value = UpstreamHostHeader
+			case strings.Contains(value, "$"):
+				notify(notifications.WarningNotification, fmt.Sprintf("%s: the Host header %s cannot be converted since Gateway API does not support NGINX variables, the Host header is %s", annotation, value, hostHeaderDescription(hostHeader)), ingress)
+				value = hostHeader
+			}
+			if value == PreserveHostHeader {
+				continue
+			}
+
+			if setIngressHostHeader(ingresses, *ingress, value, ir) {
+				notify(notifications.InfoNotification, fmt.Sprintf("The Host header of the requests to the backends is %s", hostHeaderDescription(value)), ingress)
+			}
+		}
+		return nil
+	}
+}
+
+// hostHeaderDescription describes the Host header for the notifications.
+func hostHeaderDescription(value string) string {
+	switch value {
+	case PreserveHostHeader:
+		return "the one of the client"
+	case UpstreamHostHeader:
+		return "the host of the backend Service, <service>.<namespace>.svc"
+	}
+	return value
+}
+
+// ingressHostHeader returns the value of the Host header the ingress configures
+// with proxy_set_header in its snippets or with a variable in
+// nginx.org/proxy-set-headers, and the annotation configuring it. The location
+// snippets take precedence over the server snippets, as in NGINX.
+func ingressHostHeader(ingress networkingv1.Ingress) (string, string) {
+	for _, annotation := range []string{nginxLocationSnippetsAnnotation, nginxServerSnippetsAnnotation} {
+		matches := hostHeaderRegexp.FindAllStringSubmatch(ingress.Annotations[annotation], -1)
+		if len(matches) > 0 {
+			return strings.Trim(matches[len(matches)-1][1], `'"`), annotation
+		}
+	}
+	for _, header := range parseSetHeaders(ingress.Annotations[nginxProxySetHeadersAnnotation]) {
+		if strings.EqualFold(string(header.Name), "Host") {
+			return header.Value, nginxProxySetHeadersAnnotation
+		}
+	}
+	return "", ""
+}
+
+// hasStaticHostHeader tells whether nginx.org/proxy-set-headers of the ingress
+// sets the Host header to a static value.
+func hasStaticHostHeader(ingress networkingv1.Ingress) bool {
+	for _, header := range parseSetHeaders(ingress.Annotations[nginxProxySetHeadersAnnotation]) {
+		if strings.EqualFold(string(header.Name), "Host") && header.Value != "" && !strings.Contains(header.Value, "$") {
+			return true
+		}
+	}
+	return false
+}
+
+// setIngressHostHeader sets the Host header on the rules of the HTTPRoutes of
+// the ingress routing to its Services, to the value or, with
+// UpstreamHostHeader, to the host of the Service of every backendRef. It tells
+// whether a rule was changed.
+func setIngressHostHeader(ingresses []networkingv1.Ingress, ingress networkingv1.Ingress, value string, ir *intermediate.IR) bool {
+	services := sets.New(ingressServiceNames(ingress)...)
+
+	changed := false
+	keys := sets.New[types.NamespacedName]()
+	for _, rule := range ingress.Spec.Rules {
+		keys.Insert(common.HTTPRouteKey(ingresses, ingress, rule.Host))
+	}
+	for key := range keys {
+		httpRouteContext, ok := ir.HTTPRoutes[key]
+		if !ok {
+			continue
+		}
+		for i := range httpRouteContext.Spec.Rules {
+			rule := &httpRouteContext.Spec.Rules[i]
+			hosts := sets.New[string]()
+			for _, backendRef := range rule.BackendRefs {
+				if services.Has(string(backendRef.Name)) {
+					hosts.Insert(backendHost(value, key.Namespace, backendRef.BackendRef))
+				}
+			}
+			switch {
+			case hosts.Len() == 0:
+				continue
+			case hosts.Len() == 1:
+				rule.Filters = hostHeaderFilter(rule.Filters, hosts.UnsortedList()[0])
+			default:
+				for j := range rule.BackendRefs {
+					backendRef := &rule.BackendRefs[j]
+					if services.Has(string(backendRef.Name)) {
+						backendRef.Filters = hostHeaderFilter(backendRef.Filters, backendHost(value, key.Namespace, backendRef.BackendRef))
+					}
+				}
+			}
+			changed = true
+		}
+		ir.HTTPRoutes[key] = httpRouteContext
+	}
+	return changed
+}
+
+// backendHost returns the Host header of the requests to the backend.
+func backendHost(value, routeNamespace string, backendRef gatewayv1.BackendRef) string {
+	if value != UpstreamHostHeader {
+		return value
+	}
+	namespace := routeNamespace
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace)
+	}
+	return fmt.Sprintf("%s.%s.svc", backendRef.Name, namespace)
+}
+
+// hostHeaderFilter sets the Host header in the RequestHeaderModifier filter of
+// the filters, which is added when there is none.
+func hostHeaderFilter(filters []gatewayv1.HTTPRouteFilter, host string) []gatewayv1.HTTPRouteFilter {
+	return common.AddHTTPFilter(filters, common.RequestHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: "Host", Value: host}}, nil, nil))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestHostHeaderFeature(t *testing.T) {
+	hostFilter := func(host string) []gatewayv1.HTTPRouteFilter {
+		return []gatewayv1.HTTPRouteFilter{{
+			Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "Host", Value: host}}},
+		}}
+	}
+	backendRef := func(name string) gatewayv1.HTTPBackendRef {
+		return gatewayv1.HTTPBackendRef{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(name)}}}
+	}
+
+	tests := []struct {
+		name          string
+		hostHeader    string
+		annotations   map[string]string
+		rules         []gatewayv1.HTTPRouteRule
+		expectedRules []gatewayv1.HTTPRouteRule
+	}{
+		{
+			name:          "client Host header preserved by default",
+			hostHeader:    PreserveHostHeader,
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+		},
+		{
+			name:          "upstream Host header",
+			hostHeader:    UpstreamHostHeader,
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}, Filters: hostFilter("web.default.svc")}},
+		},
+		{
+			name:       "upstream Host header set on the backendRefs of rules routing to several Services",
+			hostHeader: UpstreamHostHeader,
+			rules:      []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web"), backendRef("api")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{
+				{BackendRef: backendRef("web").BackendRef, Filters: hostFilter("web.default.svc")},
+				{BackendRef: backendRef("api").BackendRef, Filters: hostFilter("api.default.svc")},
+			}}},
+		},
+		{
+			name:          "location snippet preserving the Host header takes precedence",
+			hostHeader:    UpstreamHostHeader,
+			annotations:   map[string]string{nginxLocationSnippetsAnnotation: "proxy_set_header Host $host;"},
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+		},
+		{
+			name:          "server snippet setting the upstream Host header",
+			hostHeader:    PreserveHostHeader,
+			annotations:   map[string]string{nginxServerSnippetsAnnotation: "proxy_set_header  Host  $proxy_host;"},
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}, Filters: hostFilter("web.default.svc")}},
+		},
+		{
+			name:        "static Host header merged into the RequestHeaderModifier filter",
+			hostHeader:  PreserveHostHeader,
+			annotations: map[string]string{nginxLocationSnippetsAnnotation: `proxy_set_header Host "internal.example.com";`},
+			rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")},
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "X-Team", Value: "a"}}},
+				}},
+			}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")},
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "Host", Value: "internal.example.com"}, {Name: "X-Team", Value: "a"}}},
+				}},
+			}},
+		},
+		{
+			name:          "variables are not converted",
+			hostHeader:    PreserveHostHeader,
+			annotations:   map[string]string{nginxProxySetHeadersAnnotation: "Host: $http_x_forwarded_host"},
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+		},
+		{
+			name:          "static proxy-set-headers Host header left to the header manipulation",
+			hostHeader:    UpstreamHostHeader,
+			annotations:   map[string]string{nginxProxySetHeadersAnnotation: "Host: internal.example.com"},
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("web")}}},
+		},
+		{
+			name:          "rules without the Services of the Ingress are unchanged",
+			hostHeader:    UpstreamHostHeader,
+			rules:         []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("other")}}},
+			expectedRules: []gatewayv1.HTTPRouteRule{{BackendRefs: []gatewayv1.HTTPBackendRef{backendRef("other")}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{
+					Host: "example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{
+						{Path: "/", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}}},
+						{Path: "/api", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "api"}}},
+					}}},
+				}}},
+			}
+			key := types.NamespacedName{Namespace: "default", Name: "app-example-com"}
+			ir := intermediate.IR{
+				HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+					key: {HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{Rules: tt.rules}}},
+				},
+			}
+
+			if errs := NewHostHeaderFeature(tt.hostHeader)([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			if diff := cmp.Diff(tt.expectedRules, ir.HTTPRoutes[key].Spec.Rules); diff != "" {
+				t.Errorf("Unexpected rules (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetHostHeaderCopiesSharedFilters(t *testing.T) {
+	shared := gatewayv1.HTTPRouteFilter{
+		Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "X-Team", Value: "a"}}},
+	}
+	filters := hostHeaderFilter([]gatewayv1.HTTPRouteFilter{shared}, "web.default.svc")
+	if len(shared.RequestHeaderModifier.Set) != 1 {
+		t.Errorf("Expected the shared filter to be unchanged, got %+v", shared.RequestHeaderModifier.Set)
+	}
+	if len(filters) != 1 || len(filters[0].RequestHeaderModifier.Set) != 2 {
+		t.Errorf("Expected the Host header to be set next to X-Team, got %+v", filters)
+	}
+}
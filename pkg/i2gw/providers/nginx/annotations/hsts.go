@@ -37,9 +37,13 @@ func HSTSFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]ma
 
 	ruleGroups := common.GetRuleGroups(ingresses)
 	for _, rg := range ruleGroups {
+		routeKey := types.NamespacedName{Namespace: rg.Namespace, Name: common.RouteName(rg.Name, rg.Host)}
+		// The HTTPRoute gets a single HSTS header, from the first Ingress of
+		// the rule group enabling it.
 		for _, rule := range rg.Rules {
 			if hsts, ok := rule.Ingress.Annotations[nginxHSTSAnnotation]; ok && hsts == "true" {
-				errs = append(errs, processHSTSAnnotation(rule.Ingress, ir)...)
+				errs = append(errs, processHSTSAnnotation(rule.Ingress, routeKey, ir)...)
+				break
 			}
 		}
 	}
@@ -48,7 +52,7 @@ func HSTSFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]ma
 }
 
 //nolint:unparam // ErrorList return type maintained for consistency
-func processHSTSAnnotation(ingress networkingv1.Ingress, ir *intermediate.IR) field.ErrorList {
+func processHSTSAnnotation(ingress networkingv1.Ingress, routeKey types.NamespacedName, ir *intermediate.IR) field.ErrorList {
 	var errs field.ErrorList
 
 	hstsHeader := "Strict-Transport-Security"
@@ -71,43 +75,19 @@ func processHSTSAnnotation(ingress networkingv1.Ingress, ir *intermediate.IR) fi
 
 	hstsHeaderValue := buildHSTS(hstsMaxAge, hstsIncludeSubdomain)
 
-	for _, rule := range ingress.Spec.Rules {
-		if rule.HTTP == nil {
-			continue
-		}
-
-		routeName := common.RouteName(ingress.Name, rule.Host)
-		routeKey := types.NamespacedName{Namespace: ingress.Namespace, Name: routeName}
-
-		httpRouteContext, exists := ir.HTTPRoutes[routeKey]
-		if !exists {
-			continue
-		}
-
-		for i := range httpRouteContext.HTTPRoute.Spec.Rules {
-			if httpRouteContext.HTTPRoute.Spec.Rules[i].Filters == nil {
-				httpRouteContext.HTTPRoute.Spec.Rules[i].Filters = []gatewayv1.HTTPRouteFilter{}
-			}
-
-			filter := gatewayv1.HTTPRouteFilter{
-				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
-				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
-					Set: []gatewayv1.HTTPHeader{
-						{
-							Name:  gatewayv1.HTTPHeaderName(hstsHeader),
-							Value: hstsHeaderValue,
-						},
-					},
-				},
-			}
-
-			httpRouteContext.HTTPRoute.Spec.Rules[i].Filters = append(httpRouteContext.HTTPRoute.Spec.Rules[i].Filters, filter)
-		}
+	httpRouteContext, exists := ir.HTTPRoutes[routeKey]
+	if !exists {
+		return errs
+	}
 
-		// Update the route context in the IR
-		ir.HTTPRoutes[routeKey] = httpRouteContext
+	filter := common.ResponseHeaderModifierFilter([]gatewayv1.HTTPHeader{{Name: gatewayv1.HTTPHeaderName(hstsHeader), Value: hstsHeaderValue}}, nil, nil)
+	for i := range httpRouteContext.HTTPRoute.Spec.Rules {
+		httpRouteContext.HTTPRoute.Spec.Rules[i].Filters = common.AddHTTPFilter(httpRouteContext.HTTPRoute.Spec.Rules[i].Filters, filter)
 	}
 
+	// Update the route context in the IR
+	ir.HTTPRoutes[routeKey] = httpRouteContext
+
 	return errs
 }
 
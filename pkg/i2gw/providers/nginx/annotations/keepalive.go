@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"strconv"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// KeepaliveFeature captures the nginx.org/keepalive annotation in the
+// provider-specific IR of every backend Service of the Ingress. A value of 0
+// disables the keepalive connections, as does the NGINX Ingress Controller
+// default.
+func KeepaliveFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+		value, ok := ingress.Annotations[nginxKeepaliveAnnotation]
+		if !ok {
+			continue
+		}
+		keepalive, err := strconv.Atoi(value)
+		if err != nil || keepalive < 0 {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q, must be a non-negative integer", nginxKeepaliveAnnotation, value), ingress)
+			continue
+		}
+		if keepalive == 0 {
+			continue
+		}
+
+		if ir.Services == nil {
+			ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+		}
+		for _, service := range ingressServiceNames(*ingress) {
+			key := types.NamespacedName{Namespace: ingress.Namespace, Name: service}
+			serviceIR := ir.Services[key]
+			if serviceIR.Nginx == nil {
+				serviceIR.Nginx = &intermediate.NginxServiceIR{}
+			}
+			serviceIR.Nginx.Keepalive = keepalive
+			ir.Services[key] = serviceIR
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestKeepaliveFeature(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected int
+	}{
+		{name: "keepalive connections", value: "32", expected: 32},
+		{name: "disabled", value: "0"},
+		{name: "invalid value is ignored", value: "-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "default",
+					Annotations: map[string]string{nginxKeepaliveAnnotation: tt.value},
+				},
+				Spec: networkingv1.IngressSpec{
+					DefaultBackend: &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}},
+				},
+			}
+			ir := intermediate.IR{}
+
+			if errs := KeepaliveFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			serviceIR := ir.Services[types.NamespacedName{Namespace: "default", Name: "web"}]
+			if tt.expected == 0 {
+				if serviceIR.Nginx != nil {
+					t.Errorf("Expected no keepalive to be captured, got %d", serviceIR.Nginx.Keepalive)
+				}
+				return
+			}
+			if serviceIR.Nginx == nil || serviceIR.Nginx.Keepalive != tt.expected {
+				t.Errorf("Expected keepalive %d, got %+v", tt.expected, serviceIR.Nginx)
+			}
+		})
+	}
+}
@@ -17,9 +17,7 @@ limitations under the License.
 package annotations
 
 import (
-	"fmt"
 	"strconv"
-	"strings"
 
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -177,10 +175,8 @@ func replaceGatewayPortsWithCustom(ingress networkingv1.Ingress, portConfigurati
 
 // createListener creates a Gateway listener for the given hostname, port, and protocol
 func createListener(hostname string, port int32, protocol gatewayv1.ProtocolType) gatewayv1.Listener {
-	listenerName := createListenerName(hostname, port, protocol)
-
 	listener := gatewayv1.Listener{
-		Name:     gatewayv1.SectionName(listenerName),
+		Name:     common.PortListenerName(hostname, protocol, gatewayv1.PortNumber(port)),
 		Port:     gatewayv1.PortNumber(port),
 		Protocol: protocol,
 	}
@@ -192,13 +188,6 @@ func createListener(hostname string, port int32, protocol gatewayv1.ProtocolType
 	return listener
 }
 
-// createListenerName generates a safe listener name from hostname, port, and protocol
-func createListenerName(hostname string, port int32, protocol gatewayv1.ProtocolType) string {
-	safeName := common.NameFromHost(hostname)
-	protocolStr := strings.ToLower(string(protocol))
-	return fmt.Sprintf("%s-%s-%d", safeName, protocolStr, port)
-}
-
 // getGatewayClassName extracts the gateway class name from ingress
 func getGatewayClassName(ingress networkingv1.Ingress) string {
 	if ingress.Spec.IngressClassName != nil && *ingress.Spec.IngressClassName != "" {
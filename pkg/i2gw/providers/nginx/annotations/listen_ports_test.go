@@ -27,6 +27,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
 func TestExtractListenPorts(t *testing.T) {
@@ -82,7 +83,7 @@ func TestExtractListenPorts(t *testing.T) {
 	}
 }
 
-func TestCreateListenerName(t *testing.T) {
+func TestListenPortsListenerName(t *testing.T) {
 	tests := []struct {
 		name     string
 		hostname string
@@ -122,7 +123,7 @@ func TestCreateListenerName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := createListenerName(tt.hostname, tt.port, tt.protocol)
+			result := string(common.PortListenerName(tt.hostname, tt.protocol, gatewayv1.PortNumber(tt.port)))
 			if result != tt.expected {
 				t.Errorf("Expected %s, got %s", tt.expected, result)
 			}
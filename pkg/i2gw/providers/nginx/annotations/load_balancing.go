@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// lbMethodRegexp matches the load balancing methods of NGINX Ingress Controller,
+// e.g. "round_robin", "random two least_conn" or "hash $request_id consistent".
+var lbMethodRegexp = regexp.MustCompile(`^(round_robin|least_conn|ip_hash|random( two( least_conn| least_time=(header|last_byte))?)?|least_time (header|last_byte)( inflight)?|hash \S+( consistent)?)$`)
+
+// LoadBalancingMethodFeature captures the nginx.org/lb-method annotation in the
+// provider-specific IR of every backend Service of the Ingress.
+func LoadBalancingMethodFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+		value, ok := ingress.Annotations[nginxLBMethodAnnotation]
+		if !ok {
+			continue
+		}
+		method := strings.Join(strings.Fields(value), " ")
+		if !lbMethodRegexp.MatchString(method) {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q", nginxLBMethodAnnotation, value), ingress)
+			continue
+		}
+
+		if ir.Services == nil {
+			ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+		}
+		for _, service := range ingressServiceNames(*ingress) {
+			key := types.NamespacedName{Namespace: ingress.Namespace, Name: service}
+			serviceIR := ir.Services[key]
+			if serviceIR.Nginx == nil {
+				serviceIR.Nginx = &intermediate.NginxServiceIR{}
+			}
+			serviceIR.Nginx.LoadBalancingMethod = method
+			ir.Services[key] = serviceIR
+		}
+	}
+
+	return nil
+}
@@ -27,3 +27,9 @@ func notify(mType notifications.MessageType, message string, callingObject ...cl
 	newNotification := notifications.NewNotification(mType, message, callingObject...)
 	notifications.NotificationAggr.DispatchNotification(newNotification, "nginx")
 }
+
+// reportSecurityFinding dispatches a security finding with the nginx provider name
+func reportSecurityFinding(feature, reference string, routes []string, callingObject ...client.Object) {
+	finding := notifications.SecurityFinding{Feature: feature, Reference: reference, Routes: routes, CallingObjects: callingObject}
+	notifications.NotificationAggr.DispatchSecurityFinding(finding, "nginx")
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package annotations
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -62,10 +64,6 @@ func PathRegexFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedNa
 				// "true", "case_sensitive", "case_insensitive" all use regex
 				pathMatchType = gatewayv1.PathMatchRegularExpression
 
-				// Add a general warning about NGF not supporting regex
-				message := "nginx.org/path-regex: PathMatchRegularExpression is not supported by NGINX Gateway Fabric - only Exact and PathPrefix are supported"
-				notify(notifications.WarningNotification, message, &rule.Ingress)
-
 				// Add a warning for case_insensitive since Gateway API doesn't guarantee it
 				if pathRegex == "case_insensitive" {
 					message := "nginx.org/path-regex: case_insensitive - injected (?i) regex flag but case insensitive behavior depends on Gateway implementation support"
@@ -107,3 +105,30 @@ func PathRegexFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedNa
 
 	return errs
 }
+
+// regexCharsRegexp matches characters with a special meaning in regular expressions.
+var regexCharsRegexp = regexp.MustCompile(`[\^$*+?()\[\]{}|\\~]`)
+
+// ImplementationSpecificPathFeature reports the ImplementationSpecific paths which
+// look like regular expressions but are converted to PathPrefix matches, because the
+// Ingress has no nginx.org/path-regex annotation.
+func ImplementationSpecificPathFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, _ *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+		if _, ok := ingress.Annotations[nginxPathRegexAnnotation]; ok {
+			continue
+		}
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.PathType == nil || *path.PathType != networkingv1.PathTypeImplementationSpecific || !regexCharsRegexp.MatchString(path.Path) {
+					continue
+				}
+				notify(notifications.WarningNotification, fmt.Sprintf("ImplementationSpecific path %q contains regular expression characters but was converted to a PathPrefix match, set %s to convert it to a regular expression", path.Path, nginxPathRegexAnnotation), ingress)
+			}
+		}
+	}
+	return nil
+}
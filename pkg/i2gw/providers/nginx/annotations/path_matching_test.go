@@ -26,6 +26,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
@@ -586,3 +587,60 @@ func TestPathRegexCaseInsensitiveFlagNotDuplicated(t *testing.T) {
 		t.Errorf("Expected path value '%s', got '%s'", expectedPath, *match.Path.Value)
 	}
 }
+
+func TestImplementationSpecificPathFeature(t *testing.T) {
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		path             string
+		pathType         networkingv1.PathType
+		expectedWarnings int
+	}{
+		{
+			name:     "plain path",
+			path:     "/api",
+			pathType: networkingv1.PathTypeImplementationSpecific,
+		},
+		{
+			name:             "regex-like path without path-regex",
+			path:             "/api/v[0-9]+",
+			pathType:         networkingv1.PathTypeImplementationSpecific,
+			expectedWarnings: 1,
+		},
+		{
+			name:        "regex-like path with path-regex",
+			annotations: map[string]string{nginxPathRegexAnnotation: "true"},
+			path:        "/api/v[0-9]+",
+			pathType:    networkingv1.PathTypeImplementationSpecific,
+		},
+		{
+			name:     "regex-like Prefix path",
+			path:     "/api/v[0-9]+",
+			pathType: networkingv1.PathTypePrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{Path: tt.path, PathType: ptr.To(tt.pathType)}},
+						}},
+					}},
+				},
+			}
+
+			if errs := ImplementationSpecificPathFeature([]networkingv1.Ingress{ingress}, nil, &intermediate.IR{}); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			if got := len(notifications.NotificationAggr.Notifications["nginx"]); got != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %d", tt.expectedWarnings, got)
+			}
+		})
+	}
+}
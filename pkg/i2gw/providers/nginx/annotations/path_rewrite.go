@@ -22,8 +22,6 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"k8s.io/utils/ptr"
-	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
@@ -57,20 +55,7 @@ func RewriteTargetFeature(ingresses []networkingv1.Ingress, _ map[types.Namespac
 				for _, path := range rule.IngressRule.HTTP.Paths {
 					serviceName := path.Backend.Service.Name
 					if rewritePath, hasRewrite := rewriteRules[serviceName]; hasRewrite {
-						filter := gatewayv1.HTTPRouteFilter{
-							Type: gatewayv1.HTTPRouteFilterURLRewrite,
-							URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
-								Path: &gatewayv1.HTTPPathModifier{
-									Type:               gatewayv1.PrefixMatchHTTPPathModifier,
-									ReplacePrefixMatch: ptr.To(rewritePath),
-								},
-							},
-						}
-
-						if httpRouteContext.HTTPRoute.Spec.Rules[i].Filters == nil {
-							httpRouteContext.HTTPRoute.Spec.Rules[i].Filters = []gatewayv1.HTTPRouteFilter{}
-						}
-						httpRouteContext.HTTPRoute.Spec.Rules[i].Filters = append(httpRouteContext.HTTPRoute.Spec.Rules[i].Filters, filter)
+						httpRouteContext.HTTPRoute.Spec.Rules[i].Filters = common.AddHTTPFilter(httpRouteContext.HTTPRoute.Spec.Rules[i].Filters, common.PrefixRewriteFilter(rewritePath))
 					}
 				}
 			}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+var (
+	// nginxSizeRegexp matches an NGINX size, e.g. "4k" or "1m"
+	nginxSizeRegexp = regexp.MustCompile(`^\d+[kKmM]?$`)
+	// nginxBuffersRegexp matches the number and size of buffers, e.g. "8 4k"
+	nginxBuffersRegexp = regexp.MustCompile(`^\d+\s+\d+[kKmM]?$`)
+)
+
+// ProxyBufferingFeature captures the nginx.org/proxy-buffering, proxy-buffers and
+// proxy-buffer-size annotations in the provider-specific IR of every backend
+// Service of the Ingress.
+func ProxyBufferingFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for _, ingress := range ingresses {
+		config := parseProxyBuffering(ingress)
+		if config == nil {
+			continue
+		}
+
+		if ir.Services == nil {
+			ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+		}
+		for _, service := range ingressServiceNames(ingress) {
+			key := types.NamespacedName{Namespace: ingress.Namespace, Name: service}
+			serviceIR := ir.Services[key]
+			if serviceIR.Nginx == nil {
+				serviceIR.Nginx = &intermediate.NginxServiceIR{}
+			}
+			serviceIR.Nginx.ProxyBuffering = config
+			ir.Services[key] = serviceIR
+		}
+	}
+
+	return nil
+}
+
+// parseProxyBuffering returns the proxy buffering configuration of the ingress, or
+// nil when none of the annotations are set. Invalid values are reported and ignored.
+func parseProxyBuffering(ingress networkingv1.Ingress) *intermediate.ProxyBufferingConfig {
+	config := intermediate.ProxyBufferingConfig{}
+
+	if value, ok := ingress.Annotations[nginxProxyBufferingAnnotation]; ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q, must be true or false", nginxProxyBufferingAnnotation, value), &ingress)
+		} else {
+			config.Enabled = &enabled
+		}
+	}
+	if value, ok := ingress.Annotations[nginxProxyBuffersAnnotation]; ok {
+		if nginxBuffersRegexp.MatchString(value) {
+			config.Buffers = value
+		} else {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q, must be a number and a size, e.g. \"8 4k\"", nginxProxyBuffersAnnotation, value), &ingress)
+		}
+	}
+	if value, ok := ingress.Annotations[nginxProxyBufferSizeAnnotation]; ok {
+		if nginxSizeRegexp.MatchString(value) {
+			config.BufferSize = value
+		} else {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q, must be a size, e.g. \"4k\"", nginxProxyBufferSizeAnnotation, value), &ingress)
+		}
+	}
+
+	if config.Enabled == nil && config.Buffers == "" && config.BufferSize == "" {
+		return nil
+	}
+	return &config
+}
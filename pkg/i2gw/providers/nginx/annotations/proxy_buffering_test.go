@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestProxyBufferingFeature(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *intermediate.ProxyBufferingConfig
+	}{
+		{
+			name:        "no buffering annotations",
+			annotations: map[string]string{},
+		},
+		{
+			name: "all annotations",
+			annotations: map[string]string{
+				nginxProxyBufferingAnnotation:  "false",
+				nginxProxyBuffersAnnotation:    "8 4k",
+				nginxProxyBufferSizeAnnotation: "8k",
+			},
+			expected: &intermediate.ProxyBufferingConfig{Enabled: ptr.To(false), Buffers: "8 4k", BufferSize: "8k"},
+		},
+		{
+			name: "invalid values are ignored",
+			annotations: map[string]string{
+				nginxProxyBufferingAnnotation:  "maybe",
+				nginxProxyBuffersAnnotation:    "4k",
+				nginxProxyBufferSizeAnnotation: "16k",
+			},
+			expected: &intermediate.ProxyBufferingConfig{BufferSize: "16k"},
+		},
+		{
+			name:        "only invalid values",
+			annotations: map[string]string{nginxProxyBuffersAnnotation: "lots"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}}},
+								{Path: "/api", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "api"}}},
+							},
+						}},
+					}},
+				},
+			}
+			ir := intermediate.IR{}
+
+			if errs := ProxyBufferingFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			if tt.expected == nil {
+				if len(ir.Services) != 0 {
+					t.Errorf("Expected no service IR, got %v", ir.Services)
+				}
+				return
+			}
+			for _, service := range []string{"web", "api"} {
+				serviceIR := ir.Services[types.NamespacedName{Namespace: "default", Name: service}]
+				if serviceIR.Nginx == nil || !reflect.DeepEqual(serviceIR.Nginx.ProxyBuffering, tt.expected) {
+					t.Errorf("Expected service %s proxy buffering %+v, got %+v", service, tt.expected, serviceIR.Nginx)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// defaultProxyNextUpstream is the proxy_next_upstream value of NGINX, used when
+// only the tries or timeout annotations are set.
+const defaultProxyNextUpstream = "error timeout"
+
+// nginxTimeRegexp matches the NGINX time values Gateway API durations can express.
+// A value without unit is in seconds.
+var nginxTimeRegexp = regexp.MustCompile(`^([0-9]{1,5})(ms|s|m|h)?$`)
+
+// retryConditions are the proxy_next_upstream conditions which are not status codes.
+var retryConditions = []string{"error", "timeout", "invalid_header", "non_idempotent"}
+
+// ProxyNextUpstreamFeature captures the nginx.org/proxy-next-upstream, -tries and
+// -timeout annotations as a retry policy in the provider-specific IR of every
+// backend Service of the Ingress.
+func ProxyNextUpstreamFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+		retry, ok := parseRetryPolicy(ingress)
+		if !ok {
+			continue
+		}
+
+		if ir.Services == nil {
+			ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+		}
+		for _, service := range ingressServiceNames(*ingress) {
+			key := types.NamespacedName{Namespace: ingress.Namespace, Name: service}
+			serviceIR := ir.Services[key]
+			if serviceIR.Nginx == nil {
+				serviceIR.Nginx = &intermediate.NginxServiceIR{}
+			}
+			serviceIR.Nginx.Retry = retry
+			ir.Services[key] = serviceIR
+		}
+	}
+
+	return nil
+}
+
+// parseRetryPolicy returns the retry policy configured by the annotations of the
+// ingress, and false when none is configured, retries are turned off, or an
+// annotation is invalid.
+func parseRetryPolicy(ingress *networkingv1.Ingress) (*intermediate.RetryPolicy, bool) {
+	value, conditionsSet := ingress.Annotations[nginxProxyNextUpstreamAnnotation]
+	tries, triesSet := ingress.Annotations[nginxProxyNextUpstreamTriesAnnotation]
+	timeout, timeoutSet := ingress.Annotations[nginxProxyNextUpstreamTimeoutAnnotation]
+	if !conditionsSet && !triesSet && !timeoutSet {
+		return nil, false
+	}
+	if !conditionsSet {
+		value = defaultProxyNextUpstream
+	}
+
+	retry := &intermediate.RetryPolicy{}
+	for _, condition := range strings.Fields(value) {
+		switch {
+		case condition == "off":
+			return nil, false
+		case slices.Contains(retryConditions, condition):
+			retry.Conditions = append(retry.Conditions, condition)
+		case strings.HasPrefix(condition, "http_"):
+			code, err := strconv.Atoi(strings.TrimPrefix(condition, "http_"))
+			if err != nil || code < 400 || code > 599 {
+				notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid condition %q", nginxProxyNextUpstreamAnnotation, condition), ingress)
+				return nil, false
+			}
+			retry.Codes = append(retry.Codes, code)
+		default:
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid condition %q", nginxProxyNextUpstreamAnnotation, condition), ingress)
+			return nil, false
+		}
+	}
+	if len(retry.Codes) == 0 && len(retry.Conditions) == 0 {
+		return nil, false
+	}
+
+	if triesSet {
+		n, err := strconv.Atoi(strings.TrimSpace(tries))
+		if err != nil || n < 0 {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q", nginxProxyNextUpstreamTriesAnnotation, tries), ingress)
+			return nil, false
+		}
+		// NGINX counts the first attempt in the tries, 0 meaning unlimited.
+		if n > 0 {
+			retry.Attempts = ptr.To(n - 1)
+		}
+	}
+
+	if timeoutSet {
+		match := nginxTimeRegexp.FindStringSubmatch(strings.TrimSpace(timeout))
+		if match == nil {
+			notify(notifications.ErrorNotification, fmt.Sprintf("%s: invalid value %q", nginxProxyNextUpstreamTimeoutAnnotation, timeout), ingress)
+			return nil, false
+		}
+		// The regexp only matches digits, and 0 means unlimited.
+		if n, _ := strconv.Atoi(match[1]); n > 0 {
+			unit := match[2]
+			if unit == "" {
+				unit = "s"
+			}
+			retry.PerTryTimeout = fmt.Sprintf("%d%s", n, unit)
+		}
+	}
+
+	return retry, true
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestProxyNextUpstreamFeature(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *intermediate.RetryPolicy
+	}{
+		{
+			name: "conditions, tries and timeout",
+			annotations: map[string]string{
+				nginxProxyNextUpstreamAnnotation:        "error timeout http_502 http_503",
+				nginxProxyNextUpstreamTriesAnnotation:   "3",
+				nginxProxyNextUpstreamTimeoutAnnotation: "10s",
+			},
+			expected: &intermediate.RetryPolicy{
+				Codes:         []int{502, 503},
+				Conditions:    []string{"error", "timeout"},
+				Attempts:      ptr.To(2),
+				PerTryTimeout: "10s",
+			},
+		},
+		{
+			name:        "default conditions with tries",
+			annotations: map[string]string{nginxProxyNextUpstreamTriesAnnotation: "2"},
+			expected:    &intermediate.RetryPolicy{Conditions: []string{"error", "timeout"}, Attempts: ptr.To(1)},
+		},
+		{
+			name: "unlimited tries and timeout, timeout in seconds",
+			annotations: map[string]string{
+				nginxProxyNextUpstreamAnnotation:        "http_504",
+				nginxProxyNextUpstreamTriesAnnotation:   "0",
+				nginxProxyNextUpstreamTimeoutAnnotation: "0",
+			},
+			expected: &intermediate.RetryPolicy{Codes: []int{504}},
+		},
+		{
+			name:        "timeout without unit",
+			annotations: map[string]string{nginxProxyNextUpstreamTimeoutAnnotation: "30"},
+			expected:    &intermediate.RetryPolicy{Conditions: []string{"error", "timeout"}, PerTryTimeout: "30s"},
+		},
+		{
+			name:        "retries turned off",
+			annotations: map[string]string{nginxProxyNextUpstreamAnnotation: "off"},
+		},
+		{
+			name:        "invalid condition",
+			annotations: map[string]string{nginxProxyNextUpstreamAnnotation: "error http_200"},
+		},
+		{
+			name:        "invalid tries",
+			annotations: map[string]string{nginxProxyNextUpstreamTriesAnnotation: "many"},
+		},
+		{
+			name:        "invalid timeout",
+			annotations: map[string]string{nginxProxyNextUpstreamTimeoutAnnotation: "1d"},
+		},
+		{
+			name: "no annotations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+				Spec: networkingv1.IngressSpec{
+					DefaultBackend: &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}},
+				},
+			}
+			ir := intermediate.IR{}
+
+			if errs := ProxyNextUpstreamFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var retry *intermediate.RetryPolicy
+			if serviceIR := ir.Services[types.NamespacedName{Namespace: "default", Name: "web"}]; serviceIR.Nginx != nil {
+				retry = serviceIR.Nginx.Retry
+			}
+			if diff := cmp.Diff(tt.expected, retry); diff != "" {
+				t.Errorf("Unexpected retry policy (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+const (
+	appProtectWAFFeature = "App Protect WAF"
+	appProtectDoSFeature = "App Protect DoS"
+)
+
+// SecurityFeature reports the NGINX App Protect WAF and DoS annotations. Gateway API
+// has no equivalent for them, so they are listed together with the routes which no
+// longer get the protection, for the security policies to be migrated manually.
+func SecurityFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+
+		wafEnabled := strings.EqualFold(ingress.Annotations[appProtectEnableAnnotation], "true")
+		policy := ingress.Annotations[appProtectPolicyAnnotation]
+		dosResource := ingress.Annotations[appProtectDosResourceAnnotation]
+		if !wafEnabled && policy == "" && dosResource == "" {
+			continue
+		}
+
+		routes := routesFromIngress(ingresses, *ingress, ir)
+		if wafEnabled || policy != "" {
+			reference := policy
+			if reference == "" {
+				reference = "default policy"
+			}
+			if logConf := ingress.Annotations[appProtectSecurityLogAnnotation]; logConf != "" {
+				reference = fmt.Sprintf("%s (security log %s)", reference, logConf)
+			}
+			reportSecurityFinding(appProtectWAFFeature, reference, routes, ingress)
+		}
+		if dosResource != "" {
+			reportSecurityFinding(appProtectDoSFeature, dosResource, routes, ingress)
+		}
+	}
+
+	return nil
+}
+
+// routesFromIngress returns the sorted routes generated from the ingress.
+func routesFromIngress(ingresses []networkingv1.Ingress, ingress networkingv1.Ingress, ir *intermediate.IR) []string {
+	var routes []string
+	for key, httpRouteContext := range ir.HTTPRoutes {
+		for _, source := range httpRouteContext.Sources {
+			if source.Kind == "Ingress" && source.Namespace == ingress.Namespace && source.Name == ingress.Name {
+				routes = append(routes, "HTTPRoute: "+key.String())
+				break
+			}
+		}
+	}
+	for _, rule := range ingress.Spec.Rules {
+		key := common.HTTPRouteKey(ingresses, ingress, rule.Host)
+		route := "GRPCRoute: " + key.String()
+		if _, ok := ir.GRPCRoutes[key]; ok && !slices.Contains(routes, route) {
+			routes = append(routes, route)
+		}
+	}
+	slices.Sort(routes)
+	return routes
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestSecurityFeature(t *testing.T) {
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		expectedFindings []notifications.SecurityFinding
+	}{
+		{
+			name:        "no security annotations",
+			annotations: map[string]string{nginxRedirectToHTTPSAnnotation: "true"},
+		},
+		{
+			name: "waf with policy and security log",
+			annotations: map[string]string{
+				appProtectEnableAnnotation:      "True",
+				appProtectPolicyAnnotation:      "default/dataguard",
+				appProtectSecurityLogAnnotation: "default/logconf",
+			},
+			expectedFindings: []notifications.SecurityFinding{{
+				Feature:   appProtectWAFFeature,
+				Reference: "default/dataguard (security log default/logconf)",
+				Routes:    []string{"HTTPRoute: default/app-example-com"},
+			}},
+		},
+		{
+			name: "waf enabled with default policy and dos",
+			annotations: map[string]string{
+				appProtectEnableAnnotation:      "true",
+				appProtectDosResourceAnnotation: "default/dos-protected",
+			},
+			expectedFindings: []notifications.SecurityFinding{{
+				Feature:   appProtectWAFFeature,
+				Reference: "default policy",
+				Routes:    []string{"HTTPRoute: default/app-example-com"},
+			}, {
+				Feature:   appProtectDoSFeature,
+				Reference: "default/dos-protected",
+				Routes:    []string{"HTTPRoute: default/app-example-com"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.SecurityFindings = map[string][]notifications.SecurityFinding{}
+
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{{Host: "example.com"}},
+				},
+			}
+			routeKey := types.NamespacedName{Namespace: "default", Name: "app-example-com"}
+			ir := intermediate.IR{
+				HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+					routeKey: {Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "app"}}},
+					{Namespace: "default", Name: "other-example-com"}: {
+						Sources: []intermediate.SourceReference{{Kind: "Ingress", Namespace: "default", Name: "other"}},
+					},
+				},
+			}
+
+			if errs := SecurityFeature([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			findings := notifications.NotificationAggr.SecurityFindings["nginx"]
+			if len(findings) != len(tt.expectedFindings) {
+				t.Fatalf("Expected %d findings, got %d", len(tt.expectedFindings), len(findings))
+			}
+			for i, expected := range tt.expectedFindings {
+				got := findings[i]
+				if got.Feature != expected.Feature || got.Reference != expected.Reference || !reflect.DeepEqual(got.Routes, expected.Routes) {
+					t.Errorf("Expected finding %+v, got %+v", expected, got)
+				}
+				if len(got.CallingObjects) != 1 || got.CallingObjects[0].GetName() != "app" {
+					t.Errorf("Expected finding to reference Ingress app, got %v", got.CallingObjects)
+				}
+			}
+		})
+	}
+}
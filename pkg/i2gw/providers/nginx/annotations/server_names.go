@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// serverNameRegexp matches a server_name directive, e.g. "server_name www.example.com;".
+var serverNameRegexp = regexp.MustCompile(`(?:^|[;{}\s])server_name\s+([^;]*);`)
+
+// parseServerNames extracts the names of the server_name directives of the
+// snippet. It also returns the snippet without those directives.
+func parseServerNames(snippet string) ([]string, string) {
+	var names []string
+	for _, match := range serverNameRegexp.FindAllStringSubmatch(snippet, -1) {
+		names = append(names, strings.Fields(match[1])...)
+	}
+	return names, serverNameRegexp.ReplaceAllString(snippet, " ")
+}
+
+// serverNameHostnames returns the hostnames a server name stands for, e.g.
+// example.com and *.example.com for .example.com. Regular expressions, suffix
+// wildcards and invalid names have no Gateway API hostname, the reason is
+// returned instead.
+func serverNameHostnames(name string) ([]string, string) {
+	switch {
+	case strings.HasPrefix(name, "~"):
+		return nil, "regular expression server names are not supported by Gateway API hostnames"
+	case name == "_" || name == `""`:
+		return nil, "catch-all server names are served by the listeners without hostname"
+	case strings.HasPrefix(name, "."):
+		name = strings.TrimPrefix(name, ".")
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return nil, strings.Join(errs, ", ")
+		}
+		return []string{name, "*." + name}, ""
+	case strings.HasPrefix(name, "*."):
+		if errs := validation.IsWildcardDNS1123Subdomain(name); len(errs) > 0 {
+			return nil, strings.Join(errs, ", ")
+		}
+		return []string{name}, ""
+	case strings.Contains(name, "*"):
+		return nil, "Gateway API hostnames only accept a wildcard as the first label"
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return nil, strings.Join(errs, ", ")
+	}
+	return []string{name}, ""
+}
+
+// addServerNameAliases adds the server names of the server snippet of the ingress
+// as aliases of its hosts: the aliases are added to the hostnames of the HTTPRoutes
+// of the hosts, and every listener of a host is copied for each alias, so that
+// the Gateway accepts the requests NGINX served with the additional server names.
+// Rules without host already match every hostname and are left unchanged.
+func addServerNameAliases(ingresses []networkingv1.Ingress, ingress *networkingv1.Ingress, names []string, ir *intermediate.IR) {
+	var aliases []string
+	for _, name := range names {
+		hostnames, reason := serverNameHostnames(name)
+		if reason != "" {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s: server_name %s was not converted, %s", nginxServerSnippetsAnnotation, name, reason), ingress)
+			continue
+		}
+		for _, hostname := range hostnames {
+			if !slices.Contains(aliases, hostname) {
+				aliases = append(aliases, hostname)
+			}
+		}
+	}
+
+	converted := map[types.NamespacedName]bool{}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" {
+			continue
+		}
+		key := common.HTTPRouteKey(ingresses, *ingress, rule.Host)
+		httpRouteContext, ok := ir.HTTPRoutes[key]
+		if !ok || converted[key] {
+			continue
+		}
+		converted[key] = true
+
+		var added []string
+		for _, alias := range aliases {
+			if alias == rule.Host || slices.Contains(httpRouteContext.Spec.Hostnames, gatewayv1.Hostname(alias)) {
+				continue
+			}
+			httpRouteContext.Spec.Hostnames = append(httpRouteContext.Spec.Hostnames, gatewayv1.Hostname(alias))
+			added = append(added, alias)
+		}
+		if len(added) == 0 {
+			continue
+		}
+
+		var parentRefs []gatewayv1.ParentReference
+		for _, parentRef := range httpRouteContext.Spec.ParentRefs {
+			gatewayKey := types.NamespacedName{Namespace: key.Namespace, Name: string(parentRef.Name)}
+			if parentRef.Namespace != nil {
+				gatewayKey.Namespace = string(*parentRef.Namespace)
+			}
+			gatewayContext, ok := ir.Gateways[gatewayKey]
+			if !ok {
+				continue
+			}
+			addAliasListeners(&gatewayContext.Gateway, rule.Host, added)
+
+			// Routes attached to a listener of the host are attached to its
+			// copies too.
+			if parentRef.SectionName != nil {
+				for _, listener := range gatewayContext.Spec.Listeners {
+					if listener.Name != *parentRef.SectionName || listener.Hostname == nil || string(*listener.Hostname) != rule.Host {
+						continue
+					}
+					for _, alias := range added {
+						aliasParentRef := *parentRef.DeepCopy()
+						aliasParentRef.SectionName = ptr.To(common.FindListenerName(gatewayContext.Gateway, alias, listener.Protocol))
+						parentRefs = append(parentRefs, aliasParentRef)
+					}
+				}
+			}
+			ir.Gateways[gatewayKey] = gatewayContext
+		}
+		httpRouteContext.Spec.ParentRefs = append(httpRouteContext.Spec.ParentRefs, parentRefs...)
+		ir.HTTPRoutes[key] = httpRouteContext
+
+		notify(notifications.InfoNotification, fmt.Sprintf("%s: server names %s added as aliases of host %s to the HTTPRoute and the Gateway listeners",
+			nginxServerSnippetsAnnotation, strings.Join(added, ", "), rule.Host), ingress)
+	}
+}
+
+// addAliasListeners copies every listener of the host for each alias, unless the
+// Gateway already has a listener of the alias with the same port and protocol.
+func addAliasListeners(gateway *gatewayv1.Gateway, host string, aliases []string) {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Hostname == nil || string(*listener.Hostname) != host {
+			continue
+		}
+		for _, alias := range aliases {
+			exists := slices.ContainsFunc(gateway.Spec.Listeners, func(l gatewayv1.Listener) bool {
+				return l.Hostname != nil && string(*l.Hostname) == alias && l.Port == listener.Port && l.Protocol == listener.Protocol
+			})
+			if exists {
+				continue
+			}
+			aliasListener := *listener.DeepCopy()
+			aliasListener.Hostname = ptr.To(gatewayv1.Hostname(alias))
+			aliasListener.Name = common.AliasListenerName(listener.Name, host, alias)
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, aliasListener)
+		}
+	}
+	common.DisambiguateListenerNames(gateway)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestParseServerNames(t *testing.T) {
+	names, remainder := parseServerNames("server_name www.example.com .example.org;\nadd_header X-Frame-Options DENY;\nserver_name ~^api\\d+\\.example\\.com$;")
+
+	expected := []string{"www.example.com", ".example.org", `~^api\d+\.example\.com$`}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected names %v, got %v", expected, names)
+	}
+	if strings.Contains(remainder, "server_name") || !strings.Contains(remainder, "add_header") {
+		t.Errorf("Expected the remainder to only contain the other directives, got %q", remainder)
+	}
+}
+
+func TestServerNameHostnames(t *testing.T) {
+	tests := []struct {
+		name              string
+		expectedHostnames []string
+		expectedReason    string
+	}{
+		{name: "www.example.com", expectedHostnames: []string{"www.example.com"}},
+		{name: "*.example.com", expectedHostnames: []string{"*.example.com"}},
+		{name: ".example.com", expectedHostnames: []string{"example.com", "*.example.com"}},
+		{name: "~^www\\d+\\.example\\.com$", expectedReason: "regular expression"},
+		{name: "www.example.*", expectedReason: "first label"},
+		{name: "_", expectedReason: "catch-all"},
+		{name: "Example_com", expectedReason: "RFC 1123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostnames, reason := serverNameHostnames(tt.name)
+			if !reflect.DeepEqual(hostnames, tt.expectedHostnames) {
+				t.Errorf("Expected hostnames %v, got %v", tt.expectedHostnames, hostnames)
+			}
+			if !strings.Contains(reason, tt.expectedReason) || (tt.expectedReason == "") != (reason == "") {
+				t.Errorf("Expected reason containing %q, got %q", tt.expectedReason, reason)
+			}
+		})
+	}
+}
+
+func TestServerSnippetsFeatureServerNames(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	ingress := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cafe",
+			Namespace: "default",
+			Annotations: map[string]string{
+				nginxServerSnippetsAnnotation: "server_name cafe.example.org ~^cafe\\d+\\.example\\.com$;",
+			},
+		},
+		Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "cafe.example.com"}}},
+	}
+	routeKey := types.NamespacedName{Namespace: "default", Name: "cafe-cafe-example-com"}
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			gatewayKey: {Gateway: gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "cafe-example-com-http", Hostname: ptr.To(gatewayv1.Hostname("cafe.example.com")), Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				{Name: "cafe-example-com-https", Hostname: ptr.To(gatewayv1.Hostname("cafe.example.com")), Port: 443, Protocol: gatewayv1.HTTPSProtocolType,
+					TLS: &gatewayv1.GatewayTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{{Name: "cafe-secret"}}}},
+			}}}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			routeKey: {HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("cafe-example-com-http"))}}},
+				Hostnames:       []gatewayv1.Hostname{"cafe.example.com"},
+			}}},
+		},
+	}
+
+	if errs := NewServerSnippetsFeature(false)([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	httpRoute := ir.HTTPRoutes[routeKey].HTTPRoute
+	if expected := []gatewayv1.Hostname{"cafe.example.com", "cafe.example.org"}; !reflect.DeepEqual(httpRoute.Spec.Hostnames, expected) {
+		t.Errorf("Expected hostnames %v, got %v", expected, httpRoute.Spec.Hostnames)
+	}
+	expectedParentRefs := []gatewayv1.ParentReference{
+		{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("cafe-example-com-http"))},
+		{Name: "nginx", SectionName: ptr.To(gatewayv1.SectionName("cafe-example-org-http"))},
+	}
+	if !reflect.DeepEqual(httpRoute.Spec.ParentRefs, expectedParentRefs) {
+		t.Errorf("Expected parentRefs %v, got %v", expectedParentRefs, httpRoute.Spec.ParentRefs)
+	}
+
+	listeners := ir.Gateways[gatewayKey].Spec.Listeners
+	expectedListeners := []gatewayv1.Listener{
+		listeners[0],
+		listeners[1],
+		{Name: "cafe-example-org-http", Hostname: ptr.To(gatewayv1.Hostname("cafe.example.org")), Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+		{Name: "cafe-example-org-https", Hostname: ptr.To(gatewayv1.Hostname("cafe.example.org")), Port: 443, Protocol: gatewayv1.HTTPSProtocolType,
+			TLS: &gatewayv1.GatewayTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{{Name: "cafe-secret"}}}},
+	}
+	if !reflect.DeepEqual(listeners, expectedListeners) {
+		t.Errorf("Expected listeners %+v, got %+v", expectedListeners, listeners)
+	}
+
+	var regexWarning, remainderWarning bool
+	for _, n := range notifications.NotificationAggr.Notifications["nginx"] {
+		if n.Type == notifications.WarningNotification && strings.Contains(n.Message, "regular expression") {
+			regexWarning = true
+		}
+		if strings.Contains(n.Message, "were not converted: server_name") {
+			remainderWarning = true
+		}
+	}
+	if !regexWarning {
+		t.Error("Expected a warning for the regular expression server name")
+	}
+	if remainderWarning {
+		t.Error("Expected the server_name directives not to be reported as unconverted directives")
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// returnLocationRegexp matches a location block which only returns a response,
+// e.g. "location = /healthz { return 200 'ok'; }". Regex locations are not matched.
+var returnLocationRegexp = regexp.MustCompile(`location\s+(=\s*)?(/\S*)\s*\{\s*return\s+(\d{3})(?:\s+([^;]*))?;\s*\}`)
+
+// returnLocation is a location block of a server snippet which returns a response
+// without proxying the request.
+type returnLocation struct {
+	exact bool
+	path  string
+	code  int
+	text  string
+}
+
+// NewServerSnippetsFeature returns a FeatureParser analyzing the nginx.org/server-snippets
+// annotation. The names of server_name directives are added as aliases of the hosts of
+// the Ingress. Location blocks which only return a response are reported with their path
+// and status code. When convertRedirects is set, those returning a 301 or 302 redirect
+// are converted to HTTPRoute rules with a RequestRedirect filter.
+func NewServerSnippetsFeature(convertRedirects bool) i2gw.FeatureParser {
+	return func(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+		for i := range ingresses {
+			ingress := &ingresses[i]
+
+			if _, ok := ingress.Annotations[nginxLocationSnippetsAnnotation]; ok {
+				notify(notifications.WarningNotification, fmt.Sprintf("%s: location snippets are not supported and were not converted", nginxLocationSnippetsAnnotation), ingress)
+			}
+
+			snippet, ok := ingress.Annotations[nginxServerSnippetsAnnotation]
+			if !ok || strings.TrimSpace(snippet) == "" {
+				continue
+			}
+
+			names, snippet := parseServerNames(snippet)
+			if len(names) > 0 {
+				addServerNameAliases(ingresses, ingress, names, ir)
+			}
+
+			locations, remainder := parseReturnLocations(snippet)
+			for _, location := range locations {
+				if convertRedirects && isRedirectCode(location.code) {
+					if rule, err := location.toRedirectRule(); err != nil {
+						notify(notifications.WarningNotification, fmt.Sprintf("%s: location %s returns %d %s which cannot be converted: %v", nginxServerSnippetsAnnotation, location.path, location.code, location.text, err), ingress)
+					} else {
+						addRuleToIngressRoutes(ingresses, *ingress, rule, ir)
+						notify(notifications.InfoNotification, fmt.Sprintf("%s: location %s converted to a RequestRedirect rule", nginxServerSnippetsAnnotation, location.path), ingress)
+					}
+					continue
+				}
+				notify(notifications.WarningNotification, location.message(), ingress)
+			}
+			if strings.TrimSpace(remainder) != "" {
+				notify(notifications.WarningNotification, fmt.Sprintf("%s: server snippets are not supported, the following directives were not converted: %s", nginxServerSnippetsAnnotation, strings.Join(strings.Fields(remainder), " ")), ingress)
+			}
+		}
+		return nil
+	}
+}
+
+// parseReturnLocations extracts the location blocks which only return a response
+// from the snippet. It also returns the snippet without those blocks.
+func parseReturnLocations(snippet string) ([]returnLocation, string) {
+	var locations []returnLocation
+	for _, match := range returnLocationRegexp.FindAllStringSubmatch(snippet, -1) {
+		code, _ := strconv.Atoi(match[3])
+		locations = append(locations, returnLocation{
+			exact: match[1] != "",
+			path:  match[2],
+			code:  code,
+			text:  strings.Trim(strings.TrimSpace(match[4]), `'"`),
+		})
+	}
+	return locations, returnLocationRegexp.ReplaceAllString(snippet, "")
+}
+
+func isRedirectCode(code int) bool {
+	return code == 301 || code == 302
+}
+
+// message describes the location for a manual migration.
+func (l returnLocation) message() string {
+	modifier := ""
+	if l.exact {
+		modifier = "= "
+	}
+	if l.code >= 300 && l.code < 400 {
+		return fmt.Sprintf("%s: location %s%s redirects with %d to %s, enable --nginx-convert-snippet-redirects to convert 301 and 302 redirects to RequestRedirect rules",
+			nginxServerSnippetsAnnotation, modifier, l.path, l.code, l.text)
+	}
+	return fmt.Sprintf("%s: location %s%s returns %d directly, Gateway API has no direct response, serve this path from a backend or use the health checks of the Gateway implementation",
+		nginxServerSnippetsAnnotation, modifier, l.path, l.code)
+}
+
+// toRedirectRule converts the location to an HTTPRoute rule redirecting to the
+// returned URL.
+func (l returnLocation) toRedirectRule() (gatewayv1.HTTPRouteRule, error) {
+	if strings.Contains(l.text, "$") {
+		return gatewayv1.HTTPRouteRule{}, fmt.Errorf("NGINX variables are not supported")
+	}
+	target, err := url.Parse(l.text)
+	if err != nil {
+		return gatewayv1.HTTPRouteRule{}, err
+	}
+	if target.RawQuery != "" || target.Fragment != "" {
+		return gatewayv1.HTTPRouteRule{}, fmt.Errorf("query strings and fragments are not supported")
+	}
+
+	redirect := &gatewayv1.HTTPRequestRedirectFilter{StatusCode: ptr.To(l.code)}
+	if target.Scheme != "" {
+		redirect.Scheme = ptr.To(target.Scheme)
+	}
+	if target.Hostname() != "" {
+		redirect.Hostname = ptr.To(gatewayv1.PreciseHostname(target.Hostname()))
+	}
+	if target.Port() != "" {
+		port, err := strconv.ParseInt(target.Port(), 10, 32)
+		if err != nil {
+			return gatewayv1.HTTPRouteRule{}, err
+		}
+		redirect.Port = ptr.To(gatewayv1.PortNumber(port))
+	}
+	if target.Path != "" {
+		redirect.Path = &gatewayv1.HTTPPathModifier{
+			Type:            gatewayv1.FullPathHTTPPathModifier,
+			ReplaceFullPath: ptr.To(target.Path),
+		}
+	}
+
+	pathType := gatewayv1.PathMatchPathPrefix
+	if l.exact {
+		pathType = gatewayv1.PathMatchExact
+	}
+	return gatewayv1.HTTPRouteRule{
+		Matches: []gatewayv1.HTTPRouteMatch{{
+			Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(pathType), Value: ptr.To(l.path)},
+		}},
+		Filters: []gatewayv1.HTTPRouteFilter{{
+			Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+			RequestRedirect: redirect,
+		}},
+	}, nil
+}
+
+// addRuleToIngressRoutes adds the rule to the HTTPRoutes generated for every host of
+// the ingress.
+func addRuleToIngressRoutes(ingresses []networkingv1.Ingress, ingress networkingv1.Ingress, rule gatewayv1.HTTPRouteRule, ir *intermediate.IR) {
+	for _, ingressRule := range ingress.Spec.Rules {
+		key := common.HTTPRouteKey(ingresses, ingress, ingressRule.Host)
+		httpRouteContext, ok := ir.HTTPRoutes[key]
+		if !ok {
+			continue
+		}
+		httpRouteContext.HTTPRoute.Spec.Rules = append(httpRouteContext.HTTPRoute.Spec.Rules, *rule.DeepCopy())
+		ir.HTTPRoutes[key] = httpRouteContext
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestParseReturnLocations(t *testing.T) {
+	snippet := `
+location = /healthz { return 200 'ok'; }
+location /old {
+    return 301 https://example.com/new;
+}
+location ~ ^/regex { return 404; }
+add_header X-Frame-Options DENY;
+`
+	locations, remainder := parseReturnLocations(snippet)
+
+	expected := []returnLocation{
+		{exact: true, path: "/healthz", code: 200, text: "ok"},
+		{path: "/old", code: 301, text: "https://example.com/new"},
+	}
+	if !reflect.DeepEqual(locations, expected) {
+		t.Errorf("Expected locations %+v, got %+v", expected, locations)
+	}
+	if !strings.Contains(remainder, "location ~ ^/regex") || !strings.Contains(remainder, "add_header") || strings.Contains(remainder, "/healthz") {
+		t.Errorf("Expected remainder to only contain the unconverted directives, got %q", remainder)
+	}
+}
+
+func TestServerSnippetsFeature(t *testing.T) {
+	tests := []struct {
+		name             string
+		convertRedirects bool
+		snippet          string
+		expectedRule     *gatewayv1.HTTPRouteRule
+	}{
+		{
+			name:    "redirects are only reported by default",
+			snippet: "location /old { return 301 https://example.com:8443/new; }",
+		},
+		{
+			name:             "redirect converted to a rule",
+			convertRedirects: true,
+			snippet:          "location /old { return 301 https://example.com:8443/new; }",
+			expectedRule: &gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/old")},
+				}},
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+						Scheme:     ptr.To("https"),
+						Hostname:   ptr.To(gatewayv1.PreciseHostname("example.com")),
+						Port:       ptr.To(gatewayv1.PortNumber(8443)),
+						Path:       &gatewayv1.HTTPPathModifier{Type: gatewayv1.FullPathHTTPPathModifier, ReplaceFullPath: ptr.To("/new")},
+						StatusCode: ptr.To(301),
+					},
+				}},
+			},
+		},
+		{
+			name:             "redirect with variables is not converted",
+			convertRedirects: true,
+			snippet:          "location = /old { return 302 https://$host/new; }",
+		},
+		{
+			name:             "direct responses are not converted",
+			convertRedirects: true,
+			snippet:          "location = /healthz { return 200; }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "app",
+					Namespace:   "default",
+					Annotations: map[string]string{nginxServerSnippetsAnnotation: tt.snippet},
+				},
+				Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "example.com"}}},
+			}
+			key := types.NamespacedName{Namespace: "default", Name: "app-example-com"}
+			ir := intermediate.IR{
+				HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+					key: {HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{}}}}},
+				},
+			}
+
+			if errs := NewServerSnippetsFeature(tt.convertRedirects)([]networkingv1.Ingress{ingress}, nil, &ir); len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			rules := ir.HTTPRoutes[key].HTTPRoute.Spec.Rules
+			if tt.expectedRule == nil {
+				if len(rules) != 1 {
+					t.Errorf("Expected no rule to be added, got %d rules", len(rules))
+				}
+				return
+			}
+			if len(rules) != 2 {
+				t.Fatalf("Expected a rule to be added, got %d rules", len(rules))
+			}
+			if !reflect.DeepEqual(rules[1], *tt.expectedRule) {
+				t.Errorf("Expected rule %+v, got %+v", *tt.expectedRule, rules[1])
+			}
+		})
+	}
+}
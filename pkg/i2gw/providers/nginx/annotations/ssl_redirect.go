@@ -18,7 +18,6 @@ package annotations
 
 import (
 	"fmt"
-	"strings"
 
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -46,38 +45,39 @@ func SSLRedirectFeature(ingresses []networkingv1.Ingress, _ map[types.Namespaced
 				continue
 			}
 
-			for _, ingressRule := range rule.Ingress.Spec.Rules {
-				ensureHTTPSListener(rule.Ingress, ingressRule, ir)
-
-				routeName := common.RouteName(rule.Ingress.Name, ingressRule.Host)
-				routeKey := types.NamespacedName{Namespace: rule.Ingress.Namespace, Name: routeName}
-				httpRouteContext, routeExists := ir.HTTPRoutes[routeKey]
-				if !routeExists {
-					continue
-				}
-
-				// Update parentRefs to specify the HTTP listener for SSL redirect
-				httpListenerName := fmt.Sprintf("%s-http", strings.ReplaceAll(ingressRule.Host, ".", "-"))
-				for i := range httpRouteContext.HTTPRoute.Spec.ParentRefs {
-					httpRouteContext.HTTPRoute.Spec.ParentRefs[i].SectionName = (*gatewayv1.SectionName)(&httpListenerName)
-				}
-
-				// Add redirect rule at the beginning to redirect all HTTP traffic to HTTPS
-				redirectRule := gatewayv1.HTTPRouteRule{
-					Filters: []gatewayv1.HTTPRouteFilter{
-						{
-							Type: gatewayv1.HTTPRouteFilterRequestRedirect,
-							RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
-								Scheme:     ptr.To("https"),
-								StatusCode: ptr.To(301),
-							},
+			ensureHTTPSListener(rule.Ingress, rg.Host, ir)
+
+			routeKey := types.NamespacedName{Namespace: rg.Namespace, Name: common.RouteName(rg.Name, rg.Host)}
+			httpRouteContext, routeExists := ir.HTTPRoutes[routeKey]
+			if !routeExists {
+				break
+			}
+
+			// Update parentRefs to specify the HTTP listener for SSL redirect
+			httpListenerName := common.FindListenerName(ir.Gateways[ingressGatewayKey(rule.Ingress)].Gateway, rg.Host, gatewayv1.HTTPProtocolType)
+			for i := range httpRouteContext.HTTPRoute.Spec.ParentRefs {
+				httpRouteContext.HTTPRoute.Spec.ParentRefs[i].SectionName = ptr.To(httpListenerName)
+			}
+
+			// Add redirect rule at the beginning to redirect all HTTP traffic to HTTPS
+			redirectRule := gatewayv1.HTTPRouteRule{
+				Filters: []gatewayv1.HTTPRouteFilter{
+					{
+						Type: gatewayv1.HTTPRouteFilterRequestRedirect,
+						RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+							Scheme:     ptr.To("https"),
+							StatusCode: ptr.To(301),
 						},
 					},
-				}
-				httpRouteContext.HTTPRoute.Spec.Rules = append([]gatewayv1.HTTPRouteRule{redirectRule}, httpRouteContext.HTTPRoute.Spec.Rules...)
-
-				ir.HTTPRoutes[routeKey] = httpRouteContext
+				},
 			}
+			httpRouteContext.HTTPRoute.Spec.Rules = append([]gatewayv1.HTTPRouteRule{redirectRule}, httpRouteContext.HTTPRoute.Spec.Rules...)
+
+			ir.HTTPRoutes[routeKey] = httpRouteContext
+
+			// The HTTPRoute is redirected once, whichever Ingresses of the rule
+			// group enable the redirect.
+			break
 		}
 	}
 
@@ -86,18 +86,14 @@ func SSLRedirectFeature(ingresses []networkingv1.Ingress, _ map[types.Namespaced
 
 // ensureHTTPSListener ensures that a Gateway resource has an HTTPS listener configured
 // for the specified Ingress rule. If it doesn't, one is created.
-func ensureHTTPSListener(ingress networkingv1.Ingress, rule networkingv1.IngressRule, ir *intermediate.IR) {
-	gatewayName := NginxIngressClass
-	if ingress.Spec.IngressClassName != nil {
-		gatewayName = *ingress.Spec.IngressClassName
-	}
-	gatewayKey := types.NamespacedName{Namespace: ingress.Namespace, Name: gatewayName}
+func ensureHTTPSListener(ingress networkingv1.Ingress, host string, ir *intermediate.IR) {
+	gatewayKey := ingressGatewayKey(ingress)
 	gatewayContext, exists := ir.Gateways[gatewayKey]
 	if !exists {
 		return
 	}
 
-	hostname := gatewayv1.Hostname(rule.Host)
+	hostname := gatewayv1.Hostname(host)
 	for _, listener := range gatewayContext.Gateway.Spec.Listeners {
 		if listener.Protocol == gatewayv1.HTTPSProtocolType && (listener.Hostname == nil || *listener.Hostname == hostname) {
 			return
@@ -105,17 +101,36 @@ func ensureHTTPSListener(ingress networkingv1.Ingress, rule networkingv1.Ingress
 	}
 
 	httpsListener := gatewayv1.Listener{
-		Name:     gatewayv1.SectionName(fmt.Sprintf("https-%s", strings.ReplaceAll(rule.Host, ".", "-"))),
+		Name:     httpsRedirectListenerName(host),
 		Protocol: gatewayv1.HTTPSProtocolType,
 		Port:     443,
 		Hostname: &hostname,
 		TLS: &gatewayv1.GatewayTLSConfig{
 			Mode: ptr.To(gatewayv1.TLSModeTerminate),
 			CertificateRefs: []gatewayv1.SecretObjectReference{
-				{Name: gatewayv1.ObjectName(fmt.Sprintf("%s-tls", strings.ReplaceAll(rule.Host, ".", "-")))},
+				{Name: gatewayv1.ObjectName(fmt.Sprintf("%s-tls", common.NameFromHost(host)))},
 			},
 		},
 	}
 	gatewayContext.Gateway.Spec.Listeners = append(gatewayContext.Gateway.Spec.Listeners, httpsListener)
+	common.DisambiguateListenerNames(&gatewayContext.Gateway)
 	ir.Gateways[gatewayKey] = gatewayContext
 }
+
+// httpsRedirectListenerName returns the name of the HTTPS listener added for
+// the redirects of the host, e.g. https-foo-example-com. It predates the
+// listener naming of common.ToIR and is kept so that the sectionNames, overrides
+// and attachments referring to the listener keep working.
+func httpsRedirectListenerName(host string) gatewayv1.SectionName {
+	return gatewayv1.SectionName(fmt.Sprintf("https-%s", common.NameFromHost(host)))
+}
+
+// ingressGatewayKey returns the key of the Gateway generated for the ingress
+// class of the Ingress.
+func ingressGatewayKey(ingress networkingv1.Ingress) types.NamespacedName {
+	gatewayName := common.GetIngressClass(ingress)
+	if gatewayName == "" {
+		gatewayName = NginxIngressClass
+	}
+	return types.NamespacedName{Namespace: ingress.Namespace, Name: gatewayName}
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/utils/ptr"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
@@ -220,3 +221,72 @@ func TestSSLRedirectFeature(t *testing.T) {
 		})
 	}
 }
+
+func TestSSLRedirectFeatureSharedHost(t *testing.T) {
+	newIngress := func(name, path string, annotations map[string]string) networkingv1.Ingress {
+		return networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: ptr.To("nginx"),
+				Rules: []networkingv1.IngressRule{{
+					Host: "*.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{
+								Path:     path,
+								PathType: ptr.To(networkingv1.PathTypePrefix),
+								Backend: networkingv1.IngressBackend{
+									Service: &networkingv1.IngressServiceBackend{
+										Name: "web-service",
+										Port: networkingv1.ServiceBackendPort{Number: 80},
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}
+	}
+	ingresses := []networkingv1.Ingress{
+		newIngress("first", "/", nil),
+		newIngress("second", "/api", map[string]string{nginxRedirectToHTTPSAnnotation: "true"}),
+		newIngress("third", "/static", map[string]string{legacySSLRedirectAnnotation: "true"}),
+	}
+
+	ir, errs := common.ToIR(ingresses, nil, i2gw.ProviderImplementationSpecificOptions{})
+	if len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	if errs = SSLRedirectFeature(ingresses, nil, &ir); len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	// The rules of the three Ingresses are merged into the HTTPRoute of the first.
+	routeKey := types.NamespacedName{Namespace: "default", Name: common.RouteName("first", "*.example.com")}
+	httpRoute, ok := ir.HTTPRoutes[routeKey]
+	if !ok {
+		t.Fatalf("Expected HTTPRoute %s, got %v", routeKey, ir.HTTPRoutes)
+	}
+	redirects := 0
+	for _, rule := range httpRoute.HTTPRoute.Spec.Rules {
+		if len(rule.Filters) > 0 && rule.Filters[0].Type == gatewayv1.HTTPRouteFilterRequestRedirect {
+			redirects++
+		}
+	}
+	if redirects != 1 {
+		t.Errorf("Expected 1 redirect rule, got %d", redirects)
+	}
+
+	listeners := map[gatewayv1.SectionName]bool{}
+	for _, listener := range ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Gateway.Spec.Listeners {
+		listeners[listener.Name] = true
+	}
+	sectionName := httpRoute.HTTPRoute.Spec.ParentRefs[0].SectionName
+	if sectionName == nil || !listeners[*sectionName] {
+		t.Errorf("Expected parentRef sectionName to match a Gateway listener, got %v in %v", sectionName, listeners)
+	}
+	if !listeners[httpsRedirectListenerName("*.example.com")] {
+		t.Errorf("Expected HTTPS listener %s, got %v", httpsRedirectListenerName("*.example.com"), listeners)
+	}
+}
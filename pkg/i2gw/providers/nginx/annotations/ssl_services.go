@@ -18,10 +18,14 @@ package annotations
 
 import (
 	"fmt"
+	"slices"
 
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
@@ -30,12 +34,13 @@ import (
 )
 
 // SSLServicesFeature processes nginx.org/ssl-services annotation
-func SSLServicesFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+func SSLServicesFeature(ingresses []networkingv1.Ingress, servicePorts map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
 	var errs field.ErrorList
 
 	for _, ingress := range ingresses {
 		if sslServices, exists := ingress.Annotations[nginxSSLServicesAnnotation]; exists && sslServices != "" {
 			errs = append(errs, processSSLServicesAnnotation(ingress, sslServices, ir)...)
+			rewriteSSLServicePorts(ingress, sets.New(splitAndTrimCommaList(sslServices)...), servicePorts, ir)
 		}
 	}
 
@@ -60,6 +65,9 @@ func processSSLServicesAnnotation(ingress networkingv1.Ingress, sslServices stri
 	for serviceName := range sslServiceSet {
 		policyName := BackendTLSPolicyName(ingress.Name, serviceName)
 		policy := common.CreateBackendTLSPolicy(ingress.Namespace, policyName, serviceName)
+		// The hostname is required by the CRD, the DNS name of the Service is a
+		// placeholder until the hostname of the backend certificate is set.
+		policy.Spec.Validation.Hostname = gatewayv1.PreciseHostname(fmt.Sprintf("%s.%s.svc", serviceName, ingress.Namespace))
 		policyKey := types.NamespacedName{
 			Namespace: ingress.Namespace,
 			Name:      policyName,
@@ -70,13 +78,70 @@ func processSSLServicesAnnotation(ingress networkingv1.Ingress, sslServices stri
 
 	// Add warning about manual certificate configuration
 	if len(sslServiceSet) > 0 {
-		message := "nginx.org/ssl-services: " + BackendTLSPolicyKind + " created but requires manual configuration. You must set the 'validation.hostname' field to match your backend service's TLS certificate hostname, and configure appropriate CA certificates or certificateRefs for TLS verification."
+		message := "nginx.org/ssl-services: " + BackendTLSPolicyKind + " created but requires manual configuration. Its 'validation.hostname' field is set to the DNS name of the Service, <service>.<namespace>.svc, as a placeholder. You must set it to match your backend service's TLS certificate hostname, and configure appropriate CA certificates or certificateRefs for TLS verification."
 		notify(notifications.WarningNotification, message, &ingress)
 	}
 
 	return errs
 }
 
+// rewriteSSLServicePorts points the backendRefs of the HTTPRoutes generated from the
+// ingress to the HTTPS port of the ssl-services, when they reference a plaintext port
+// of a Service also exposing an HTTPS port. The BackendTLSPolicy targets the whole
+// Service, so the plaintext port would otherwise be sent TLS traffic.
+func rewriteSSLServicePorts(ingress networkingv1.Ingress, sslServiceSet sets.Set[string], servicePorts map[types.NamespacedName]map[string]int32, ir *intermediate.IR) {
+	for key, httpRouteContext := range ir.HTTPRoutes {
+		if !slices.ContainsFunc(httpRouteContext.Sources, func(source intermediate.SourceReference) bool {
+			return source.Kind == "Ingress" && source.Namespace == ingress.Namespace && source.Name == ingress.Name
+		}) {
+			continue
+		}
+		for i := range httpRouteContext.HTTPRoute.Spec.Rules {
+			for j := range httpRouteContext.HTTPRoute.Spec.Rules[i].BackendRefs {
+				backendRef := &httpRouteContext.HTTPRoute.Spec.Rules[i].BackendRefs[j].BackendObjectReference
+				if backendRef.Port == nil || !sslServiceSet.Has(string(backendRef.Name)) ||
+					(backendRef.Kind != nil && *backendRef.Kind != "Service") ||
+					(backendRef.Namespace != nil && string(*backendRef.Namespace) != ingress.Namespace) {
+					continue
+				}
+				ports := servicePorts[types.NamespacedName{Namespace: ingress.Namespace, Name: string(backendRef.Name)}]
+				httpsPort, ok := httpsServicePort(ports)
+				if !ok || isHTTPSServicePort(ports, int32(*backendRef.Port)) {
+					continue
+				}
+				notify(notifications.InfoNotification, fmt.Sprintf("%s: backendRef to Service %s of HTTPRoute %s uses HTTPS port %d instead of port %d",
+					nginxSSLServicesAnnotation, backendRef.Name, key, httpsPort, *backendRef.Port), &ingress)
+				backendRef.Port = ptr.To(gatewayv1.PortNumber(httpsPort))
+			}
+		}
+		ir.HTTPRoutes[key] = httpRouteContext
+	}
+}
+
+// httpsServicePort returns the port of the Service serving HTTPS: the port named
+// https, else port 443, else port 8443.
+func httpsServicePort(ports map[string]int32) (int32, bool) {
+	if port, ok := ports["https"]; ok {
+		return port, true
+	}
+	for _, candidate := range []int32{443, 8443} {
+		for _, port := range ports {
+			if port == candidate {
+				return candidate, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// isHTTPSServicePort returns whether the port is one httpsServicePort would infer.
+func isHTTPSServicePort(ports map[string]int32, port int32) bool {
+	if httpsPort, ok := ports["https"]; ok && httpsPort == port {
+		return true
+	}
+	return port == 443 || port == 8443
+}
+
 // BackendTLSPolicyName returns the generated name for a BackendTLSPolicy using NGINX naming convention
 func BackendTLSPolicyName(ingressName, serviceName string) string {
 	return fmt.Sprintf("%s-%s-backend-tls", ingressName, serviceName)
@@ -24,9 +24,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
 func TestSSLServicesAnnotation(t *testing.T) {
@@ -244,3 +247,92 @@ func TestBackendTLSPolicyName(t *testing.T) {
 		})
 	}
 }
+
+func TestSSLServicesPortInference(t *testing.T) {
+	tests := []struct {
+		name         string
+		servicePorts map[string]int32
+		backendPort  int32
+		expectedPort int32
+	}{
+		{
+			name:         "named https port preferred",
+			servicePorts: map[string]int32{"http": 80, "https": 9443, "alt": 443},
+			backendPort:  80,
+			expectedPort: 9443,
+		},
+		{
+			name:         "port 443",
+			servicePorts: map[string]int32{"web": 80, "tls": 443},
+			backendPort:  80,
+			expectedPort: 443,
+		},
+		{
+			name:         "port 8443",
+			servicePorts: map[string]int32{"web": 8080, "tls": 8443},
+			backendPort:  8080,
+			expectedPort: 8443,
+		},
+		{
+			name:         "already an HTTPS port",
+			servicePorts: map[string]int32{"http": 80, "https": 443},
+			backendPort:  443,
+			expectedPort: 443,
+		},
+		{
+			name:         "no HTTPS port",
+			servicePorts: map[string]int32{"http": 80},
+			backendPort:  80,
+			expectedPort: 80,
+		},
+		{
+			name:         "unknown Service",
+			backendPort:  80,
+			expectedPort: 80,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-ingress",
+					Namespace:   "default",
+					Annotations: map[string]string{nginxSSLServicesAnnotation: "secure-api"},
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					Rules: []networkingv1.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{
+							HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{{
+									Path:     "/",
+									PathType: ptr.To(networkingv1.PathTypePrefix),
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: "secure-api",
+											Port: networkingv1.ServiceBackendPort{Number: tt.backendPort},
+										},
+									},
+								}},
+							},
+						},
+					}},
+				},
+			}
+			servicePorts := map[types.NamespacedName]map[string]int32{}
+			if tt.servicePorts != nil {
+				servicePorts[types.NamespacedName{Namespace: "default", Name: "secure-api"}] = tt.servicePorts
+			}
+
+			ir, errs := common.ToIR([]networkingv1.Ingress{ingress}, servicePorts, i2gw.ProviderImplementationSpecificOptions{})
+			require.Empty(t, errs)
+			require.Empty(t, SSLServicesFeature([]networkingv1.Ingress{ingress}, servicePorts, &ir))
+
+			routeKey := types.NamespacedName{Namespace: "default", Name: common.RouteName(ingress.Name, "example.com")}
+			backendRef := ir.HTTPRoutes[routeKey].HTTPRoute.Spec.Rules[0].BackendRefs[0]
+			require.Equal(t, gatewayv1.PortNumber(tt.expectedPort), *backendRef.Port)
+		})
+	}
+}
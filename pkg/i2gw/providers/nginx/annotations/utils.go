@@ -16,7 +16,16 @@ limitations under the License.
 
 package annotations
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
 
 // splitAndTrimCommaList splits a comma-separated string and trims whitespace from each part
 func splitAndTrimCommaList(input string) []string {
@@ -35,3 +44,51 @@ func splitAndTrimCommaList(input string) []string {
 
 	return result
 }
+
+// ingressServiceBackends returns the service backends of the ingress whose service
+// name is in services.
+func ingressServiceBackends(ingress networkingv1.Ingress, services sets.Set[string]) []networkingv1.IngressServiceBackend {
+	var backends []networkingv1.IngressServiceBackend
+	addBackend := func(backend networkingv1.IngressBackend) {
+		if backend.Service == nil {
+			return
+		}
+		if services.Has(backend.Service.Name) {
+			backends = append(backends, *backend.Service)
+		}
+	}
+	if ingress.Spec.DefaultBackend != nil {
+		addBackend(*ingress.Spec.DefaultBackend)
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			addBackend(path.Backend)
+		}
+	}
+	return backends
+}
+
+// setServiceAppProtocol records in the Service IR the appProtocol the port of the
+// backend must declare.
+func setServiceAppProtocol(ir *intermediate.IR, namespace string, backend networkingv1.IngressServiceBackend, appProtocol string) {
+	if ir.Services == nil {
+		ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+	}
+	key := types.NamespacedName{Namespace: namespace, Name: backend.Name}
+	serviceIR := ir.Services[key]
+	if serviceIR.Nginx == nil {
+		serviceIR.Nginx = &intermediate.NginxServiceIR{}
+	}
+	if serviceIR.Nginx.AppProtocols == nil {
+		serviceIR.Nginx.AppProtocols = make(map[string]string)
+	}
+	port := backend.Port.Name
+	if port == "" {
+		port = strconv.Itoa(int(backend.Port.Number))
+	}
+	serviceIR.Nginx.AppProtocols[port] = appProtocol
+	ir.Services[key] = serviceIR
+}
@@ -17,20 +17,45 @@ limitations under the License.
 package annotations
 
 import (
+	"fmt"
+	"strings"
+
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 )
 
-func WebSocketServicesFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, _ *intermediate.IR) field.ErrorList {
-	for _, ingress := range ingresses {
-		if webSocketServices, exists := ingress.Annotations[nginxWebSocketServicesAnnotation]; exists && webSocketServices != "" {
-			message := "nginx.org/websocket-services: Please make sure the services are configured to support WebSocket connections. This annotation does not create any Gateway API resources."
-			notify(notifications.InfoNotification, message, &ingress)
+// webSocketAppProtocol is the appProtocol of Service ports serving WebSocket
+// connections, defined by the Kubernetes Service API.
+const webSocketAppProtocol = "kubernetes.io/ws"
+
+// WebSocketServicesFeature processes the nginx.org/websocket-services annotation. The
+// ports of the listed Services are marked with the kubernetes.io/ws appProtocol in
+// the Service IR, so Gateway implementations which rely on it keep WebSocket
+// upgrades working.
+func WebSocketServicesFeature(ingresses []networkingv1.Ingress, _ map[types.NamespacedName]map[string]int32, ir *intermediate.IR) field.ErrorList {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+		webSocketServices, exists := ingress.Annotations[nginxWebSocketServicesAnnotation]
+		if !exists || webSocketServices == "" {
+			continue
+		}
+
+		services := sets.New(splitAndTrimCommaList(webSocketServices)...)
+		unused := services.Clone()
+		for _, backend := range ingressServiceBackends(*ingress, services) {
+			setServiceAppProtocol(ir, ingress.Namespace, backend, webSocketAppProtocol)
+			unused.Delete(backend.Name)
 		}
+		if unused.Len() > 0 {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s: services %s are not backends of the Ingress and were ignored", nginxWebSocketServicesAnnotation, strings.Join(sets.List(unused), ", ")), ingress)
+		}
+
+		notify(notifications.InfoNotification, fmt.Sprintf("%s: the Service ports must declare appProtocol %s for Gateway implementations which require it to proxy WebSocket upgrades, NGINX Gateway Fabric proxies upgrades without further configuration", nginxWebSocketServicesAnnotation, webSocketAppProtocol), ingress)
 	}
 
 	return nil
@@ -17,10 +17,12 @@ limitations under the License.
 package annotations
 
 import (
+	"reflect"
 	"testing"
 
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
 )
@@ -32,9 +34,20 @@ func TestWebSocketServicesFeature(t *testing.T) {
 				Name:      "websocket-ingress",
 				Namespace: "default",
 				Annotations: map[string]string{
-					nginxWebSocketServicesAnnotation: "websocket-service",
+					nginxWebSocketServicesAnnotation: "websocket-service,missing-service",
 				},
 			},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					Host: "example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Path: "/ws", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "websocket-service", Port: networkingv1.ServiceBackendPort{Number: 8080}}}},
+							{Path: "/", Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web", Port: networkingv1.ServiceBackendPort{Name: "http"}}}},
+						},
+					}},
+				}},
+			},
 		}
 
 		ir := intermediate.IR{}
@@ -42,6 +55,13 @@ func TestWebSocketServicesFeature(t *testing.T) {
 		if len(errs) > 0 {
 			t.Errorf("Unexpected errors: %v", errs)
 		}
+
+		expected := map[types.NamespacedName]intermediate.ProviderSpecificServiceIR{
+			{Namespace: "default", Name: "websocket-service"}: {Nginx: &intermediate.NginxServiceIR{AppProtocols: map[string]string{"8080": "kubernetes.io/ws"}}},
+		}
+		if !reflect.DeepEqual(ir.Services, expected) {
+			t.Errorf("Expected services %+v, got %+v", expected, ir.Services)
+		}
 	})
 
 	t.Run("without annotation", func(t *testing.T) {
@@ -57,5 +77,8 @@ func TestWebSocketServicesFeature(t *testing.T) {
 		if len(errs) > 0 {
 			t.Errorf("Unexpected errors: %v", errs)
 		}
+		if len(ir.Services) != 0 {
+			t.Errorf("Expected no service IR, got %+v", ir.Services)
+		}
 	})
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/annotations"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/testgen"
+)
+
+// BenchmarkCRDsToGatewayIR measures the conversion of synthetic clusters of
+// increasing size to the IR, e.g.:
+//
+//	go test ./pkg/i2gw/providers/nginx/ -run '^$' -bench CRDsToGatewayIR -benchmem
+func BenchmarkCRDsToGatewayIR(b *testing.B) {
+	for _, size := range []int{100, 1000, 5000} {
+		b.Run(fmt.Sprintf("ingresses=%d", size), func(b *testing.B) {
+			storage := newTestgenStorage(testgen.Generate(testgen.DefaultOptions(size)))
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{})
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+				b.StartTimer()
+				if _, errs := converter.convert(storage); len(errs) > 0 {
+					b.Fatalf("Unexpected errors: %v", errs.ToAggregate())
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRouteResolver measures the resolution of the rules of the Ingresses
+// of synthetic clusters into the HTTPRoutes and Gateways of the IR, which the
+// feature parsers then refine.
+func BenchmarkRouteResolver(b *testing.B) {
+	for _, size := range []int{100, 1000, 5000} {
+		b.Run(fmt.Sprintf("ingresses=%d", size), func(b *testing.B) {
+			storage := newTestgenStorage(testgen.Generate(testgen.DefaultOptions(size)))
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{})
+			ingresses := make([]networkingv1.Ingress, 0, len(storage.Ingresses))
+			for _, ingress := range storage.Ingresses {
+				ingresses = append(ingresses, *ingress)
+			}
+			ingresses = annotations.TranslateCommunityAnnotations(ingresses)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, errs := common.ToIR(ingresses, storage.ServicePorts, converter.implementationSpecificOptions); len(errs) > 0 {
+					b.Fatalf("Unexpected errors: %v", errs.ToAggregate())
+				}
+			}
+		})
+	}
+}
+
+// newTestgenStorage returns the storage of the generated cluster, as the
+// resource reader fills it.
+func newTestgenStorage(cluster testgen.Cluster) *storage {
+	storage := newResourceStorage()
+	for _, ingress := range cluster.Ingresses {
+		storage.Ingresses[types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}] = ingress
+	}
+	for _, service := range cluster.Services {
+		storage.Services[types.NamespacedName{Namespace: service.Namespace, Name: service.Name}] = service
+	}
+	storage.ServicePorts = common.GroupServicePortsByPortName(storage.Services)
+	storage.VirtualServers = cluster.VirtualServers
+	return storage
+}
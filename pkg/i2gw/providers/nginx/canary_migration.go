@@ -0,0 +1,232 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+const (
+	// CanaryMigrationFlag enables the canary migration mode. Its value is the
+	// Service of the NGINX Ingress Controller being migrated from, formatted as
+	// namespace/name:port.
+	CanaryMigrationFlag = "canary-migration"
+
+	// CanaryWeightFlag is the percentage of traffic sent to the converted
+	// backends in canary migration mode, the rest goes to the legacy controller.
+	CanaryWeightFlag = "canary-weight"
+
+	defaultCanaryWeight = 10
+
+	// maxBackendWeight is the largest backendRef weight accepted by the Gateway
+	// API CRDs.
+	maxBackendWeight = 1000000
+)
+
+// canaryMigration splits the traffic of every converted route rule between the
+// new backends and the legacy NGINX Ingress Controller Service, so traffic can be
+// shifted gradually instead of switching all at once.
+type canaryMigration struct {
+	legacyService types.NamespacedName
+	legacyPort    gatewayv1.PortNumber
+	weight        int32
+}
+
+// newCanaryMigration parses the canary migration flags. It returns nil when the
+// mode is not enabled.
+func newCanaryMigration(flags map[string]string) (*canaryMigration, field.ErrorList) {
+	value := flags[CanaryMigrationFlag]
+	if value == "" {
+		return nil, nil
+	}
+	flagPath := field.NewPath(CanaryMigrationFlag)
+
+	ref, portValue, found := strings.Cut(value, ":")
+	namespace, name, hasNamespace := strings.Cut(ref, "/")
+	if !found || !hasNamespace || namespace == "" || name == "" {
+		return nil, field.ErrorList{field.Invalid(flagPath, value, "must be formatted as namespace/name:port")}
+	}
+	port, err := strconv.ParseInt(portValue, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return nil, field.ErrorList{field.Invalid(flagPath, value, "port must be a number between 1 and 65535")}
+	}
+
+	weight := int64(defaultCanaryWeight)
+	if weightValue := flags[CanaryWeightFlag]; weightValue != "" {
+		weight, err = strconv.ParseInt(weightValue, 10, 32)
+		if err != nil || weight < 0 || weight > 100 {
+			return nil, field.ErrorList{field.Invalid(field.NewPath(CanaryWeightFlag), weightValue, "must be a percentage between 0 and 100")}
+		}
+	}
+
+	return &canaryMigration{
+		legacyService: types.NamespacedName{Namespace: namespace, Name: name},
+		legacyPort:    gatewayv1.PortNumber(port),
+		weight:        int32(weight),
+	}, nil
+}
+
+// apply adds the legacy controller Service to the backendRefs of every HTTPRoute
+// rule. The weights of the converted backends are scaled so that, together, they
+// receive the configured percentage of the traffic while keeping their ratios.
+// The filters of the rule are moved to the converted backendRefs, so that the
+// requests sent to the legacy controller are not filtered twice.
+//
+// The legacy and converted backends share a rule rather than being split into
+// two HTTPRoutes: Gateway API does not balance the traffic between routes, the
+// rules with the same matches of two routes conflict and only the oldest one is
+// served.
+func (c *canaryMigration) apply(ir *intermediate.IR) {
+	fromNamespaces := map[string]struct{}{}
+	for key, httpRouteContext := range ir.HTTPRoutes {
+		movedFilters := false
+		for i := range httpRouteContext.HTTPRoute.Spec.Rules {
+			rule := &httpRouteContext.HTTPRoute.Spec.Rules[i]
+			if len(rule.BackendRefs) == 0 {
+				continue
+			}
+			weights := make([]int64, 0, len(rule.BackendRefs)+1)
+			var total int64
+			for j := range rule.BackendRefs {
+				weight := int64(ptr.Deref(rule.BackendRefs[j].Weight, 1))
+				total += weight
+				weights = append(weights, weight*int64(c.weight))
+				if len(rule.Filters) > 0 {
+					rule.BackendRefs[j].Filters = append(slices.Clone(rule.Filters), rule.BackendRefs[j].Filters...)
+				}
+			}
+			if len(rule.Filters) > 0 {
+				rule.Filters = nil
+				movedFilters = true
+			}
+			rule.BackendRefs = append(rule.BackendRefs, gatewayv1.HTTPBackendRef{
+				BackendRef: gatewayv1.BackendRef{
+					BackendObjectReference: c.legacyBackendObjectReference(key.Namespace),
+				},
+			})
+			weights = append(weights, total*int64(100-c.weight))
+			for j, weight := range reduceBackendWeights(weights) {
+				rule.BackendRefs[j].Weight = ptr.To(weight)
+			}
+		}
+		ir.HTTPRoutes[key] = httpRouteContext
+		if movedFilters {
+			notify(notifications.InfoNotification, fmt.Sprintf("canary migration moved the filters of the rules of HTTPRoute %s to their converted backendRefs, so that they do not apply to the legacy controller. "+
+				"backendRef filters are an extended feature of Gateway API", key), &httpRouteContext.HTTPRoute)
+		}
+		if key.Namespace != c.legacyService.Namespace {
+			fromNamespaces[key.Namespace] = struct{}{}
+		}
+	}
+
+	for namespace := range fromNamespaces {
+		referenceGrant := c.referenceGrant(namespace)
+		ir.ReferenceGrants[types.NamespacedName{Namespace: referenceGrant.Namespace, Name: referenceGrant.Name}] = referenceGrant
+	}
+
+	for _, grpcRoute := range ir.GRPCRoutes {
+		notify(notifications.WarningNotification, fmt.Sprintf("canary migration does not split GRPCRoute traffic, route %s/%s sends all traffic to the new backends", grpcRoute.Namespace, grpcRoute.Name), &grpcRoute)
+	}
+	notify(notifications.InfoNotification, fmt.Sprintf("canary migration: %d%% of the traffic goes to the converted backends and %d%% to %s. "+
+		"Raise --%s-%s step by step up to 100, then remove the %s backendRefs", c.weight, 100-c.weight, c.legacyService, Name, CanaryWeightFlag, c.legacyService.Name))
+}
+
+// reduceBackendWeights divides the weights by their greatest common divisor,
+// and scales them down proportionally when they still exceed the largest
+// backendRef weight. A weight which is not zero stays at least 1.
+func reduceBackendWeights(weights []int64) []int32 {
+	var divisor, largest int64
+	for _, weight := range weights {
+		divisor = gcd(divisor, weight)
+	}
+	if divisor == 0 {
+		divisor = 1
+	}
+	for _, weight := range weights {
+		largest = max(largest, weight/divisor)
+	}
+
+	reduced := make([]int32, len(weights))
+	for i, weight := range weights {
+		weight /= divisor
+		if largest > maxBackendWeight {
+			scaled := weight * maxBackendWeight / largest
+			if scaled == 0 && weight > 0 {
+				scaled = 1
+			}
+			weight = scaled
+		}
+		reduced[i] = int32(weight)
+	}
+	return reduced
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func (c *canaryMigration) legacyBackendObjectReference(routeNamespace string) gatewayv1.BackendObjectReference {
+	ref := gatewayv1.BackendObjectReference{
+		Name: gatewayv1.ObjectName(c.legacyService.Name),
+		Port: ptr.To(c.legacyPort),
+	}
+	if routeNamespace != c.legacyService.Namespace {
+		ref.Namespace = ptr.To(gatewayv1.Namespace(c.legacyService.Namespace))
+	}
+	return ref
+}
+
+// referenceGrant allows the HTTPRoutes of the given namespace to reference the
+// legacy controller Service.
+func (c *canaryMigration) referenceGrant(fromNamespace string) gatewayv1beta1.ReferenceGrant {
+	referenceGrant := gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("from-%s-to-service-%s", fromNamespace, c.legacyService.Name),
+			Namespace: c.legacyService.Namespace,
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group:     gatewayv1.Group(common.HTTPRouteGVK.Group),
+				Kind:      gatewayv1.Kind(common.HTTPRouteGVK.Kind),
+				Namespace: gatewayv1.Namespace(fromNamespace),
+			}},
+			To: []gatewayv1beta1.ReferenceGrantTo{{
+				Kind: "Service",
+				Name: ptr.To(gatewayv1.ObjectName(c.legacyService.Name)),
+			}},
+		},
+	}
+	referenceGrant.SetGroupVersionKind(common.ReferenceGrantGVK)
+	return referenceGrant
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestNewCanaryMigration(t *testing.T) {
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		expected    *canaryMigration
+		expectError bool
+	}{
+		{
+			name:  "disabled",
+			flags: map[string]string{CanaryWeightFlag: "10"},
+		},
+		{
+			name:  "default weight",
+			flags: map[string]string{CanaryMigrationFlag: "nginx-ingress/nginx-ingress:80"},
+			expected: &canaryMigration{
+				legacyService: types.NamespacedName{Namespace: "nginx-ingress", Name: "nginx-ingress"},
+				legacyPort:    80,
+				weight:        defaultCanaryWeight,
+			},
+		},
+		{
+			name:  "custom weight",
+			flags: map[string]string{CanaryMigrationFlag: "nginx-ingress/nginx-ingress:80", CanaryWeightFlag: "50"},
+			expected: &canaryMigration{
+				legacyService: types.NamespacedName{Namespace: "nginx-ingress", Name: "nginx-ingress"},
+				legacyPort:    80,
+				weight:        50,
+			},
+		},
+		{
+			name:        "missing namespace",
+			flags:       map[string]string{CanaryMigrationFlag: "nginx-ingress:80"},
+			expectError: true,
+		},
+		{
+			name:        "missing port",
+			flags:       map[string]string{CanaryMigrationFlag: "nginx-ingress/nginx-ingress"},
+			expectError: true,
+		},
+		{
+			name:        "weight out of range",
+			flags:       map[string]string{CanaryMigrationFlag: "nginx-ingress/nginx-ingress:80", CanaryWeightFlag: "120"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := newCanaryMigration(tt.flags)
+			if tt.expectError {
+				if len(errs) == 0 {
+					t.Errorf("Expected an error but got none")
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			if (got == nil) != (tt.expected == nil) || (got != nil && *got != *tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCanaryMigrationApply(t *testing.T) {
+	routeKey := types.NamespacedName{Namespace: "default", Name: "example-foo-com"}
+	rewrite := gatewayv1.HTTPRouteFilter{
+		Type:       gatewayv1.HTTPRouteFilterURLRewrite,
+		URLRewrite: &gatewayv1.HTTPURLRewriteFilter{Path: &gatewayv1.HTTPPathModifier{Type: gatewayv1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: ptr.To("/")}},
+	}
+	hostHeader := gatewayv1.HTTPRouteFilter{
+		Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{Set: []gatewayv1.HTTPHeader{{Name: "Host", Value: "v2.default.svc"}}},
+	}
+	ir := intermediate.IR{
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			routeKey: {HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example-foo-com"},
+				Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{
+					{BackendRefs: []gatewayv1.HTTPBackendRef{
+						{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "app"}}},
+					}},
+					{
+						Filters: []gatewayv1.HTTPRouteFilter{rewrite},
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "v1"}, Weight: ptr.To(int32(3))}},
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "v2"}, Weight: ptr.To(int32(1))}, Filters: []gatewayv1.HTTPRouteFilter{hostHeader}},
+						},
+					},
+					{Filters: []gatewayv1.HTTPRouteFilter{{Type: gatewayv1.HTTPRouteFilterRequestRedirect}}},
+				}},
+			}},
+		},
+		ReferenceGrants: map[types.NamespacedName]gatewayv1beta1.ReferenceGrant{},
+	}
+
+	canary := &canaryMigration{
+		legacyService: types.NamespacedName{Namespace: "nginx-ingress", Name: "nginx-ingress"},
+		legacyPort:    80,
+		weight:        10,
+	}
+	canary.apply(&ir)
+
+	rules := ir.HTTPRoutes[routeKey].HTTPRoute.Spec.Rules
+	expectedWeights := [][]int32{{1, 9}, {3, 1, 36}, {}}
+	for i, expected := range expectedWeights {
+		if len(rules[i].BackendRefs) != len(expected) {
+			t.Fatalf("Expected rule %d to have %d backendRefs, got %d", i, len(expected), len(rules[i].BackendRefs))
+		}
+		for j, weight := range expected {
+			if got := ptr.Deref(rules[i].BackendRefs[j].Weight, 1); got != weight {
+				t.Errorf("Expected rule %d backendRef %d weight %d, got %d", i, j, weight, got)
+			}
+		}
+	}
+
+	// The filters of the rule only apply to the converted backends.
+	if len(rules[1].Filters) != 0 {
+		t.Errorf("Expected the filters of rule 1 to be moved to its backendRefs, got %+v", rules[1].Filters)
+	}
+	expectedFilters := [][]gatewayv1.HTTPRouteFilter{{rewrite}, {rewrite, hostHeader}, nil}
+	for j, expected := range expectedFilters {
+		if !reflect.DeepEqual(rules[1].BackendRefs[j].Filters, expected) {
+			t.Errorf("Expected rule 1 backendRef %d filters %+v, got %+v", j, expected, rules[1].BackendRefs[j].Filters)
+		}
+	}
+	if len(rules[2].Filters) != 1 {
+		t.Errorf("Expected the filters of the rule without backendRefs to be kept, got %+v", rules[2].Filters)
+	}
+
+	legacy := rules[0].BackendRefs[1]
+	if legacy.Name != "nginx-ingress" || ptr.Deref(legacy.Namespace, "") != "nginx-ingress" || ptr.Deref(legacy.Port, 0) != 80 {
+		t.Errorf("Expected legacy backendRef nginx-ingress/nginx-ingress:80, got %+v", legacy.BackendObjectReference)
+	}
+
+	referenceGrantKey := types.NamespacedName{Namespace: "nginx-ingress", Name: "from-default-to-service-nginx-ingress"}
+	referenceGrant, ok := ir.ReferenceGrants[referenceGrantKey]
+	if !ok {
+		t.Fatalf("Expected ReferenceGrant %s to exist", referenceGrantKey)
+	}
+	if referenceGrant.Spec.From[0].Namespace != "default" || referenceGrant.Spec.From[0].Kind != "HTTPRoute" {
+		t.Errorf("Expected ReferenceGrant from HTTPRoutes in default, got %+v", referenceGrant.Spec.From[0])
+	}
+}
+
+func TestReduceBackendWeights(t *testing.T) {
+	testCases := []struct {
+		name     string
+		weights  []int64
+		expected []int32
+	}{{
+		name:     "common divisor",
+		weights:  []int64{30, 10, 360},
+		expected: []int32{3, 1, 36},
+	}, {
+		name:     "all traffic to the legacy controller",
+		weights:  []int64{0, 0, 400},
+		expected: []int32{0, 0, 1},
+	}, {
+		name:     "above the largest weight",
+		weights:  []int64{9999990, 10, 90000000},
+		expected: []int32{111111, 1, 1000000},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := reduceBackendWeights(tc.weights); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected weights %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Config is the nginx section of the --config file. Every field sets the
+// provider-specific flag of the same name, unless the flag is set on the command
+// line.
+type Config struct {
+	TargetImplementation    string   `json:"targetImplementation,omitempty"`
+	TLSListenerStrategy     string   `json:"tlsListenerStrategy,omitempty"`
+	HTTPListenerStrategy    string   `json:"httpListenerStrategy,omitempty"`
+	OmitRedirectListeners   *bool    `json:"omitRedirectListeners,omitempty"`
+	DefaultCertificate      string   `json:"defaultCertificate,omitempty"`
+	ConvertSnippetRedirects *bool    `json:"convertSnippetRedirects,omitempty"`
+	GRPCHeuristics          *bool    `json:"grpcHeuristics,omitempty"`
+	HostHeader              string   `json:"hostHeader,omitempty"`
+	ForwardedHeaders        *bool    `json:"forwardedHeaders,omitempty"`
+	NamespacePrecedence     []string `json:"namespacePrecedence,omitempty"`
+	FailOnHostnameCollision *bool    `json:"failOnHostnameCollision,omitempty"`
+	CanaryMigration         string   `json:"canaryMigration,omitempty"`
+	CanaryWeight            *int     `json:"canaryWeight,omitempty"`
+	ControllerService       string   `json:"controllerService,omitempty"`
+	RejectedIngresses       string   `json:"rejectedIngresses,omitempty"`
+	VirtualServerPrecedence string   `json:"virtualServerPrecedence,omitempty"`
+}
+
+// parseConfig decodes and validates the nginx section of the --config file, and
+// returns the values of the flags it sets.
+func parseConfig(data []byte) (map[string]string, error) {
+	config := Config{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, err
+	}
+
+	flags := config.flags()
+	if errs := validateFlags(flags); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return flags, nil
+}
+
+// flags returns the values of the flags set by the configuration.
+func (c Config) flags() map[string]string {
+	flags := map[string]string{}
+	setString := func(name, value string) {
+		if value != "" {
+			flags[name] = value
+		}
+	}
+	setBool := func(name string, value *bool) {
+		if value != nil {
+			flags[name] = strconv.FormatBool(*value)
+		}
+	}
+
+	setString(TargetImplementationFlag, c.TargetImplementation)
+	setString(TLSListenerStrategyFlag, c.TLSListenerStrategy)
+	setString(HTTPListenerStrategyFlag, c.HTTPListenerStrategy)
+	setBool(OmitRedirectListenersFlag, c.OmitRedirectListeners)
+	setString(DefaultCertificateFlag, c.DefaultCertificate)
+	setBool(ConvertSnippetRedirectsFlag, c.ConvertSnippetRedirects)
+	setBool(GRPCHeuristicsFlag, c.GRPCHeuristics)
+	setString(HostHeaderFlag, c.HostHeader)
+	setBool(ForwardedHeadersFlag, c.ForwardedHeaders)
+	setString(NamespacePrecedenceFlag, strings.Join(c.NamespacePrecedence, ","))
+	setBool(FailOnHostnameCollisionFlag, c.FailOnHostnameCollision)
+	setString(CanaryMigrationFlag, c.CanaryMigration)
+	if c.CanaryWeight != nil {
+		flags[CanaryWeightFlag] = strconv.Itoa(*c.CanaryWeight)
+	}
+	setString(ControllerServiceFlag, c.ControllerService)
+	setString(RejectedIngressesFlag, c.RejectedIngresses)
+	setString(VirtualServerPrecedenceFlag, c.VirtualServerPrecedence)
+	return flags
+}
+
+// validateFlags validates the values of the flags the converters parse, so that
+// invalid configuration files are rejected before the conversion.
+func validateFlags(flags map[string]string) field.ErrorList {
+	var errs field.ErrorList
+	if _, err := parseTargetImplementation(flags[TargetImplementationFlag]); err != nil {
+		errs = append(errs, err)
+	}
+	_, tlsErrs := parseTLSListenerStrategy(flags[TLSListenerStrategyFlag])
+	errs = append(errs, tlsErrs...)
+	_, httpErrs := parseHTTPListenerStrategy(flags[HTTPListenerStrategyFlag])
+	errs = append(errs, httpErrs...)
+	_, certificateErrs := parseDefaultCertificate(flags)
+	errs = append(errs, certificateErrs...)
+	if weight, ok := flags[CanaryWeightFlag]; ok {
+		if n, err := strconv.Atoi(weight); err != nil || n < 0 || n > 100 {
+			errs = append(errs, field.Invalid(field.NewPath(CanaryWeightFlag), weight, "must be a percentage between 0 and 100"))
+		}
+	}
+	_, canaryErrs := newCanaryMigration(flags)
+	errs = append(errs, canaryErrs...)
+	_, controllerServiceErrs := parseControllerService(flags)
+	errs = append(errs, controllerServiceErrs...)
+	_, rejectedIngressesErrs := parseRejectedIngresses(flags[RejectedIngressesFlag])
+	errs = append(errs, rejectedIngressesErrs...)
+	_, hostHeaderErrs := parseHostHeader(flags[HostHeaderFlag])
+	errs = append(errs, hostHeaderErrs...)
+	_, virtualServerPrecedenceErrs := parseVirtualServerPrecedence(flags[VirtualServerPrecedenceFlag])
+	errs = append(errs, virtualServerPrecedenceErrs...)
+	return errs
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expected    map[string]string
+		expectError bool
+	}{
+		{
+			name:     "empty",
+			data:     `{}`,
+			expected: map[string]string{},
+		},
+		{
+			name: "all options",
+			data: `{
+				"targetImplementation": "nginx-gateway-fabric",
+				"tlsListenerStrategy": "wildcard",
+				"httpListenerStrategy": "shared",
+				"omitRedirectListeners": true,
+				"defaultCertificate": "default/tls",
+				"convertSnippetRedirects": true,
+				"grpcHeuristics": false,
+				"hostHeader": "upstream",
+				"forwardedHeaders": true,
+				"namespacePrecedence": ["prod", "staging"],
+				"failOnHostnameCollision": true,
+				"canaryMigration": "nginx-ingress/nginx-ingress:80",
+				"canaryWeight": 25
+			}`,
+			expected: map[string]string{
+				TargetImplementationFlag:    "nginx-gateway-fabric",
+				TLSListenerStrategyFlag:     "wildcard",
+				HTTPListenerStrategyFlag:    "shared",
+				OmitRedirectListenersFlag:   "true",
+				DefaultCertificateFlag:      "default/tls",
+				ConvertSnippetRedirectsFlag: "true",
+				GRPCHeuristicsFlag:          "false",
+				HostHeaderFlag:              "upstream",
+				ForwardedHeadersFlag:        "true",
+				NamespacePrecedenceFlag:     "prod,staging",
+				FailOnHostnameCollisionFlag: "true",
+				CanaryMigrationFlag:         "nginx-ingress/nginx-ingress:80",
+				CanaryWeightFlag:            "25",
+			},
+		},
+		{
+			name:        "unknown option",
+			data:        `{"listenerStrategy": "wildcard"}`,
+			expectError: true,
+		},
+		{
+			name:        "wrong type",
+			data:        `{"grpcHeuristics": "yes"}`,
+			expectError: true,
+		},
+		{
+			name:        "unsupported target implementation",
+			data:        `{"targetImplementation": "envoy"}`,
+			expectError: true,
+		},
+		{
+			name:        "unsupported listener strategy",
+			data:        `{"tlsListenerStrategy": "shared"}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid default certificate",
+			data:        `{"defaultCertificate": "tls"}`,
+			expectError: true,
+		},
+		{
+			name:        "unsupported host header",
+			data:        `{"hostHeader": "client"}`,
+			expectError: true,
+		},
+		{
+			name:        "unsupported virtual server precedence",
+			data:        `{"virtualServerPrecedence": "prefer-virtualserver"}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid canary weight",
+			data:        `{"canaryWeight": 120}`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flags, err := parseConfig([]byte(tc.data))
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got flags %v", flags)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, flags); diff != "" {
+				t.Errorf("Unexpected flags (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
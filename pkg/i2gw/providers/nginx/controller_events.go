@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// RejectedIngressesFlag selects what happens to the Ingresses NGINX Ingress
+// Controller reported as rejected.
+const RejectedIngressesFlag = "rejected-ingresses"
+
+const (
+	// skipRejectedIngresses does not convert the rejected Ingresses.
+	skipRejectedIngresses = "skip"
+	// convertRejectedIngresses converts the rejected Ingresses and reports them.
+	convertRejectedIngresses = "convert"
+)
+
+// Reasons of the events NGINX Ingress Controller records on the Ingresses it
+// processes.
+const (
+	// eventReasonAddedOrUpdated is recorded when the configuration is applied.
+	eventReasonAddedOrUpdated = "AddedOrUpdated"
+	// eventReasonAddedOrUpdatedWithWarning is recorded when the configuration
+	// is applied, ignoring some of the settings of the Ingress.
+	eventReasonAddedOrUpdatedWithWarning = "AddedOrUpdatedWithWarning"
+	// eventReasonAddedOrUpdatedWithError is recorded when the configuration
+	// could not be reloaded, so NGINX keeps serving the previous one.
+	eventReasonAddedOrUpdatedWithError = "AddedOrUpdatedWithError"
+	// eventReasonRejected is recorded when the Ingress is invalid and not
+	// served.
+	eventReasonRejected = "Rejected"
+)
+
+// transportServerKind is the kind of the TransportServers, the NGINX Ingress
+// Controller custom resources of the TCP, UDP and TLS passthrough load balancing.
+const transportServerKind = "TransportServer"
+
+// States NGINX Ingress Controller reports in the status of its custom
+// resources.
+const (
+	// resourceStateWarning is reported when the resource is applied, ignoring
+	// some of its settings.
+	resourceStateWarning = "Warning"
+	// resourceStateInvalid is reported when the resource is rejected and not
+	// served.
+	resourceStateInvalid = "Invalid"
+)
+
+// parseRejectedIngresses validates the rejected Ingresses flag.
+func parseRejectedIngresses(value string) (string, field.ErrorList) {
+	switch value {
+	case "":
+		return skipRejectedIngresses, nil
+	case skipRejectedIngresses, convertRejectedIngresses:
+		return value, nil
+	default:
+		return "", field.ErrorList{field.NotSupported(field.NewPath(RejectedIngressesFlag), value, []string{skipRejectedIngresses, convertRejectedIngresses})}
+	}
+}
+
+// readIngressEventsFromCluster reads the events of the Ingresses from the
+// cluster. Events are only used to report the Ingresses NGINX Ingress Controller
+// rejected, so a failure to list them is reported instead of failing the
+// conversion.
+func readIngressEventsFromCluster(ctx context.Context, c client.Client) map[types.NamespacedName][]apiv1.Event {
+	var eventList apiv1.EventList
+	if err := c.List(ctx, &eventList); err != nil {
+		notify(notifications.WarningNotification, fmt.Sprintf("failed to read the events of the Ingresses, the Ingresses rejected by NGINX Ingress Controller are not detected: %v", err))
+		return nil
+	}
+	return groupIngressEvents(eventList.Items)
+}
+
+// groupIngressEvents groups the events of Ingresses by Ingress.
+func groupIngressEvents(events []apiv1.Event) map[types.NamespacedName][]apiv1.Event {
+	ingressEvents := map[types.NamespacedName][]apiv1.Event{}
+	for _, event := range events {
+		if event.InvolvedObject.Kind != "Ingress" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: event.InvolvedObject.Namespace, Name: event.InvolvedObject.Name}
+		if key.Namespace == "" {
+			key.Namespace = event.Namespace
+		}
+		ingressEvents[key] = append(ingressEvents[key], event)
+	}
+	return ingressEvents
+}
+
+// lastControllerEvent returns the most recent event NGINX Ingress Controller
+// recorded on the Ingress, ignoring the events of a deleted Ingress of the same
+// name. It returns nil when the controller recorded none.
+func lastControllerEvent(events []apiv1.Event, uid types.UID) *apiv1.Event {
+	var last *apiv1.Event
+	for i, event := range events {
+		switch event.Reason {
+		case eventReasonAddedOrUpdated, eventReasonAddedOrUpdatedWithWarning, eventReasonAddedOrUpdatedWithError, eventReasonRejected:
+		default:
+			continue
+		}
+		if uid != "" && event.InvolvedObject.UID != "" && event.InvolvedObject.UID != uid {
+			continue
+		}
+		if last == nil || eventTime(event).After(eventTime(*last)) {
+			last = &events[i]
+		}
+	}
+	return last
+}
+
+// eventTime returns the time the event last occurred.
+func eventTime(event apiv1.Event) time.Time {
+	switch {
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// filterRejectedIngresses reports the Ingresses whose last NGINX Ingress
+// Controller event tells they were rejected or not applied, and returns the
+// Ingresses to convert. Rejected Ingresses are only converted with the convert
+// mode, since NGINX does not serve them and their conversion would likely
+// produce invalid Gateway API resources. Ingresses applied with warnings or
+// errors are still served by NGINX, so they are always converted and reported.
+func filterRejectedIngresses(ingresses map[types.NamespacedName]*networkingv1.Ingress, events map[types.NamespacedName][]apiv1.Event, mode string) map[types.NamespacedName]*networkingv1.Ingress {
+	if len(events) == 0 {
+		return ingresses
+	}
+	filtered := make(map[types.NamespacedName]*networkingv1.Ingress, len(ingresses))
+	for key, ingress := range ingresses {
+		if ingress == nil {
+			continue
+		}
+		event := lastControllerEvent(events[key], ingress.UID)
+		if event == nil {
+			filtered[key] = ingress
+			continue
+		}
+		switch event.Reason {
+		case eventReasonRejected:
+			if mode == convertRejectedIngresses {
+				notify(notifications.WarningNotification, fmt.Sprintf("Ingress %s was converted although NGINX Ingress Controller reported it as %s: %s", key, event.Reason, event.Message), ingress)
+				filtered[key] = ingress
+				continue
+			}
+			notify(notifications.ErrorNotification, fmt.Sprintf("Ingress %s was not converted since NGINX Ingress Controller reported it as %s: %s. Fix it, or set --%s-%s=%s to convert it anyway",
+				key, event.Reason, event.Message, Name, RejectedIngressesFlag, convertRejectedIngresses), ingress)
+		case eventReasonAddedOrUpdatedWithError:
+			notify(notifications.WarningNotification, fmt.Sprintf("NGINX Ingress Controller could not reload the configuration of Ingress %s and may still serve a previous one, check that the converted configuration is the intended one: %s", key, event.Message), ingress)
+			filtered[key] = ingress
+		case eventReasonAddedOrUpdatedWithWarning:
+			notify(notifications.WarningNotification, fmt.Sprintf("NGINX Ingress Controller applied Ingress %s with warnings, the settings it ignored may be converted: %s", key, event.Message), ingress)
+			filtered[key] = ingress
+		default:
+			filtered[key] = ingress
+		}
+	}
+	return filtered
+}
+
+// filterRejectedResources reports the NGINX Ingress Controller custom resources
+// whose status tells they were rejected or applied with warnings, and returns
+// the resources NGINX serves. The rejected resources serve no traffic, so their
+// hosts are not correlated with the Ingresses, and their upstreams are neither
+// validated nor reported.
+func filterRejectedResources(resources []*unstructured.Unstructured) []*unstructured.Unstructured {
+	served := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, resource := range resources {
+		if reportResourceStatus(resource) {
+			served = append(served, resource)
+		}
+	}
+	return served
+}
+
+// reportRejectedResources reports the NGINX Ingress Controller custom resources
+// whose status tells they were rejected or applied with warnings.
+func reportRejectedResources(resources []*unstructured.Unstructured) {
+	for _, resource := range resources {
+		reportResourceStatus(resource)
+	}
+}
+
+// reportResourceStatus reports the custom resource when its status tells NGINX
+// Ingress Controller rejected it or applied it with warnings, and returns
+// whether NGINX serves it. Resources without status are assumed to be served.
+func reportResourceStatus(resource *unstructured.Unstructured) bool {
+	state, _, _ := unstructured.NestedString(resource.Object, "status", "state")
+	reason, _, _ := unstructured.NestedString(resource.Object, "status", "reason")
+	message, _, _ := unstructured.NestedString(resource.Object, "status", "message")
+	key := types.NamespacedName{Namespace: resource.GetNamespace(), Name: resource.GetName()}
+	switch state {
+	case resourceStateInvalid:
+		notify(notifications.ErrorNotification, fmt.Sprintf("%s %s is ignored since NGINX Ingress Controller reported it as %s (%s): %s. It serves no traffic, and must be fixed before it is migrated",
+			resource.GetKind(), key, state, reason, message), resource)
+		return false
+	case resourceStateWarning:
+		notify(notifications.WarningNotification, fmt.Sprintf("NGINX Ingress Controller applied %s %s with warnings (%s), the settings it ignored must not be migrated: %s",
+			resource.GetKind(), key, reason, message), resource)
+	}
+	return true
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestFilterRejectedIngresses(t *testing.T) {
+	ingress := func(name string) *networkingv1.Ingress {
+		return &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: types.UID(name + "-uid")}}
+	}
+	event := func(name, reason string, minutes int, uid types.UID) apiv1.Event {
+		return apiv1.Event{
+			InvolvedObject: apiv1.ObjectReference{Kind: "Ingress", Namespace: "default", Name: name, UID: uid},
+			Reason:         reason,
+			Message:        reason + " message",
+			LastTimestamp:  metav1.NewTime(time.Date(2025, 1, 1, 0, minutes, 0, 0, time.UTC)),
+		}
+	}
+	key := func(name string) types.NamespacedName {
+		return types.NamespacedName{Namespace: "default", Name: name}
+	}
+	ingresses := map[types.NamespacedName]*networkingv1.Ingress{
+		key("valid"):     ingress("valid"),
+		key("rejected"):  ingress("rejected"),
+		key("fixed"):     ingress("fixed"),
+		key("warning"):   ingress("warning"),
+		key("error"):     ingress("error"),
+		key("recreated"): ingress("recreated"),
+	}
+	events := groupIngressEvents([]apiv1.Event{
+		event("valid", eventReasonAddedOrUpdated, 1, "valid-uid"),
+		event("rejected", eventReasonAddedOrUpdated, 1, "rejected-uid"),
+		event("rejected", eventReasonRejected, 2, "rejected-uid"),
+		event("fixed", eventReasonRejected, 1, "fixed-uid"),
+		event("fixed", eventReasonAddedOrUpdated, 2, "fixed-uid"),
+		event("warning", eventReasonAddedOrUpdatedWithWarning, 1, ""),
+		event("error", eventReasonAddedOrUpdatedWithError, 1, "error-uid"),
+		event("recreated", eventReasonRejected, 1, "deleted-uid"),
+	})
+
+	testCases := []struct {
+		mode              string
+		expectedConverted []string
+		expectedErrors    int
+		expectedWarnings  int
+	}{{
+		mode:              skipRejectedIngresses,
+		expectedConverted: []string{"valid", "fixed", "warning", "error", "recreated"},
+		expectedErrors:    1,
+		expectedWarnings:  2,
+	}, {
+		mode:              convertRejectedIngresses,
+		expectedConverted: []string{"valid", "rejected", "fixed", "warning", "error", "recreated"},
+		expectedWarnings:  3,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.mode, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+			filtered := filterRejectedIngresses(ingresses, events, tc.mode)
+
+			if len(filtered) != len(tc.expectedConverted) {
+				t.Errorf("Expected %d Ingresses to be converted, got %d", len(tc.expectedConverted), len(filtered))
+			}
+			for _, name := range tc.expectedConverted {
+				if _, ok := filtered[key(name)]; !ok {
+					t.Errorf("Expected Ingress %s to be converted", name)
+				}
+			}
+
+			var errors, warnings int
+			for _, n := range notifications.NotificationAggr.Notifications[Name] {
+				switch n.Type {
+				case notifications.ErrorNotification:
+					errors++
+					if !strings.Contains(n.Message, "Ingress default/rejected was not converted since NGINX Ingress Controller reported it as Rejected: Rejected message") {
+						t.Errorf("Unexpected error: %s", n.Message)
+					}
+				case notifications.WarningNotification:
+					warnings++
+				}
+			}
+			if errors != tc.expectedErrors || warnings != tc.expectedWarnings {
+				t.Errorf("Expected %d errors and %d warnings, got %d and %d", tc.expectedErrors, tc.expectedWarnings, errors, warnings)
+			}
+		})
+	}
+}
+
+func TestReadRejectedIngressesFromFile(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	input := `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: cafe
+  namespace: default
+spec:
+  ingressClassName: nginx
+  rules:
+  - host: cafe.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: coffee
+            port:
+              number: 80
+---
+apiVersion: v1
+kind: Event
+metadata:
+  name: cafe.1
+  namespace: default
+involvedObject:
+  kind: Ingress
+  namespace: default
+  name: cafe
+reason: Rejected
+message: All hosts are taken by other resources
+type: Warning
+lastTimestamp: "2025-01-01T00:00:00Z"
+`
+	path := filepath.Join(t.TempDir(), "input.yaml")
+	if err := os.WriteFile(path, []byte(input), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider := NewProvider(&i2gw.ProviderConf{}).(*Provider)
+	if err := provider.ReadResourcesFromFile(context.Background(), path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ir, errs := provider.ToIR()
+	if len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	if len(ir.HTTPRoutes) != 0 {
+		t.Errorf("Expected the rejected Ingress not to be converted, got routes %v", ir.HTTPRoutes)
+	}
+	for _, n := range notifications.NotificationAggr.Notifications[Name] {
+		if strings.Contains(n.Message, "Event resources are not processed") {
+			t.Errorf("Expected the events not to be reported as unprocessed: %s", n.Message)
+		}
+	}
+}
+
+func TestFilterRejectedResources(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	resource := func(kind, name string, status map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k8s.nginx.org/v1",
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+			"spec":       map[string]interface{}{"host": name + ".example.com"},
+		}}
+		if status != nil {
+			u.Object["status"] = status
+		}
+		return u
+	}
+	virtualServers := []*unstructured.Unstructured{
+		resource("VirtualServer", "valid", map[string]interface{}{"state": "Valid", "reason": "AddedOrUpdated"}),
+		resource("VirtualServer", "unknown", nil),
+		resource("VirtualServer", "invalid", map[string]interface{}{"state": "Invalid", "reason": "Rejected", "message": "spec.upstreams[0].port: must be between 1 and 65535"}),
+		resource("VirtualServerRoute", "warning", map[string]interface{}{"state": "Warning", "reason": "AddedOrUpdatedWithWarning", "message": "ignored snippets"}),
+	}
+
+	var served []string
+	for _, virtualServer := range filterRejectedResources(virtualServers) {
+		served = append(served, virtualServer.GetName())
+	}
+	if diff := cmp.Diff([]string{"valid", "unknown", "warning"}, served); diff != "" {
+		t.Errorf("Unexpected served resources (-want +got):\n%s", diff)
+	}
+	reportRejectedResources([]*unstructured.Unstructured{
+		resource("TransportServer", "tcp", map[string]interface{}{"state": "Invalid", "reason": "Rejected", "message": "listener tcp is not defined"}),
+	})
+
+	var messages []string
+	for _, n := range notifications.NotificationAggr.Notifications[Name] {
+		messages = append(messages, string(n.Type)+": "+n.Message)
+	}
+	expected := []string{
+		"ERROR: VirtualServer default/invalid is ignored since NGINX Ingress Controller reported it as Invalid (Rejected): spec.upstreams[0].port: must be between 1 and 65535. It serves no traffic, and must be fixed before it is migrated",
+		"WARNING: NGINX Ingress Controller applied VirtualServerRoute default/warning with warnings (AddedOrUpdatedWithWarning), the settings it ignored must not be migrated: ignored snippets",
+		"ERROR: TransportServer default/tcp is ignored since NGINX Ingress Controller reported it as Invalid (Rejected): listener tcp is not defined. It serves no traffic, and must be fixed before it is migrated",
+	}
+	if diff := cmp.Diff(expected, messages); diff != "" {
+		t.Errorf("Unexpected notifications (-want +got):\n%s", diff)
+	}
+}
+
+func TestConvertIgnoresRejectedVirtualServers(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	storage := newResourceStorage()
+	storage.VirtualServers = []*unstructured.Unstructured{{Object: map[string]interface{}{
+		"apiVersion": "k8s.nginx.org/v1",
+		"kind":       "VirtualServer",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "tea"},
+		"spec": map[string]interface{}{
+			"host":      "tea.example.com",
+			"upstreams": []interface{}{map[string]interface{}{"name": "tea", "service": "tea-svc", "port": int64(0)}},
+		},
+		"status": map[string]interface{}{"state": "Invalid", "reason": "Rejected", "message": "invalid port"},
+	}}}
+
+	// The invalid fields of the rejected VirtualServer do not fail the run.
+	if _, errs := newResourcesToIRConverter(&i2gw.ProviderConf{}).convert(storage); len(errs) > 0 {
+		t.Errorf("Expected the rejected VirtualServer to be ignored, got %v", errs)
+	}
+}
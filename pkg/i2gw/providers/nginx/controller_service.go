@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// ControllerServiceFlag is the NGINX Ingress Controller Service, formatted as
+// namespace/name, whose load balancer annotations are copied to the Gateways.
+const ControllerServiceFlag = "controller-service"
+
+// maxInfrastructureAnnotations is the maximum number of infrastructure annotations
+// of a Gateway.
+const maxInfrastructureAnnotations = 8
+
+// loadBalancerAnnotationPrefixes are the prefixes of the Service annotations
+// configuring the load balancer provisioned by the cloud provider.
+var loadBalancerAnnotationPrefixes = []string{
+	"service.beta.kubernetes.io/",
+	"service.kubernetes.io/",
+	"cloud.google.com/",
+	"networking.gke.io/",
+	"metallb.universe.tf/",
+}
+
+// parseControllerService parses the controller Service flag. It returns an empty
+// NamespacedName when the flag is not set.
+func parseControllerService(flags map[string]string) (types.NamespacedName, field.ErrorList) {
+	value := flags[ControllerServiceFlag]
+	if value == "" {
+		return types.NamespacedName{}, nil
+	}
+	namespace, name, found := strings.Cut(value, "/")
+	if !found || namespace == "" || name == "" {
+		return types.NamespacedName{}, field.ErrorList{field.Invalid(field.NewPath(ControllerServiceFlag), value, "must be formatted as namespace/name")}
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+// applyControllerServiceAnnotations copies the load balancer annotations of the
+// controller Service to the infrastructure annotations of every Gateway, so that
+// the data plane provisioned for the Gateways keeps the load balancer settings.
+// Gateway API accepts at most 8 annotations, the others are reported.
+func applyControllerServiceAnnotations(ir *intermediate.IR, key types.NamespacedName, service *apiv1.Service) {
+	if service == nil {
+		notify(notifications.WarningNotification, fmt.Sprintf("controller Service %s was not found, no load balancer annotation was copied to the Gateways", key))
+		return
+	}
+
+	var names []string
+	for name := range service.Annotations {
+		if slices.ContainsFunc(loadBalancerAnnotationPrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	slices.Sort(names)
+	if len(names) > maxInfrastructureAnnotations {
+		notify(notifications.WarningNotification, fmt.Sprintf("controller Service %s has %d load balancer annotations, only the first %d are copied to the Gateways, not %s",
+			key, len(names), maxInfrastructureAnnotations, strings.Join(names[maxInfrastructureAnnotations:], ", ")), service)
+		names = names[:maxInfrastructureAnnotations]
+	}
+
+	for gatewayKey, gatewayContext := range ir.Gateways {
+		infrastructure := gatewayContext.Spec.Infrastructure
+		if infrastructure == nil {
+			infrastructure = &gatewayv1.GatewayInfrastructure{}
+		}
+		if infrastructure.Annotations == nil {
+			infrastructure.Annotations = map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{}
+		}
+		for _, name := range names {
+			infrastructure.Annotations[gatewayv1.AnnotationKey(name)] = gatewayv1.AnnotationValue(service.Annotations[name])
+		}
+		gatewayContext.Spec.Infrastructure = infrastructure
+		ir.Gateways[gatewayKey] = gatewayContext
+		notify(notifications.InfoNotification, fmt.Sprintf("copied the load balancer annotations %s of controller Service %s to the infrastructure of the Gateway, which requires the experimental channel of Gateway API",
+			strings.Join(names, ", "), key), &gatewayContext.Gateway)
+	}
+}
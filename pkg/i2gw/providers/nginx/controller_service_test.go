@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestControllerServiceAnnotations(t *testing.T) {
+	controllerService := &apiv1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:      "nginx-ingress",
+		Namespace: "nginx-ingress",
+		Annotations: map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-type":   "nlb",
+			"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			"cloud.google.com/neg":                                `{"ingress": true}`,
+			"kubectl.kubernetes.io/last-applied-configuration":    "{}",
+		},
+	}}
+
+	tests := []struct {
+		name                string
+		controllerService   string
+		expectErrors        bool
+		expectedAnnotations map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue
+		expectedWarnings    int
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name:              "load balancer annotations copied",
+			controllerService: "nginx-ingress/nginx-ingress",
+			expectedAnnotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				"service.beta.kubernetes.io/aws-load-balancer-type":   "nlb",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				"cloud.google.com/neg":                                `{"ingress": true}`,
+			},
+		},
+		{
+			name:              "unknown Service",
+			controllerService: "nginx-ingress/unknown",
+			expectedWarnings:  1,
+		},
+		{
+			name:              "invalid",
+			controllerService: "nginx-ingress",
+			expectErrors:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					Rules:            []networkingv1.IngressRule{ingressRule("a.example.com")},
+				},
+			}
+
+			flags := map[string]map[string]string{Name: {ControllerServiceFlag: tt.controllerService}}
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{ProviderSpecificFlags: flags})
+			ir, errs := converter.convert(&storage{
+				Ingresses: map[types.NamespacedName]*networkingv1.Ingress{{Namespace: "default", Name: "app"}: &ingress},
+				Services:  map[types.NamespacedName]*apiv1.Service{{Namespace: "nginx-ingress", Name: "nginx-ingress"}: controllerService},
+			})
+			if tt.expectErrors {
+				if len(errs) == 0 {
+					t.Errorf("Expected errors, got none")
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var annotations map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue
+			if infrastructure := ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Spec.Infrastructure; infrastructure != nil {
+				annotations = infrastructure.Annotations
+			}
+			if !reflect.DeepEqual(annotations, tt.expectedAnnotations) {
+				t.Errorf("Expected infrastructure annotations %v, got %v", tt.expectedAnnotations, annotations)
+			}
+
+			warnings := 0
+			for _, notification := range notifications.NotificationAggr.Notifications[Name] {
+				if notification.Type == notifications.WarningNotification {
+					warnings++
+				}
+			}
+			if warnings != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %d", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+}
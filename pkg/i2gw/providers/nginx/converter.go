@@ -29,33 +29,64 @@ import (
 type resourcesToIRConverter struct {
 	featureParsers                []i2gw.FeatureParser
 	implementationSpecificOptions i2gw.ProviderImplementationSpecificOptions
+	providerSpecificFlags         map[string]string
 }
 
-func newResourcesToIRConverter() *resourcesToIRConverter {
+func newResourcesToIRConverter(conf *i2gw.ProviderConf) *resourcesToIRConverter {
+	providerSpecificFlags := conf.ProviderSpecificFlags[Name]
 	return &resourcesToIRConverter{
-		featureParsers: []i2gw.FeatureParser{
-			annotations.ListenPortsFeature,
-			annotations.RewriteTargetFeature,
-			annotations.HeaderManipulationFeature,
-			annotations.PathRegexFeature,
-			annotations.SSLRedirectFeature,
-			annotations.HSTSFeature,
-			annotations.WebSocketServicesFeature,
-			annotations.SSLServicesFeature,
-			annotations.GRPCServicesFeature,
+		providerSpecificFlags: providerSpecificFlags,
+		featureParsers:        featureParsers(providerSpecificFlags),
+		implementationSpecificOptions: i2gw.ProviderImplementationSpecificOptions{
+			ToImplementationSpecificHTTPPathTypeMatch: implementationSpecificHTTPPathTypeMatch,
 		},
-		implementationSpecificOptions: i2gw.ProviderImplementationSpecificOptions{},
 	}
 }
 
+// featureParsers returns the parsers of the annotation features, followed by the
+// shared feature parsers.
+func featureParsers(providerSpecificFlags map[string]string) []i2gw.FeatureParser {
+	// An invalid Host header is reported by convert.
+	hostHeader, _ := parseHostHeader(providerSpecificFlags[HostHeaderFlag])
+	features := annotations.Features(annotations.FeatureOptions{
+		GRPCHeuristics:          providerSpecificFlags[GRPCHeuristicsFlag] == "true",
+		ConvertSnippetRedirects: providerSpecificFlags[ConvertSnippetRedirectsFlag] == "true",
+		HostHeader:              hostHeader,
+	})
+	parsers := make([]i2gw.FeatureParser, 0, len(features))
+	for _, feature := range features {
+		parsers = append(parsers, feature.Parser)
+	}
+	return append(parsers, common.SharedFeatureParsers(Name)...)
+}
+
 func (c *resourcesToIRConverter) convert(storage *storage) (intermediate.IR, field.ErrorList) {
+	rejectedIngresses, errs := parseRejectedIngresses(c.providerSpecificFlags[RejectedIngressesFlag])
+	if len(errs) > 0 {
+		return intermediate.IR{}, errs
+	}
+
 	ingressList := []networkingv1.Ingress{}
-	for _, ingress := range storage.Ingresses {
+	for _, ingress := range filterRejectedIngresses(storage.Ingresses, storage.IngressEvents, rejectedIngresses) {
 		if ingress != nil {
 			ingressList = append(ingressList, *ingress)
 		}
 	}
 
+	if _, errs := parseHostHeader(c.providerSpecificFlags[HostHeaderFlag]); len(errs) > 0 {
+		return intermediate.IR{}, errs
+	}
+
+	virtualServerPrecedence, errs := parseVirtualServerPrecedence(c.providerSpecificFlags[VirtualServerPrecedenceFlag])
+	if len(errs) > 0 {
+		return intermediate.IR{}, errs
+	}
+	virtualServers := filterRejectedResources(storage.VirtualServers)
+	reportRejectedResources(storage.TransportServers)
+	ingressList = correlateVirtualServerHosts(ingressList, virtualServers, virtualServerPrecedence)
+
+	ingressList = annotations.TranslateCommunityAnnotations(ingressList)
+
 	ir, errorList := common.ToIR(ingressList, storage.ServicePorts, c.implementationSpecificOptions)
 	if len(errorList) > 0 {
 		return intermediate.IR{}, errorList
@@ -66,5 +97,75 @@ func (c *resourcesToIRConverter) convert(storage *storage) (intermediate.IR, fie
 		errorList = append(errorList, errs...)
 	}
 
+	errorList = append(errorList, validateVirtualServers(virtualServers)...)
+	captureVirtualServerUpstreams(virtualServers, &ir)
+	reportInternalRoutes(virtualServers)
+	checkVirtualServerRoutes(virtualServers)
+	resolveAppProtocolPorts(&ir, storage.ServicePorts)
+	applyHostCertificates(ingressList, &ir)
+	reportUnmatchedTLSHosts(ingressList)
+
+	tlsListenerStrategy, errs := parseTLSListenerStrategy(c.providerSpecificFlags[TLSListenerStrategyFlag])
+	if len(errs) > 0 {
+		return intermediate.IR{}, append(errorList, errs...)
+	}
+	if tlsListenerStrategy == wildcardTLSListeners {
+		mergeWildcardListeners(&ir)
+	}
+
+	httpListenerStrategy, errs := parseHTTPListenerStrategy(c.providerSpecificFlags[HTTPListenerStrategyFlag])
+	if len(errs) > 0 {
+		return intermediate.IR{}, append(errorList, errs...)
+	}
+	if httpListenerStrategy == sharedHTTPListeners {
+		shareHTTPListeners(&ir)
+	}
+
+	defaultCertificate, errs := parseDefaultCertificate(c.providerSpecificFlags)
+	if len(errs) > 0 {
+		return intermediate.IR{}, append(errorList, errs...)
+	}
+	applyDefaultCertificate(&ir, defaultCertificate)
+
+	controllerService, errs := parseControllerService(c.providerSpecificFlags)
+	if len(errs) > 0 {
+		return intermediate.IR{}, append(errorList, errs...)
+	}
+	if controllerService.Name != "" {
+		applyControllerServiceAnnotations(&ir, controllerService, storage.Services[controllerService])
+	}
+
+	precedence := parseNamespacePrecedence(c.providerSpecificFlags[NamespacePrecedenceFlag])
+	failOnCollision := c.providerSpecificFlags[FailOnHostnameCollisionFlag] == "true"
+	if errs := resolveHostnameCollisions(&ir, precedence, failOnCollision); len(errs) > 0 {
+		return intermediate.IR{}, append(errorList, errs...)
+	}
+
+	if c.providerSpecificFlags[OmitRedirectListenersFlag] == "true" {
+		omitRedirectListeners(&ir)
+	}
+
+	if c.providerSpecificFlags[ForwardedHeadersFlag] == "true" {
+		profile, err := parseTargetImplementation(c.providerSpecificFlags[TargetImplementationFlag])
+		if err != nil {
+			return intermediate.IR{}, append(errorList, err)
+		}
+		applyForwardedHeaders(&ir, profile)
+	}
+
+	auditListenerPairing(ingressList, &ir)
+	checkRewriteSemantics(&ir)
+	reportMixedProtocolServices(ir)
+
+	canary, errs := newCanaryMigration(c.providerSpecificFlags)
+	if len(errs) > 0 {
+		return intermediate.IR{}, append(errorList, errs...)
+	}
+	if canary != nil {
+		canary.apply(&ir)
+	}
+
+	errorList = append(errorList, annotations.FilterOrderFeature(ingressList, storage.ServicePorts, &ir)...)
+
 	return ir, errorList
 }
@@ -17,7 +17,22 @@ limitations under the License.
 package nginx
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
 func TestNewResourcesToIRConverter(t *testing.T) {
@@ -32,9 +47,187 @@ func TestNewResourcesToIRConverter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := newResourcesToIRConverter(); got == nil {
+			if got := newResourcesToIRConverter(&i2gw.ProviderConf{}); got == nil {
 				t.Errorf("newResourcesToIRConverter() = %v, want non-nil", got)
 			}
 		})
 	}
 }
+
+func TestHeaderNormalizationFixture(t *testing.T) {
+	provider := NewProvider(&i2gw.ProviderConf{}).(*Provider)
+	if err := provider.ReadResourcesFromFile(context.Background(), "fixtures/annotations/input/nginx-header-normalization.yaml"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ir, errs := provider.ToIR()
+	if len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	objects, err := common.ExtractObjectsFromPath("fixtures/annotations/output/nginx-header-normalization.yaml", "")
+	if err != nil {
+		t.Fatalf("Failed to read the expected output: %v", err)
+	}
+	for _, obj := range objects {
+		if obj.GetKind() != "HTTPRoute" {
+			continue
+		}
+		var expected gatewayv1.HTTPRoute
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &expected); err != nil {
+			t.Fatal(err)
+		}
+		for key, route := range ir.HTTPRoutes {
+			if key.Name != expected.Name {
+				continue
+			}
+			if !reflect.DeepEqual(route.Spec.Rules[0].Filters, expected.Spec.Rules[0].Filters) {
+				t.Errorf("Expected filters %+v, got %+v", expected.Spec.Rules[0].Filters, route.Spec.Rules[0].Filters)
+			}
+			return
+		}
+		t.Fatalf("Expected HTTPRoute %s to be generated", expected.Name)
+	}
+	t.Fatal("Expected an HTTPRoute in the expected output")
+}
+
+// TestSectionNameContract checks that the sectionName of every parentRef the
+// converter generates names a generated listener, for every fixture and listener
+// strategy.
+func TestSectionNameContract(t *testing.T) {
+	inputs, err := filepath.Glob("fixtures/annotations/input/*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixed := filepath.Join(t.TempDir(), "mixed.yaml")
+	if err = os.WriteFile(mixed, []byte(`apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: cafe
+  namespace: default
+  annotations:
+    nginx.org/listen-ports: "8080"
+    nginx.org/listen-ports-ssl: "8443"
+    nginx.org/redirect-to-https: "true"
+    nginx.org/server-snippets: "server_name cafe.example.org;"
+spec:
+  ingressClassName: nginx
+  tls:
+  - hosts: [cafe.example.com, tea.example.com]
+    secretName: cafe-tls
+  rules:
+  - host: cafe.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: coffee
+            port:
+              number: 80
+  - host: tea.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: tea
+            port:
+              number: 80
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	inputs = append(inputs, mixed)
+
+	for _, flags := range []map[string]string{
+		{},
+		{TLSListenerStrategyFlag: wildcardTLSListeners, HTTPListenerStrategyFlag: sharedHTTPListeners},
+		{OmitRedirectListenersFlag: "true"},
+		{OmitRedirectListenersFlag: "true", HTTPListenerStrategyFlag: sharedHTTPListeners},
+	} {
+		for _, input := range inputs {
+			provider := NewProvider(&i2gw.ProviderConf{ProviderSpecificFlags: map[string]map[string]string{Name: flags}}).(*Provider)
+			if err := provider.ReadResourcesFromFile(context.Background(), input); err != nil {
+				t.Fatalf("%s: unexpected error: %v", input, err)
+			}
+			ir, errs := provider.ToIR()
+			if len(errs) > 0 {
+				t.Fatalf("%s: unexpected errors: %v", input, errs)
+			}
+			for _, unmatched := range i2gw.UnmatchedSectionNames(&ir) {
+				t.Errorf("%s with %v: the sectionName of %s names no listener", filepath.Base(input), flags, unmatched)
+			}
+		}
+	}
+}
+
+// TestGatewayAPISchemaContract checks that the resources generated for every
+// fixture match the schemas of the Gateway API CRDs, placeholders included.
+func TestGatewayAPISchemaContract(t *testing.T) {
+	inputs, err := filepath.Glob("fixtures/annotations/input/*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range inputs {
+		provider := NewProvider(&i2gw.ProviderConf{}).(*Provider)
+		if err := provider.ReadResourcesFromFile(context.Background(), input); err != nil {
+			t.Fatalf("%s: unexpected error: %v", input, err)
+		}
+		ir, errs := provider.ToIR()
+		if len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors: %v", input, errs)
+		}
+		gatewayResources, errs := provider.ToGatewayResources(ir)
+		if len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors: %v", input, errs)
+		}
+		for _, violation := range i2gw.GatewayAPISchemaViolations(&gatewayResources) {
+			t.Errorf("%s: %s", filepath.Base(input), violation)
+		}
+	}
+}
+
+func TestConvertOrdersFiltersLast(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	storage := newResourceStorage()
+	storage.Ingresses[types.NamespacedName{Namespace: "default", Name: "cafe"}] = &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe", Annotations: map[string]string{
+			"nginx.org/rewrites":           "serviceName=app rewrite=/v1",
+			"nginx.org/proxy-hide-headers": "X-Powered-By",
+		}},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ptr.To("nginx"),
+			Rules:            []networkingv1.IngressRule{ingressRule("cafe.example.com")},
+		},
+	}
+	flags := map[string]string{
+		ForwardedHeadersFlag: "true",
+		CanaryMigrationFlag:  "nginx-ingress/nginx-ingress:80",
+	}
+
+	converter := newResourcesToIRConverter(&i2gw.ProviderConf{ProviderSpecificFlags: map[string]map[string]string{Name: flags}})
+	ir, errs := converter.convert(storage)
+	if len(errs) > 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	// The forwarded headers filter is added after the annotation filters, and the
+	// canary migration moves all of them to the converted backendRef.
+	rules := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "cafe-cafe-example-com"}].HTTPRoute.Spec.Rules
+	if len(rules) != 1 || len(rules[0].BackendRefs) != 2 {
+		t.Fatalf("Expected a rule with the converted and the legacy backendRefs, got %+v", rules)
+	}
+	var filterTypes []gatewayv1.HTTPRouteFilterType
+	for _, filter := range rules[0].BackendRefs[0].Filters {
+		filterTypes = append(filterTypes, filter.Type)
+	}
+	expected := []gatewayv1.HTTPRouteFilterType{
+		gatewayv1.HTTPRouteFilterURLRewrite,
+		gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+		gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+	}
+	if !reflect.DeepEqual(filterTypes, expected) {
+		t.Errorf("Expected the filters %v, got %v", expected, filterTypes)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// DefaultCertificateFlag is the Secret, formatted as namespace/name, referenced by
+// HTTPS listeners whose source relies on the default server certificate of NGINX
+// Ingress Controller, i.e. an Ingress tls entry without a secretName. It is the
+// Secret of the -wildcard-tls-secret or -default-server-tls-secret argument of
+// the controller.
+const DefaultCertificateFlag = "default-certificate"
+
+// parseDefaultCertificate parses the default certificate flag. It returns an empty
+// NamespacedName when the flag is not set.
+func parseDefaultCertificate(flags map[string]string) (types.NamespacedName, field.ErrorList) {
+	value := flags[DefaultCertificateFlag]
+	if value == "" {
+		return types.NamespacedName{}, nil
+	}
+	namespace, name, found := strings.Cut(value, "/")
+	if !found || namespace == "" || name == "" {
+		return types.NamespacedName{}, field.ErrorList{field.Invalid(field.NewPath(DefaultCertificateFlag), value, "must be formatted as namespace/name")}
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+// applyDefaultCertificate replaces the empty certificateRefs of the HTTPS listeners
+// with the default certificate. Without a default certificate, listeners left with
+// no certificateRefs are removed, since the HTTP listener for the same hostname
+// still serves the routes.
+func applyDefaultCertificate(ir *intermediate.IR, defaultCertificate types.NamespacedName) {
+	for key, gatewayContext := range ir.Gateways {
+		listeners := make([]gatewayv1.Listener, 0, len(gatewayContext.Spec.Listeners))
+		for _, listener := range gatewayContext.Spec.Listeners {
+			if listener.TLS == nil || !hasEmptyCertificateRef(listener.TLS.CertificateRefs) {
+				listeners = append(listeners, listener)
+				continue
+			}
+
+			var certificateRefs []gatewayv1.SecretObjectReference
+			for _, ref := range listener.TLS.CertificateRefs {
+				if ref.Name != "" {
+					certificateRefs = append(certificateRefs, ref)
+				}
+			}
+
+			if defaultCertificate.Name != "" {
+				certificateRefs = append(certificateRefs, defaultCertificateRef(key.Namespace, defaultCertificate))
+				if key.Namespace != defaultCertificate.Namespace {
+					referenceGrant := defaultCertificateReferenceGrant(key.Namespace, defaultCertificate)
+					ir.ReferenceGrants[types.NamespacedName{Namespace: referenceGrant.Namespace, Name: referenceGrant.Name}] = referenceGrant
+				}
+				notify(notifications.InfoNotification, fmt.Sprintf("listener %s uses the default certificate %s", listener.Name, defaultCertificate), &gatewayContext.Gateway)
+			}
+
+			if len(certificateRefs) == 0 {
+				notify(notifications.WarningNotification, fmt.Sprintf("listener %s was removed because its TLS configuration relies on the default server certificate, "+
+					"set --%s-%s to reference a certificate instead", listener.Name, Name, DefaultCertificateFlag), &gatewayContext.Gateway)
+				continue
+			}
+			listener.TLS.CertificateRefs = certificateRefs
+			listeners = append(listeners, listener)
+		}
+		gatewayContext.Spec.Listeners = listeners
+		ir.Gateways[key] = gatewayContext
+	}
+}
+
+func hasEmptyCertificateRef(refs []gatewayv1.SecretObjectReference) bool {
+	for _, ref := range refs {
+		if ref.Name == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func defaultCertificateRef(gatewayNamespace string, defaultCertificate types.NamespacedName) gatewayv1.SecretObjectReference {
+	ref := gatewayv1.SecretObjectReference{Name: gatewayv1.ObjectName(defaultCertificate.Name)}
+	if gatewayNamespace != defaultCertificate.Namespace {
+		ref.Namespace = ptr.To(gatewayv1.Namespace(defaultCertificate.Namespace))
+	}
+	return ref
+}
+
+// defaultCertificateReferenceGrant allows the Gateways of the given namespace to
+// reference the default certificate Secret.
+func defaultCertificateReferenceGrant(fromNamespace string, defaultCertificate types.NamespacedName) gatewayv1beta1.ReferenceGrant {
+	referenceGrant := gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("from-%s-to-secret-%s", fromNamespace, defaultCertificate.Name),
+			Namespace: defaultCertificate.Namespace,
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group:     gatewayv1.Group(common.GatewayGVK.Group),
+				Kind:      gatewayv1.Kind(common.GatewayGVK.Kind),
+				Namespace: gatewayv1.Namespace(fromNamespace),
+			}},
+			To: []gatewayv1beta1.ReferenceGrantTo{{
+				Kind: "Secret",
+				Name: ptr.To(gatewayv1.ObjectName(defaultCertificate.Name)),
+			}},
+		},
+	}
+	referenceGrant.SetGroupVersionKind(common.ReferenceGrantGVK)
+	return referenceGrant
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestApplyDefaultCertificate(t *testing.T) {
+	tests := []struct {
+		name                  string
+		defaultCertificate    types.NamespacedName
+		certificateRefs       []gatewayv1.SecretObjectReference
+		expectedRefs          []gatewayv1.SecretObjectReference
+		expectListenerRemoved bool
+		expectReferenceGrant  bool
+	}{
+		{
+			name:            "listener with secret is untouched",
+			certificateRefs: []gatewayv1.SecretObjectReference{{Name: "cert"}},
+			expectedRefs:    []gatewayv1.SecretObjectReference{{Name: "cert"}},
+		},
+		{
+			name:                  "listener without secret is removed",
+			certificateRefs:       []gatewayv1.SecretObjectReference{{Name: ""}},
+			expectListenerRemoved: true,
+		},
+		{
+			name:            "empty ref is dropped when another secret is set",
+			certificateRefs: []gatewayv1.SecretObjectReference{{Name: ""}, {Name: "cert"}},
+			expectedRefs:    []gatewayv1.SecretObjectReference{{Name: "cert"}},
+		},
+		{
+			name:               "default certificate in the gateway namespace",
+			defaultCertificate: types.NamespacedName{Namespace: "default", Name: "default-cert"},
+			certificateRefs:    []gatewayv1.SecretObjectReference{{Name: ""}},
+			expectedRefs:       []gatewayv1.SecretObjectReference{{Name: "default-cert"}},
+		},
+		{
+			name:               "default certificate in another namespace",
+			defaultCertificate: types.NamespacedName{Namespace: "nginx-ingress", Name: "default-server-secret"},
+			certificateRefs:    []gatewayv1.SecretObjectReference{{Name: ""}},
+			expectedRefs: []gatewayv1.SecretObjectReference{
+				{Name: "default-server-secret", Namespace: ptr.To(gatewayv1.Namespace("nginx-ingress"))},
+			},
+			expectReferenceGrant: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+			ir := intermediate.IR{
+				Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+					gatewayKey: {Gateway: gatewayv1.Gateway{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+						Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+							{Name: "foo-com-http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+							{
+								Name: "foo-com-https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType,
+								TLS: &gatewayv1.GatewayTLSConfig{CertificateRefs: tt.certificateRefs},
+							},
+						}},
+					}},
+				},
+				ReferenceGrants: map[types.NamespacedName]gatewayv1beta1.ReferenceGrant{},
+			}
+
+			applyDefaultCertificate(&ir, tt.defaultCertificate)
+
+			listeners := ir.Gateways[gatewayKey].Spec.Listeners
+			if tt.expectListenerRemoved {
+				if len(listeners) != 1 || listeners[0].Name != "foo-com-http" {
+					t.Errorf("Expected only the HTTP listener to remain, got %v", listeners)
+				}
+				return
+			}
+			if len(listeners) != 2 {
+				t.Fatalf("Expected 2 listeners, got %d", len(listeners))
+			}
+			if !reflect.DeepEqual(listeners[1].TLS.CertificateRefs, tt.expectedRefs) {
+				t.Errorf("Expected certificateRefs %v, got %v", tt.expectedRefs, listeners[1].TLS.CertificateRefs)
+			}
+			if got := len(ir.ReferenceGrants) > 0; got != tt.expectReferenceGrant {
+				t.Errorf("Expected ReferenceGrant generated to be %t, got %t", tt.expectReferenceGrant, got)
+			}
+		})
+	}
+}
+
+func TestParseDefaultCertificate(t *testing.T) {
+	if _, errs := parseDefaultCertificate(map[string]string{DefaultCertificateFlag: "no-namespace"}); len(errs) == 0 {
+		t.Errorf("Expected an error for a value without namespace")
+	}
+	got, errs := parseDefaultCertificate(map[string]string{DefaultCertificateFlag: "nginx-ingress/default-server-secret"})
+	if len(errs) > 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+	if expected := (types.NamespacedName{Namespace: "nginx-ingress", Name: "default-server-secret"}); got != expected {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
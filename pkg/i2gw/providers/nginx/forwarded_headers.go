@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// ForwardedHeadersFlag enables the capture of the X-Forwarded-* headers NGINX
+// Ingress Controller sets on the requests to the backends.
+const ForwardedHeadersFlag = "forwarded-headers"
+
+// The headers NGINX Ingress Controller sets on every request to the backends.
+const (
+	forwardedForHeader   = "X-Forwarded-For"
+	forwardedHostHeader  = "X-Forwarded-Host"
+	forwardedPortHeader  = "X-Forwarded-Port"
+	forwardedProtoHeader = "X-Forwarded-Proto"
+	realIPHeader         = "X-Real-IP"
+)
+
+// applyForwardedHeaders sets the forwarded headers NGINX Ingress Controller sets
+// on the requests to the backends with a RequestHeaderModifier filter on the
+// rules of the HTTPRoutes, when their value is static for the route:
+// X-Forwarded-Proto and X-Forwarded-Port when all the listeners the route is
+// attached to share their protocol and port, X-Forwarded-Host when the route has
+// a single hostname without wildcard. X-Forwarded-For, X-Real-IP and the headers
+// depending on the request are reported, for the Gateway implementation to be
+// configured to set them. Headers the rules already set are kept. Targets which
+// set the forwarded headers themselves get no filter.
+func applyForwardedHeaders(ir *intermediate.IR, profile targetProfile) {
+	if profile.setsForwardedHeaders {
+		notify(notifications.InfoNotification, fmt.Sprintf("%s sets the %s headers on the requests to the backends, as NGINX Ingress Controller does",
+			profile.implementation, strings.Join([]string{forwardedForHeader, forwardedHostHeader, forwardedPortHeader, forwardedProtoHeader, realIPHeader}, ", ")))
+		return
+	}
+
+	for _, key := range sortedKeys(ir.HTTPRoutes) {
+		httpRouteContext := ir.HTTPRoutes[key]
+		headers := staticForwardedHeaders(ir, key, httpRouteContext.HTTPRoute)
+
+		changed := false
+		for i := range httpRouteContext.Spec.Rules {
+			rule := &httpRouteContext.Spec.Rules[i]
+			if len(rule.BackendRefs) == 0 || len(headers) == 0 {
+				continue
+			}
+			rule.Filters = setRequestHeaders(rule.Filters, headers)
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		ir.HTTPRoutes[key] = httpRouteContext
+
+		set := sets.New[string]()
+		for _, header := range headers {
+			set.Insert(string(header.Name))
+		}
+		var unset []string
+		for _, name := range []string{forwardedForHeader, forwardedHostHeader, forwardedPortHeader, forwardedProtoHeader, realIPHeader} {
+			if !set.Has(name) {
+				unset = append(unset, name)
+			}
+		}
+		notify(notifications.InfoNotification, fmt.Sprintf("HTTPRoute %s sets the static forwarded headers %s, the Gateway implementation must be configured to set %s, which depend on the request",
+			key, strings.Join(sets.List(set), ", "), strings.Join(unset, ", ")), &httpRouteContext.HTTPRoute)
+	}
+}
+
+// staticForwardedHeaders returns the forwarded headers whose value is the same
+// for every request of the route, sorted by name.
+func staticForwardedHeaders(ir *intermediate.IR, key types.NamespacedName, httpRoute gatewayv1.HTTPRoute) []gatewayv1.HTTPHeader {
+	var headers []gatewayv1.HTTPHeader
+	if len(httpRoute.Spec.Hostnames) == 1 && !strings.HasPrefix(string(httpRoute.Spec.Hostnames[0]), "*") {
+		headers = append(headers, gatewayv1.HTTPHeader{Name: forwardedHostHeader, Value: string(httpRoute.Spec.Hostnames[0])})
+	}
+
+	listeners := routeListeners(ir, key.Namespace, httpRoute)
+	if len(listeners) == 0 {
+		return headers
+	}
+	protocols := sets.New[gatewayv1.ProtocolType]()
+	ports := sets.New[gatewayv1.PortNumber]()
+	for _, listener := range listeners {
+		protocols.Insert(listener.Protocol)
+		ports.Insert(listener.Port)
+	}
+	if ports.Len() == 1 {
+		headers = append(headers, gatewayv1.HTTPHeader{Name: forwardedPortHeader, Value: strconv.Itoa(int(listeners[0].Port))})
+	}
+	if protocols.Len() == 1 {
+		headers = append(headers, gatewayv1.HTTPHeader{Name: forwardedProtoHeader, Value: strings.ToLower(string(listeners[0].Protocol))})
+	}
+	return headers
+}
+
+// routeListeners returns the HTTP and HTTPS listeners of the Gateways of the IR
+// the route is attached to: see parentRefListeners.
+func routeListeners(ir *intermediate.IR, namespace string, httpRoute gatewayv1.HTTPRoute) []gatewayv1.Listener {
+	var listeners []gatewayv1.Listener
+	for _, parentRef := range httpRoute.Spec.ParentRefs {
+		gatewayContext, ok := ir.Gateways[i2gw.ParentGatewayKey(namespace, parentRef)]
+		if !ok {
+			continue
+		}
+		for _, listener := range parentRefListeners(gatewayContext.Spec.Listeners, parentRef, httpRoute.Spec.Hostnames) {
+			if listener.Protocol == gatewayv1.HTTPProtocolType || listener.Protocol == gatewayv1.HTTPSProtocolType {
+				listeners = append(listeners, listener)
+			}
+		}
+	}
+	return listeners
+}
+
+// parentRefListeners returns the listeners a route with the hostnames is
+// attached to through the parentRef: the listener named by its sectionName, or
+// else the listeners accepting a hostname of the route.
+func parentRefListeners(listeners []gatewayv1.Listener, parentRef gatewayv1.ParentReference, hostnames []gatewayv1.Hostname) []gatewayv1.Listener {
+	var attached []gatewayv1.Listener
+	for _, listener := range listeners {
+		if parentRef.SectionName != nil {
+			if listener.Name == *parentRef.SectionName {
+				attached = append(attached, listener)
+			}
+			continue
+		}
+		if i2gw.ListenerAcceptsHostnames(listener, hostnames) {
+			attached = append(attached, listener)
+		}
+	}
+	return attached
+}
+
+// setRequestHeaders adds the headers to the RequestHeaderModifier filter of the
+// filters, which is added when there is none. Headers the filter already sets or
+// adds are kept.
+func setRequestHeaders(filters []gatewayv1.HTTPRouteFilter, headers []gatewayv1.HTTPHeader) []gatewayv1.HTTPRouteFilter {
+	for _, filter := range filters {
+		if filter.Type != gatewayv1.HTTPRouteFilterRequestHeaderModifier || filter.RequestHeaderModifier == nil {
+			continue
+		}
+		headers = slices.DeleteFunc(slices.Clone(headers), func(header gatewayv1.HTTPHeader) bool {
+			configured := func(h gatewayv1.HTTPHeader) bool { return strings.EqualFold(string(h.Name), string(header.Name)) }
+			return slices.ContainsFunc(filter.RequestHeaderModifier.Set, configured) || slices.ContainsFunc(filter.RequestHeaderModifier.Add, configured)
+		})
+	}
+	if len(headers) == 0 {
+		return filters
+	}
+	return common.AddHTTPFilter(filters, common.RequestHeaderModifierFilter(headers, nil, nil))
+}
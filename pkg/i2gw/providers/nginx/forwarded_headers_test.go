@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestForwardedHeaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		flags           map[string]string
+		annotations     map[string]string
+		tls             []networkingv1.IngressTLS
+		expectedHeaders []gatewayv1.HTTPHeader
+	}{
+		{
+			name: "disabled by default",
+		},
+		{
+			name:  "HTTP listener",
+			flags: map[string]string{ForwardedHeadersFlag: "true"},
+			expectedHeaders: []gatewayv1.HTTPHeader{
+				{Name: "X-Forwarded-Host", Value: "a.example.com"},
+				{Name: "X-Forwarded-Port", Value: "80"},
+				{Name: "X-Forwarded-Proto", Value: "http"},
+			},
+		},
+		{
+			name:            "HTTP and HTTPS listeners",
+			flags:           map[string]string{ForwardedHeadersFlag: "true"},
+			tls:             []networkingv1.IngressTLS{{Hosts: []string{"a.example.com"}, SecretName: "a"}},
+			expectedHeaders: []gatewayv1.HTTPHeader{{Name: "X-Forwarded-Host", Value: "a.example.com"}},
+		},
+		{
+			name:        "headers set by the annotations are kept",
+			flags:       map[string]string{ForwardedHeadersFlag: "true"},
+			annotations: map[string]string{"nginx.org/proxy-set-headers": "X-Forwarded-Proto: https"},
+			expectedHeaders: []gatewayv1.HTTPHeader{
+				{Name: "X-Forwarded-Host", Value: "a.example.com"},
+				{Name: "X-Forwarded-Port", Value: "80"},
+				{Name: "X-Forwarded-Proto", Value: "https"},
+			},
+		},
+		{
+			name:  "set by the target implementation",
+			flags: map[string]string{ForwardedHeadersFlag: "true", TargetImplementationFlag: nginxGatewayFabricProfile},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					TLS:              tt.tls,
+					Rules:            []networkingv1.IngressRule{ingressRule("a.example.com")},
+				},
+			}
+
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{ProviderSpecificFlags: map[string]map[string]string{Name: tt.flags}})
+			ir, errs := converter.convert(&storage{Ingresses: map[types.NamespacedName]*networkingv1.Ingress{
+				{Namespace: "default", Name: "app"}: &ingress,
+			}})
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var headers []gatewayv1.HTTPHeader
+			for _, filter := range ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "app-a-example-com"}].Spec.Rules[0].Filters {
+				if filter.Type == gatewayv1.HTTPRouteFilterRequestHeaderModifier {
+					headers = append(headers, filter.RequestHeaderModifier.Set...)
+				}
+			}
+			if diff := cmp.Diff(tt.expectedHeaders, headers); diff != "" {
+				t.Errorf("Unexpected request headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
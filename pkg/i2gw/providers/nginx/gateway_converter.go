@@ -17,19 +17,31 @@ limitations under the License.
 package nginx
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
 // gatewayResourcesConverter converts intermediate representation to Gateway API resources with NGINX-specific extensions
-type gatewayResourcesConverter struct{}
+type gatewayResourcesConverter struct {
+	targetImplementation string
+}
 
 // newGatewayResourcesConverter creates a new gateway resources converter
-func newGatewayResourcesConverter() *gatewayResourcesConverter {
-	return &gatewayResourcesConverter{}
+func newGatewayResourcesConverter(conf *i2gw.ProviderConf) *gatewayResourcesConverter {
+	return &gatewayResourcesConverter{
+		targetImplementation: conf.ProviderSpecificFlags[Name][TargetImplementationFlag],
+	}
 }
 
 // convert converts IR to Gateway API resources including NGINX Gateway Fabric custom policies
@@ -39,5 +51,146 @@ func (c *gatewayResourcesConverter) convert(ir intermediate.IR) (i2gw.GatewayRes
 	if len(errs) != 0 {
 		return i2gw.GatewayResources{}, errs
 	}
+	reportProxyBuffering(ir)
+	gatewayResources.GatewayExtensions = append(gatewayResources.GatewayExtensions, serviceAppProtocolPatches(ir)...)
+
+	profile, err := parseTargetImplementation(c.targetImplementation)
+	if err != nil {
+		return i2gw.GatewayResources{}, field.ErrorList{err}
+	}
+	reportScale(gatewayResources, profile)
+	reportRegularExpressionMatches(gatewayResources, profile)
+	reportLoadBalancingMethods(ir, profile)
+	reportConnectionReuse(ir, profile)
+	reportRetryPolicies(ir)
 	return gatewayResources, errs
 }
+
+// reportProxyBuffering reports the proxy buffering settings captured in the IR.
+// Neither Gateway API nor the NGINX Gateway Fabric ClientSettingsPolicy and
+// UpstreamSettingsPolicy can express them, so they are listed for the data plane
+// to be configured manually.
+func reportProxyBuffering(ir intermediate.IR) {
+	serviceKeys := make([]types.NamespacedName, 0, len(ir.Services))
+	for key, serviceIR := range ir.Services {
+		if serviceIR.Nginx != nil && serviceIR.Nginx.ProxyBuffering != nil {
+			serviceKeys = append(serviceKeys, key)
+		}
+	}
+	slices.SortFunc(serviceKeys, func(a, b types.NamespacedName) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+
+	for _, key := range serviceKeys {
+		config := ir.Services[key].Nginx.ProxyBuffering
+		var settings []string
+		if config.Enabled != nil {
+			buffering := "off"
+			if *config.Enabled {
+				buffering = "on"
+			}
+			settings = append(settings, "proxy_buffering "+buffering)
+		}
+		if config.Buffers != "" {
+			settings = append(settings, fmt.Sprintf("proxy_buffers %s", config.Buffers))
+		}
+		if config.BufferSize != "" {
+			settings = append(settings, fmt.Sprintf("proxy_buffer_size %s", config.BufferSize))
+		}
+		notify(notifications.WarningNotification, fmt.Sprintf("Service %s uses %s, which has no Gateway API or NGINX Gateway Fabric policy equivalent and must be configured on the data plane",
+			key, strings.Join(settings, ", ")))
+	}
+}
+
+// reportLoadBalancingMethods reports the load balancing methods captured in the IR
+// which the target implementation does not use.
+func reportLoadBalancingMethods(ir intermediate.IR, profile targetProfile) {
+	for _, key := range sortedKeys(ir.Services) {
+		serviceIR := ir.Services[key]
+		if serviceIR.Nginx == nil || serviceIR.Nginx.LoadBalancingMethod == "" {
+			continue
+		}
+		method := serviceIR.Nginx.LoadBalancingMethod
+		if profile.defaultLoadBalancingMethod == "" {
+			notify(notifications.WarningNotification, fmt.Sprintf("Service %s uses the %s load balancing method, which Gateway API cannot express, configure it with the policies of the Gateway implementation",
+				key, method))
+			continue
+		}
+		if method != profile.defaultLoadBalancingMethod {
+			notify(notifications.WarningNotification, fmt.Sprintf("Service %s uses the %s load balancing method, but %s balances with %s",
+				key, method, profile.implementation, profile.defaultLoadBalancingMethod))
+		}
+	}
+}
+
+// reportConnectionReuse reports the upstream connection settings captured in the
+// IR according to the target implementation. Keepalive connections are a tuning
+// the implementation may not expose. NTLM authenticates connections instead of
+// requests, so every client connection must keep its own upstream connection:
+// no target provides that affinity, and such Services are reported as blockers.
+func reportConnectionReuse(ir intermediate.IR, profile targetProfile) {
+	for _, key := range sortedKeys(ir.Services) {
+		serviceIR := ir.Services[key]
+		if serviceIR.Nginx == nil {
+			continue
+		}
+		if keepalive := serviceIR.Nginx.Keepalive; keepalive > 0 {
+			if profile.keepaliveSetting == "" {
+				notify(notifications.WarningNotification, fmt.Sprintf("Service %s keeps up to %d idle connections to its upstream servers for reuse, which Gateway API cannot express, check the connection reuse of the Gateway implementation",
+					key, keepalive))
+			} else {
+				notify(notifications.WarningNotification, fmt.Sprintf("Service %s keeps up to %d idle connections to its upstream servers for reuse, set it with %s targeting the Service",
+					key, keepalive, profile.keepaliveSetting))
+			}
+		}
+		if serviceIR.Nginx.NTLM {
+			reason := "Gateway API has no affinity between the client and the upstream connections"
+			if profile.implementation != "" {
+				reason = fmt.Sprintf("%s does not support NTLM upstreams", profile.implementation)
+			}
+			notify(notifications.BlockerNotification, fmt.Sprintf("Service %s requires NTLM authentication, which needs every client connection to keep its own upstream connection, but %s. Migrate the Service to another authentication scheme, or to an implementation pinning the connections",
+				key, reason))
+		}
+	}
+}
+
+// reportRetryPolicies reports the retry policies captured in the IR in terms of the
+// Gateway API retry semantics. The Gateway API version generated by the tool has
+// no retry field on HTTPRoute rules, so they are listed for the retries to be
+// configured with the policies of the Gateway implementation.
+func reportRetryPolicies(ir intermediate.IR) {
+	for _, key := range sortedKeys(ir.Services) {
+		serviceIR := ir.Services[key]
+		if serviceIR.Nginx == nil || serviceIR.Nginx.Retry == nil {
+			continue
+		}
+		notify(notifications.WarningNotification, fmt.Sprintf("Service %s retries %s, which the generated HTTPRoutes cannot express, configure it with the retry policy of the Gateway implementation",
+			key, describeRetryPolicy(*serviceIR.Nginx.Retry)))
+	}
+}
+
+// describeRetryPolicy describes the retry policy, e.g. "on status codes 502, 503
+// and on error, timeout, with up to 2 attempts and a per-try timeout of 10s".
+func describeRetryPolicy(retry intermediate.RetryPolicy) string {
+	var on []string
+	if len(retry.Codes) > 0 {
+		codes := make([]string, 0, len(retry.Codes))
+		for _, code := range retry.Codes {
+			codes = append(codes, strconv.Itoa(code))
+		}
+		on = append(on, "on status codes "+strings.Join(codes, ", "))
+	}
+	if len(retry.Conditions) > 0 {
+		on = append(on, "on "+strings.Join(retry.Conditions, ", "))
+	}
+
+	attempts := "unlimited attempts"
+	if retry.Attempts != nil {
+		attempts = fmt.Sprintf("up to %d attempts", *retry.Attempts)
+	}
+	description := fmt.Sprintf("%s, with %s", strings.Join(on, " and "), attempts)
+	if retry.PerTryTimeout != "" {
+		description = fmt.Sprintf("%s and a per-try timeout of %s", description, retry.PerTryTimeout)
+	}
+	return description
+}
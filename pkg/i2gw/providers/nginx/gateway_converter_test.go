@@ -18,6 +18,13 @@ package nginx
 
 import (
 	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 )
 
 func TestNewGatewayResourcesConverter(t *testing.T) {
@@ -32,9 +39,33 @@ func TestNewGatewayResourcesConverter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := newGatewayResourcesConverter(); got == nil {
-				t.Errorf("newGatewayResourcesConverter() = %v, want non-nil", got)
+			if got := newGatewayResourcesConverter(&i2gw.ProviderConf{}); got == nil {
+				t.Errorf("newGatewayResourcesConverter(&i2gw.ProviderConf{}) = %v, want non-nil", got)
 			}
 		})
 	}
 }
+
+func TestReportRetryPolicies(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	ir := intermediate.IR{Services: map[types.NamespacedName]intermediate.ProviderSpecificServiceIR{
+		{Namespace: "default", Name: "web"}: {Nginx: &intermediate.NginxServiceIR{Retry: &intermediate.RetryPolicy{
+			Codes:         []int{502, 503},
+			Conditions:    []string{"error", "timeout"},
+			Attempts:      ptr.To(2),
+			PerTryTimeout: "10s",
+		}}},
+		{Namespace: "default", Name: "no-retry"}: {Nginx: &intermediate.NginxServiceIR{LoadBalancingMethod: "ip_hash"}},
+	}}
+
+	reportRetryPolicies(ir)
+
+	reported := notifications.NotificationAggr.Notifications[Name]
+	if len(reported) != 1 {
+		t.Fatalf("Expected 1 warning, got %d", len(reported))
+	}
+	expected := "Service default/web retries on status codes 502, 503 and on error, timeout, with up to 2 attempts and a per-try timeout of 10s, which the generated HTTPRoutes cannot express, configure it with the retry policy of the Gateway implementation"
+	if reported[0].Message != expected {
+		t.Errorf("Expected warning %q, got %q", expected, reported[0].Message)
+	}
+}
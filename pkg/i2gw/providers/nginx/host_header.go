@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/annotations"
+)
+
+// HostHeaderFlag selects the Host header of the requests to the backends of the
+// Ingresses which do not set it with proxy_set_header.
+const HostHeaderFlag = "host-header"
+
+// parseHostHeader validates the Host header flag.
+func parseHostHeader(value string) (string, field.ErrorList) {
+	if value == "" {
+		return annotations.PreserveHostHeader, nil
+	}
+	if !slices.Contains(annotations.HostHeaders, value) {
+		return "", field.ErrorList{field.NotSupported(field.NewPath(HostHeaderFlag), value, annotations.HostHeaders)}
+	}
+	return value, nil
+}
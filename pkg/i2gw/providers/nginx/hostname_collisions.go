@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+const (
+	// NamespacePrecedenceFlag is a comma-separated list of namespaces, from highest
+	// to lowest precedence, used to resolve hostname collisions across namespaces.
+	NamespacePrecedenceFlag = "namespace-precedence"
+
+	// FailOnHostnameCollisionFlag makes the conversion fail when a hostname
+	// collision across namespaces cannot be resolved.
+	FailOnHostnameCollisionFlag = "fail-on-hostname-collision"
+)
+
+// hostnamePort identifies the listeners which collide when their Gateways are
+// served behind the same address.
+type hostnamePort struct {
+	hostname gatewayv1.Hostname
+	port     gatewayv1.PortNumber
+}
+
+// resolveHostnameCollisions detects Gateways of different namespaces with listeners
+// for the same hostname and port. NGINX Ingress Controller serves all of them from a
+// single address, so only one of them can win. When the namespace precedence ranks
+// one of the namespaces above the others, the listeners of the other namespaces are
+// removed. Remaining collisions are reported, or returned as errors when failOnCollision
+// is set.
+func resolveHostnameCollisions(ir *intermediate.IR, precedence []string, failOnCollision bool) field.ErrorList {
+	gatewaysByListener := map[hostnamePort][]types.NamespacedName{}
+	for key, gatewayContext := range ir.Gateways {
+		for _, listener := range gatewayContext.Spec.Listeners {
+			if listener.Hostname == nil || *listener.Hostname == "" {
+				continue
+			}
+			hp := hostnamePort{hostname: *listener.Hostname, port: listener.Port}
+			if !slices.Contains(gatewaysByListener[hp], key) {
+				gatewaysByListener[hp] = append(gatewaysByListener[hp], key)
+			}
+		}
+	}
+
+	collisions := make([]hostnamePort, 0, len(gatewaysByListener))
+	for hp, gateways := range gatewaysByListener {
+		if hasMultipleNamespaces(gateways) {
+			collisions = append(collisions, hp)
+		}
+	}
+	slices.SortFunc(collisions, func(a, b hostnamePort) int {
+		return cmp.Or(cmp.Compare(a.hostname, b.hostname), cmp.Compare(a.port, b.port))
+	})
+
+	var errs field.ErrorList
+	for _, hp := range collisions {
+		gateways := gatewaysByListener[hp]
+		slices.SortFunc(gateways, func(a, b types.NamespacedName) int {
+			return cmp.Or(cmp.Compare(namespaceRank(precedence, a.Namespace), namespaceRank(precedence, b.Namespace)), cmp.Compare(a.String(), b.String()))
+		})
+		gatewayNames := make([]string, 0, len(gateways))
+		for _, gateway := range gateways {
+			gatewayNames = append(gatewayNames, gateway.String())
+		}
+
+		winner := gateways[0]
+		runnerUp := gateways[slices.IndexFunc(gateways, func(gateway types.NamespacedName) bool { return gateway.Namespace != winner.Namespace })]
+		if namespaceRank(precedence, winner.Namespace) < namespaceRank(precedence, runnerUp.Namespace) {
+			for _, gateway := range gateways[1:] {
+				if gateway.Namespace != winner.Namespace {
+					removeListeners(ir, gateway, hp)
+				}
+			}
+			notify(notifications.WarningNotification, fmt.Sprintf("hostname %s on port %d is claimed by Gateways %s, the listeners of namespace %s take precedence and the others were removed",
+				hp.hostname, hp.port, strings.Join(gatewayNames, ", "), winner.Namespace))
+			continue
+		}
+
+		message := fmt.Sprintf("hostname %s on port %d is claimed by Gateways in different namespaces: %s. Use --%s-%s to declare which namespace takes precedence",
+			hp.hostname, hp.port, strings.Join(gatewayNames, ", "), Name, NamespacePrecedenceFlag)
+		if failOnCollision {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "listeners").Key(string(hp.hostname)), hp.port, message))
+			continue
+		}
+		notify(notifications.WarningNotification, message)
+	}
+	return errs
+}
+
+// parseNamespacePrecedence parses the comma-separated namespace precedence list.
+func parseNamespacePrecedence(value string) []string {
+	var precedence []string
+	for _, namespace := range strings.Split(value, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			precedence = append(precedence, namespace)
+		}
+	}
+	return precedence
+}
+
+func hasMultipleNamespaces(gateways []types.NamespacedName) bool {
+	for _, gateway := range gateways[1:] {
+		if gateway.Namespace != gateways[0].Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceRank returns the position of the namespace in the precedence list,
+// namespaces which are not listed rank last.
+func namespaceRank(precedence []string, namespace string) int {
+	if i := slices.Index(precedence, namespace); i >= 0 {
+		return i
+	}
+	return len(precedence)
+}
+
+func removeListeners(ir *intermediate.IR, key types.NamespacedName, hp hostnamePort) {
+	gatewayContext := ir.Gateways[key]
+	gatewayContext.Spec.Listeners = slices.DeleteFunc(gatewayContext.Spec.Listeners, func(listener gatewayv1.Listener) bool {
+		return listener.Hostname != nil && *listener.Hostname == hp.hostname && listener.Port == hp.port
+	})
+	ir.Gateways[key] = gatewayContext
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestResolveHostnameCollisions(t *testing.T) {
+	tests := []struct {
+		name              string
+		namespaces        []string
+		precedence        []string
+		failOnCollision   bool
+		expectErrors      bool
+		expectedListeners map[string]int
+	}{
+		{
+			name:              "no collision within a single namespace",
+			namespaces:        []string{"default"},
+			failOnCollision:   true,
+			expectedListeners: map[string]int{"default": 2},
+		},
+		{
+			name:              "collision is only reported by default",
+			namespaces:        []string{"team-a", "team-b"},
+			expectedListeners: map[string]int{"team-a": 2, "team-b": 2},
+		},
+		{
+			name:            "collision fails the run when requested",
+			namespaces:      []string{"team-a", "team-b"},
+			failOnCollision: true,
+			expectErrors:    true,
+		},
+		{
+			name:              "precedence keeps the listeners of the first namespace",
+			namespaces:        []string{"team-a", "team-b", "team-c"},
+			precedence:        []string{"team-b"},
+			failOnCollision:   true,
+			expectedListeners: map[string]int{"team-a": 0, "team-b": 2, "team-c": 0},
+		},
+		{
+			name:            "precedence between unlisted namespaces does not resolve the collision",
+			namespaces:      []string{"team-a", "team-b"},
+			precedence:      []string{"team-c"},
+			failOnCollision: true,
+			expectErrors:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ir := intermediate.IR{Gateways: map[types.NamespacedName]intermediate.GatewayContext{}}
+			for _, namespace := range tt.namespaces {
+				hostname := gatewayv1.Hostname("foo.example.com")
+				ir.Gateways[types.NamespacedName{Namespace: namespace, Name: "nginx"}] = intermediate.GatewayContext{Gateway: gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "nginx"},
+					Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+						{Name: "foo-example-com-http", Hostname: &hostname, Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+						{Name: "foo-example-com-https", Hostname: &hostname, Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+					}},
+				}}
+			}
+
+			errs := resolveHostnameCollisions(&ir, tt.precedence, tt.failOnCollision)
+			if tt.expectErrors {
+				if len(errs) != 2 {
+					t.Errorf("Expected an error per colliding port, got %v", errs)
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			for namespace, expected := range tt.expectedListeners {
+				if got := len(ir.Gateways[types.NamespacedName{Namespace: namespace, Name: "nginx"}].Spec.Listeners); got != expected {
+					t.Errorf("Expected Gateway in %s to have %d listeners, got %d", namespace, expected, got)
+				}
+			}
+		})
+	}
+}
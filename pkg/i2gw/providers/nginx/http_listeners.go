@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// HTTPListenerStrategyFlag selects how HTTP listeners are generated for the
+// hosts of the Ingress rules.
+const HTTPListenerStrategyFlag = "http-listener-strategy"
+
+const (
+	// perHostHTTPListeners generates an HTTP listener per host.
+	perHostHTTPListeners = "per-host"
+	// sharedHTTPListeners replaces the HTTP listeners of the hosts sharing a
+	// port with a single listener without hostname.
+	sharedHTTPListeners = "shared"
+)
+
+// parseHTTPListenerStrategy validates the HTTP listener strategy flag.
+func parseHTTPListenerStrategy(value string) (string, field.ErrorList) {
+	switch value {
+	case "":
+		return perHostHTTPListeners, nil
+	case perHostHTTPListeners, sharedHTTPListeners:
+		return value, nil
+	default:
+		return "", field.ErrorList{field.NotSupported(field.NewPath(HTTPListenerStrategyFlag), value, []string{perHostHTTPListeners, sharedHTTPListeners})}
+	}
+}
+
+// shareHTTPListeners replaces the HTTP listeners with a hostname of every Gateway
+// port shared by several hosts with a single listener without hostname. The
+// hostnames of the routes still select the requests they match. The parentRefs
+// of the routes attached to a replaced listener are updated to the shared one.
+func shareHTTPListeners(ir *intermediate.IR) {
+	for key, gatewayContext := range ir.Gateways {
+		byPort := map[gatewayv1.PortNumber][]int{}
+		hostless := map[gatewayv1.PortNumber]gatewayv1.SectionName{}
+		for i, listener := range gatewayContext.Spec.Listeners {
+			if listener.Protocol != gatewayv1.HTTPProtocolType {
+				continue
+			}
+			if listener.Hostname == nil || *listener.Hostname == "" {
+				hostless[listener.Port] = listener.Name
+				continue
+			}
+			byPort[listener.Port] = append(byPort[listener.Port], i)
+		}
+
+		ports := make([]gatewayv1.PortNumber, 0, len(byPort))
+		for port, indexes := range byPort {
+			// A single host gains nothing from a shared listener, unless one
+			// already exists.
+			if _, exists := hostless[port]; len(indexes) > 1 || exists {
+				ports = append(ports, port)
+			}
+		}
+		if len(ports) == 0 {
+			continue
+		}
+		slices.Sort(ports)
+
+		renamed := map[gatewayv1.SectionName]gatewayv1.SectionName{}
+		removed := map[int]bool{}
+		var sharedListeners []gatewayv1.Listener
+		for _, port := range ports {
+			name, exists := hostless[port]
+			if !exists {
+				name = common.SharedListenerName(gatewayv1.HTTPProtocolType, port)
+				sharedListeners = append(sharedListeners, gatewayv1.Listener{
+					Name:     name,
+					Port:     port,
+					Protocol: gatewayv1.HTTPProtocolType,
+				})
+			}
+			for _, i := range byPort[port] {
+				removed[i] = true
+				renamed[gatewayContext.Spec.Listeners[i].Name] = name
+			}
+			notify(notifications.InfoNotification, fmt.Sprintf("The HTTP listeners of %d hosts on port %d were replaced with the %s listener without hostname", len(byPort[port]), port, name), &gatewayContext.Gateway)
+		}
+
+		listeners := make([]gatewayv1.Listener, 0, len(gatewayContext.Spec.Listeners)-len(removed)+len(sharedListeners))
+		for i, listener := range gatewayContext.Spec.Listeners {
+			if !removed[i] {
+				listeners = append(listeners, listener)
+			}
+		}
+		gatewayContext.Spec.Listeners = append(listeners, sharedListeners...)
+		ir.Gateways[key] = gatewayContext
+
+		renameParentRefSections(ir, key, renamed)
+	}
+}
+
+// renameParentRefSections updates the sectionName of the route parentRefs to
+// the Gateway whose listeners were renamed.
+func renameParentRefSections(ir *intermediate.IR, gateway types.NamespacedName, renamed map[gatewayv1.SectionName]gatewayv1.SectionName) {
+	rename := func(routeNamespace string, parentRefs []gatewayv1.ParentReference) {
+		for i, parentRef := range parentRefs {
+			namespace := routeNamespace
+			if parentRef.Namespace != nil {
+				namespace = string(*parentRef.Namespace)
+			}
+			if string(parentRef.Name) != gateway.Name || namespace != gateway.Namespace || parentRef.SectionName == nil {
+				continue
+			}
+			if name, ok := renamed[*parentRef.SectionName]; ok {
+				parentRefs[i].SectionName = &name
+			}
+		}
+	}
+
+	for key, httpRouteContext := range ir.HTTPRoutes {
+		rename(key.Namespace, httpRouteContext.Spec.ParentRefs)
+	}
+	for key, grpcRoute := range ir.GRPCRoutes {
+		rename(key.Namespace, grpcRoute.Spec.ParentRefs)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestHTTPListenerStrategy(t *testing.T) {
+	tests := []struct {
+		name            string
+		strategy        string
+		expectErrors    bool
+		expectedHTTP    []string
+		expectedSection gatewayv1.SectionName
+	}{
+		{
+			name:            "per-host listeners by default",
+			expectedHTTP:    []string{"a-example-com-http", "b-example-com-http", "c-example-com-http"},
+			expectedSection: "a-example-com-http",
+		},
+		{
+			name:            "shared listener for hosts sharing a port",
+			strategy:        sharedHTTPListeners,
+			expectedHTTP:    []string{"http"},
+			expectedSection: "http",
+		},
+		{
+			name:         "unknown strategy",
+			strategy:     "single",
+			expectErrors: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "app",
+					Namespace:   "default",
+					Annotations: map[string]string{"nginx.org/redirect-to-https": "true"},
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					Rules: []networkingv1.IngressRule{
+						ingressRule("a.example.com"),
+						ingressRule("b.example.com"),
+						ingressRule("c.example.com"),
+					},
+				},
+			}
+
+			flags := map[string]map[string]string{Name: {HTTPListenerStrategyFlag: tt.strategy}}
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{ProviderSpecificFlags: flags})
+			ir, errs := converter.convert(&storage{Ingresses: map[types.NamespacedName]*networkingv1.Ingress{
+				{Namespace: "default", Name: "app"}: &ingress,
+			}})
+			if tt.expectErrors {
+				if len(errs) == 0 {
+					t.Errorf("Expected errors, got none")
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var http []string
+			for _, listener := range ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Spec.Listeners {
+				if listener.Protocol != gatewayv1.HTTPProtocolType {
+					continue
+				}
+				http = append(http, string(listener.Name))
+				if tt.strategy == sharedHTTPListeners && (listener.Hostname != nil || listener.Port != 80) {
+					t.Errorf("Expected the shared listener on port 80 without hostname, got %+v", listener)
+				}
+			}
+			if !reflect.DeepEqual(http, tt.expectedHTTP) {
+				t.Errorf("Expected HTTP listeners %v, got %v", tt.expectedHTTP, http)
+			}
+
+			// The SSL redirect attaches the route to the HTTP listener of its host.
+			httpRoute := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "app-a-example-com"}]
+			if len(httpRoute.Spec.ParentRefs) != 1 || httpRoute.Spec.ParentRefs[0].SectionName == nil || *httpRoute.Spec.ParentRefs[0].SectionName != tt.expectedSection {
+				t.Errorf("Expected the parentRef to target the %s listener, got %+v", tt.expectedSection, httpRoute.Spec.ParentRefs)
+			}
+		})
+	}
+}
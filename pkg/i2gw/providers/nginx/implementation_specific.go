@@ -0,0 +1,30 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// implementationSpecificHTTPPathTypeMatch maps the Implementation Specific
+// HTTP path type to PathPrefix, as NGINX Ingress Controller treats such paths
+// as prefixes. Ingresses with the nginx.org/path-regex annotation are converted
+// to regular expressions afterwards by the PathRegexFeature.
+func implementationSpecificHTTPPathTypeMatch(path *gatewayv1.HTTPPathMatch) {
+	pmPrefix := gatewayv1.PathMatchPathPrefix
+	path.Type = &pmPrefix
+}
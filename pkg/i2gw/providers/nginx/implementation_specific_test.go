@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+)
+
+func TestImplementationSpecificPathConversion(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		expectedType gatewayv1.PathMatchType
+	}{
+		{
+			name:         "prefix by default",
+			expectedType: gatewayv1.PathMatchPathPrefix,
+		},
+		{
+			name:         "regular expression with path-regex",
+			annotations:  map[string]string{"nginx.org/path-regex": "true"},
+			expectedType: gatewayv1.PathMatchRegularExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: tt.annotations},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					Rules: []networkingv1.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{
+								Path:     "/api",
+								PathType: ptr.To(networkingv1.PathTypeImplementationSpecific),
+								Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+									Name: "api", Port: networkingv1.ServiceBackendPort{Number: 80},
+								}},
+							}},
+						}},
+					}},
+				},
+			}
+			storage := newResourceStorage()
+			storage.Ingresses[types.NamespacedName{Namespace: "default", Name: "app"}] = ingress
+
+			ir, errs := newResourcesToIRConverter(&i2gw.ProviderConf{}).convert(storage)
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+			httpRoute := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "app-example-com"}]
+			if len(httpRoute.Spec.Rules) != 1 || len(httpRoute.Spec.Rules[0].Matches) != 1 {
+				t.Fatalf("Expected a single match, got %+v", httpRoute.Spec.Rules)
+			}
+			if got := *httpRoute.Spec.Rules[0].Matches[0].Path.Type; got != tt.expectedType {
+				t.Errorf("Expected path type %s, got %s", tt.expectedType, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// auditListenerPairing checks the listeners the HTTPS redirects of the HTTPRoutes
+// rely on, once every listener transformation is done. A redirected host needs
+// the HTTP listener its route is attached to, and an HTTPS listener to receive
+// the redirected requests. An HTTPS listener generated for the redirect of a host
+// without Ingress tls entry references a Secret which may not exist, which is
+// reported as well, and so is every HTTPS listener no route is attached to, e.g.
+// when the routes of a redirected host are only attached to its HTTP listener.
+func auditListenerPairing(ingresses []networkingv1.Ingress, ir *intermediate.IR) {
+	tlsHosts := map[string]sets.Set[string]{}
+	for _, ingress := range ingresses {
+		for _, tls := range ingress.Spec.TLS {
+			if tlsHosts[ingress.Namespace] == nil {
+				tlsHosts[ingress.Namespace] = sets.New[string]()
+			}
+			tlsHosts[ingress.Namespace].Insert(tls.Hosts...)
+		}
+	}
+
+	for routeKey, httpRouteContext := range ir.HTTPRoutes {
+		httpRoute := &httpRouteContext.HTTPRoute
+		if !redirectsToHTTPS(httpRoute.Spec.Rules) {
+			continue
+		}
+
+		for _, parentRef := range httpRoute.Spec.ParentRefs {
+			gatewayKey := types.NamespacedName{Namespace: routeKey.Namespace, Name: string(parentRef.Name)}
+			if parentRef.Namespace != nil {
+				gatewayKey.Namespace = string(*parentRef.Namespace)
+			}
+			gatewayContext, ok := ir.Gateways[gatewayKey]
+			if !ok {
+				continue
+			}
+			listeners := gatewayContext.Spec.Listeners
+
+			if parentRef.SectionName != nil && !hasHTTPListener(listeners, *parentRef.SectionName) {
+				notify(notifications.WarningNotification, fmt.Sprintf("the HTTPS redirect is attached to %s, which is not an HTTP listener of Gateway %s", *parentRef.SectionName, gatewayKey), httpRoute)
+			}
+
+			for _, hostname := range httpRoute.Spec.Hostnames {
+				host := string(hostname)
+				listener := httpsListenerFor(listeners, host)
+				switch {
+				case listener == nil:
+					notify(notifications.WarningNotification, fmt.Sprintf("host %s is redirected to HTTPS, but Gateway %s has no HTTPS listener for it", host, gatewayKey), httpRoute)
+				case !tlsHosts[routeKey.Namespace].Has(host) && listener.Hostname != nil && string(*listener.Hostname) == host:
+					notify(notifications.WarningNotification, fmt.Sprintf("listener %s was generated for the HTTPS redirect of host %s, which has no Ingress tls entry: "+
+						"provide the Secret it references, or remove the listener if TLS is terminated in front of the Gateway", listener.Name, host), &gatewayContext.Gateway)
+				}
+			}
+		}
+	}
+
+	reportUnreachedHTTPSListeners(ir)
+}
+
+// reportUnreachedHTTPSListeners warns about the HTTPS listeners of the Gateways
+// no parentRef of the HTTPRoutes and GRPCRoutes reaches: a parentRef with a
+// sectionName reaches the listener of that name, one without sectionName every
+// listener accepting a hostname of the route.
+func reportUnreachedHTTPSListeners(ir *intermediate.IR) {
+	reached := map[types.NamespacedName]sets.Set[gatewayv1.SectionName]{}
+	attach := func(namespace string, hostnames []gatewayv1.Hostname, parentRefs []gatewayv1.ParentReference) {
+		for _, parentRef := range parentRefs {
+			gatewayKey := i2gw.ParentGatewayKey(namespace, parentRef)
+			gatewayContext, ok := ir.Gateways[gatewayKey]
+			if !ok {
+				continue
+			}
+			if reached[gatewayKey] == nil {
+				reached[gatewayKey] = sets.New[gatewayv1.SectionName]()
+			}
+			for _, listener := range gatewayContext.Spec.Listeners {
+				if parentRef.SectionName != nil {
+					if listener.Name == *parentRef.SectionName {
+						reached[gatewayKey].Insert(listener.Name)
+					}
+					continue
+				}
+				if i2gw.ListenerAcceptsHostnames(listener, hostnames) {
+					reached[gatewayKey].Insert(listener.Name)
+				}
+			}
+		}
+	}
+	for routeKey, httpRouteContext := range ir.HTTPRoutes {
+		attach(routeKey.Namespace, httpRouteContext.Spec.Hostnames, httpRouteContext.Spec.ParentRefs)
+	}
+	for routeKey, grpcRoute := range ir.GRPCRoutes {
+		attach(routeKey.Namespace, grpcRoute.Spec.Hostnames, grpcRoute.Spec.ParentRefs)
+	}
+
+	for gatewayKey, gatewayContext := range ir.Gateways {
+		for _, listener := range gatewayContext.Spec.Listeners {
+			if listener.Protocol != gatewayv1.HTTPSProtocolType || reached[gatewayKey].Has(listener.Name) {
+				continue
+			}
+			notify(notifications.WarningNotification, fmt.Sprintf("no route is attached to the HTTPS listener %s of Gateway %s, the HTTPS requests it receives are not served", listener.Name, gatewayKey), &gatewayContext.Gateway)
+		}
+	}
+}
+
+// redirectsToHTTPS reports whether any rule redirects the requests to HTTPS.
+func redirectsToHTTPS(rules []gatewayv1.HTTPRouteRule) bool {
+	for _, rule := range rules {
+		for _, filter := range rule.Filters {
+			if filter.Type == gatewayv1.HTTPRouteFilterRequestRedirect && filter.RequestRedirect != nil &&
+				filter.RequestRedirect.Scheme != nil && *filter.RequestRedirect.Scheme == "https" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasHTTPListener reports whether the listener of the given name exists and
+// accepts HTTP.
+func hasHTTPListener(listeners []gatewayv1.Listener, name gatewayv1.SectionName) bool {
+	for _, listener := range listeners {
+		if listener.Name == name {
+			return listener.Protocol == gatewayv1.HTTPProtocolType
+		}
+	}
+	return false
+}
+
+// httpsListenerFor returns the HTTPS listener accepting the host: a listener for
+// the host, for a wildcard matching it, or without hostname.
+func httpsListenerFor(listeners []gatewayv1.Listener, host string) *gatewayv1.Listener {
+	for i, listener := range listeners {
+		if listener.Protocol != gatewayv1.HTTPSProtocolType {
+			continue
+		}
+		if listener.Hostname == nil || *listener.Hostname == "" {
+			return &listeners[i]
+		}
+		hostname := string(*listener.Hostname)
+		if hostname == host || (strings.HasPrefix(hostname, "*.") && strings.HasSuffix(host, hostname[1:])) {
+			return &listeners[i]
+		}
+	}
+	return nil
+}
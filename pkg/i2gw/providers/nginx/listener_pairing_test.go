@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestListenerPairing(t *testing.T) {
+	tests := []struct {
+		name              string
+		tls               []networkingv1.IngressTLS
+		redirect          bool
+		expectedListeners []string
+		// expectedSections are the sectionNames of the parentRefs of the
+		// HTTPRoute, empty for a parentRef attached to every listener.
+		expectedSections []string
+		expectedWarnings int
+	}{
+		{
+			name:              "no tls, no redirect",
+			expectedListeners: []string{"a-example-com-http HTTP []"},
+			expectedSections:  []string{""},
+		},
+		{
+			name:              "no tls, redirect",
+			redirect:          true,
+			expectedListeners: []string{"a-example-com-http HTTP []", "https-a-example-com HTTPS [a-example-com-tls]"},
+			expectedSections:  []string{"a-example-com-http"},
+			// The listener without tls entry, and no route attached to it.
+			expectedWarnings: 2,
+		},
+		{
+			name:              "tls, no redirect",
+			tls:               []networkingv1.IngressTLS{{Hosts: []string{"a.example.com"}, SecretName: "a"}},
+			expectedListeners: []string{"a-example-com-http HTTP []", "a-example-com-https HTTPS [a]"},
+			expectedSections:  []string{""},
+		},
+		{
+			name:              "tls, redirect",
+			tls:               []networkingv1.IngressTLS{{Hosts: []string{"a.example.com"}, SecretName: "a"}},
+			redirect:          true,
+			expectedListeners: []string{"a-example-com-http HTTP []", "a-example-com-https HTTPS [a]"},
+			expectedSections:  []string{"a-example-com-http"},
+			// The redirected route only reaches the HTTP listener.
+			expectedWarnings: 1,
+		},
+		{
+			name:              "tls on the default certificate, redirect",
+			tls:               []networkingv1.IngressTLS{{Hosts: []string{"a.example.com"}}},
+			redirect:          true,
+			expectedListeners: []string{"a-example-com-http HTTP []"},
+			expectedSections:  []string{"a-example-com-http"},
+			// The removal of the HTTPS listener, and the redirect left without it.
+			expectedWarnings: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					TLS:              tt.tls,
+					Rules:            []networkingv1.IngressRule{ingressRule("a.example.com")},
+				},
+			}
+			if tt.redirect {
+				ingress.Annotations = map[string]string{"nginx.org/redirect-to-https": "true"}
+			}
+
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{})
+			ir, errs := converter.convert(&storage{Ingresses: map[types.NamespacedName]*networkingv1.Ingress{
+				{Namespace: "default", Name: "app"}: &ingress,
+			}})
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var listeners []string
+			for _, listener := range ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Spec.Listeners {
+				var certificates []string
+				if listener.TLS != nil {
+					for _, ref := range listener.TLS.CertificateRefs {
+						certificates = append(certificates, string(ref.Name))
+					}
+				}
+				listeners = append(listeners, fmt.Sprintf("%s %s %v", listener.Name, listener.Protocol, certificates))
+			}
+			if !reflect.DeepEqual(listeners, tt.expectedListeners) {
+				t.Errorf("Expected listeners %v, got %v", tt.expectedListeners, listeners)
+			}
+
+			var sections []string
+			for _, parentRef := range ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "app-a-example-com"}].Spec.ParentRefs {
+				sections = append(sections, string(ptr.Deref(parentRef.SectionName, "")))
+			}
+			if !reflect.DeepEqual(sections, tt.expectedSections) {
+				t.Errorf("Expected the HTTPRoute attached to the sections %q, got %q", tt.expectedSections, sections)
+			}
+
+			warnings := 0
+			for _, notification := range notifications.NotificationAggr.Notifications[Name] {
+				if notification.Type == notifications.WarningNotification {
+					warnings++
+				}
+			}
+			if warnings != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %d: %v", tt.expectedWarnings, warnings, notifications.NotificationAggr.Notifications[Name])
+			}
+		})
+	}
+}
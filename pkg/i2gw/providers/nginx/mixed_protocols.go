@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// backendPort identifies the port of a Service backend. A zero port stands for a
+// backendRef without port.
+type backendPort struct {
+	service types.NamespacedName
+	port    gatewayv1.PortNumber
+}
+
+// reportMixedProtocolServices warns about the Services which are backends of both
+// GRPCRoutes and HTTPRoutes on the same port, e.g. a Service listed in
+// nginx.org/grpc-services by an Ingress and used as an HTTP or WebSocket backend by
+// another. The appProtocol of the port tells the Gateway which protocol to use
+// towards the backend, so either the gRPC or the HTTP routes get the wrong one.
+func reportMixedProtocolServices(ir intermediate.IR) {
+	grpcRoutes := map[backendPort]sets.Set[string]{}
+	for _, key := range sortedKeys(ir.GRPCRoutes) {
+		for _, rule := range ir.GRPCRoutes[key].Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				addBackendRoute(grpcRoutes, key, backendRef.BackendRef)
+			}
+		}
+	}
+	if len(grpcRoutes) == 0 {
+		return
+	}
+
+	httpRoutes := map[backendPort]sets.Set[string]{}
+	for _, key := range sortedKeys(ir.HTTPRoutes) {
+		for _, rule := range ir.HTTPRoutes[key].Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				addBackendRoute(httpRoutes, key, backendRef.BackendRef)
+			}
+		}
+	}
+
+	type routeNames struct{ grpc, http sets.Set[string] }
+	conflicts := map[types.NamespacedName]routeNames{}
+	for grpcPort, grpc := range grpcRoutes {
+		for httpPort, http := range httpRoutes {
+			if grpcPort.service != httpPort.service || (grpcPort.port != httpPort.port && grpcPort.port != 0 && httpPort.port != 0) {
+				continue
+			}
+			routes, ok := conflicts[grpcPort.service]
+			if !ok {
+				routes = routeNames{grpc: sets.New[string](), http: sets.New[string]()}
+				conflicts[grpcPort.service] = routes
+			}
+			routes.grpc.Insert(grpc.UnsortedList()...)
+			routes.http.Insert(http.UnsortedList()...)
+		}
+	}
+
+	for _, service := range sortedKeys(conflicts) {
+		routes := conflicts[service]
+		notify(notifications.WarningNotification, fmt.Sprintf("Service %s is a gRPC backend of GRPCRoutes %s and an HTTP backend of HTTPRoutes %s on the same port, "+
+			"but the appProtocol of the port selects a single protocol for both, use separate ports or Services", service,
+			strings.Join(sets.List(routes.grpc), ", "), strings.Join(sets.List(routes.http), ", ")))
+	}
+}
+
+// addBackendRoute records the route as a user of the Service port of the
+// backendRef.
+func addBackendRoute(routes map[backendPort]sets.Set[string], route types.NamespacedName, backendRef gatewayv1.BackendRef) {
+	if (backendRef.Group != nil && *backendRef.Group != "") || (backendRef.Kind != nil && *backendRef.Kind != "Service") {
+		return
+	}
+	key := backendPort{service: types.NamespacedName{Namespace: route.Namespace, Name: string(backendRef.Name)}}
+	if backendRef.Namespace != nil {
+		key.service.Namespace = string(*backendRef.Namespace)
+	}
+	if backendRef.Port != nil {
+		key.port = *backendRef.Port
+	}
+	if routes[key] == nil {
+		routes[key] = sets.New[string]()
+	}
+	routes[key].Insert(route.String())
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestMixedProtocolServices(t *testing.T) {
+	tests := []struct {
+		name             string
+		webPort          int32
+		expectedWarnings []string
+	}{
+		{
+			name:    "same port",
+			webPort: 80,
+			expectedWarnings: []string{"Service default/echo is a gRPC backend of GRPCRoutes default/grpc-grpc-example-com and an HTTP backend of HTTPRoutes default/web-web-example-com on the same port, " +
+				"but the appProtocol of the port selects a single protocol for both, use separate ports or Services"},
+		},
+		{
+			name:    "separate ports",
+			webPort: 8080,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			newIngress := func(name, host string, annotations map[string]string, port int32) *networkingv1.Ingress {
+				rule := ingressRule(host)
+				rule.HTTP.Paths[0].Backend.Service.Name = "echo"
+				rule.HTTP.Paths[0].Backend.Service.Port.Number = port
+				return &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+					Spec: networkingv1.IngressSpec{
+						IngressClassName: ptr.To("nginx"),
+						Rules:            []networkingv1.IngressRule{rule},
+					},
+				}
+			}
+
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{})
+			_, errs := converter.convert(&storage{Ingresses: map[types.NamespacedName]*networkingv1.Ingress{
+				{Namespace: "default", Name: "grpc"}: newIngress("grpc", "grpc.example.com", map[string]string{"nginx.org/grpc-services": "echo"}, 80),
+				{Namespace: "default", Name: "web"}:  newIngress("web", "web.example.com", map[string]string{"nginx.org/websocket-services": "echo"}, tt.webPort),
+			}})
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var warnings []string
+			for _, notification := range notifications.NotificationAggr.Notifications[Name] {
+				if strings.HasPrefix(notification.Message, "Service default/echo is a gRPC backend") {
+					warnings = append(warnings, notification.Message)
+				}
+			}
+			if !reflect.DeepEqual(warnings, tt.expectedWarnings) {
+				t.Errorf("Expected warnings %q, got %q", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+}
@@ -18,17 +18,123 @@ package nginx
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/annotations"
 )
 
 const Name = "nginx"
 
+// ConvertSnippetRedirectsFlag enables the conversion of server snippet locations
+// returning a redirect.
+const ConvertSnippetRedirectsFlag = "convert-snippet-redirects"
+
+// GRPCHeuristicsFlag enables the detection of gRPC backends of Ingresses without
+// the nginx.org/grpc-services annotation.
+const GRPCHeuristicsFlag = "grpc-heuristics"
+
 func init() {
 	i2gw.ProviderConstructorByName[Name] = NewProvider
+	i2gw.ProviderConfigParserByName[Name] = parseConfig
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         ConvertSnippetRedirectsFlag,
+		Description:  "If true, convert server snippet locations returning a 301 or 302 redirect to HTTPRoute rules with a RequestRedirect filter.",
+		DefaultValue: "false",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         GRPCHeuristicsFlag,
+		Description:  "If true, convert the backends of Ingresses without nginx.org/grpc-services to GRPCRoute rules when all their paths look like /package.Service/Method and they are served over HTTP/2, as told by a grpc, h2c or http2 port name or a snippet matching the gRPC Content-Type.",
+		DefaultValue: "false",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         ForwardedHeadersFlag,
+		Description:  "If true, set the X-Forwarded-Host, X-Forwarded-Port and X-Forwarded-Proto headers NGINX Ingress Controller sets on the requests to the backends with RequestHeaderModifier filters, when their value is static for the route, and report the others. Targets setting them themselves get no filter.",
+		DefaultValue: "false",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         HostHeaderFlag,
+		Description:  fmt.Sprintf("The Host header of the requests to the backends of the Ingresses which do not set it with proxy_set_header in their snippets, one of: %s, %s. With %s, the Host header of the client is forwarded, as NGINX Ingress Controller does. With %s, it is set to <service>.<namespace>.svc with RequestHeaderModifier filters.", annotations.PreserveHostHeader, annotations.UpstreamHostHeader, annotations.PreserveHostHeader, annotations.UpstreamHostHeader),
+		DefaultValue: annotations.PreserveHostHeader,
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:        DefaultCertificateFlag,
+		Description: "The Secret, formatted as namespace/name, used by HTTPS listeners converted from TLS configuration without a secret, e.g. the -wildcard-tls-secret or -default-server-tls-secret argument of NGINX Ingress Controller. Such listeners are removed when not set.",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         TLSListenerStrategyFlag,
+		Description:  fmt.Sprintf("How HTTPS listeners are generated for the hosts of the Ingress tls entries, one of: %s, %s. With %s, the listeners of sibling hosts sharing the same certificates are merged into a wildcard listener.", perHostTLSListeners, wildcardTLSListeners, wildcardTLSListeners),
+		DefaultValue: perHostTLSListeners,
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         HTTPListenerStrategyFlag,
+		Description:  fmt.Sprintf("How HTTP listeners are generated for the hosts of the Ingress rules, one of: %s, %s. With %s, the listeners of the hosts sharing a port are replaced with a single listener without hostname, the route hostnames selecting the requests.", perHostHTTPListeners, sharedHTTPListeners, sharedHTTPListeners),
+		DefaultValue: perHostHTTPListeners,
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         OmitRedirectListenersFlag,
+		Description:  "If true, omit the HTTP listeners every route attached to redirects to HTTPS, for the HTTP requests to be redirected in front of the Gateway, and attach the redirected routes to the HTTPS listeners without their redirect.",
+		DefaultValue: "false",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:        NamespacePrecedenceFlag,
+		Description: "Comma-separated list of namespaces, from highest to lowest precedence, used to resolve hostnames claimed by Gateways in different namespaces.",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         FailOnHostnameCollisionFlag,
+		Description:  "If true, fail the conversion when a hostname is claimed by Gateways in different namespaces and no precedence resolves it.",
+		DefaultValue: "false",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:        CanaryMigrationFlag,
+		Description: "Enable canary migration by splitting the traffic of every route between the converted backends and the NGINX Ingress Controller Service, formatted as namespace/name:port.",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         CanaryWeightFlag,
+		Description:  "Percentage of the traffic sent to the converted backends in canary migration mode.",
+		DefaultValue: strconv.Itoa(defaultCanaryWeight),
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:        ControllerServiceFlag,
+		Description: "The NGINX Ingress Controller Service, formatted as namespace/name, whose load balancer annotations (e.g. service.beta.kubernetes.io/aws-load-balancer-*, cloud.google.com/*) are copied to the infrastructure annotations of the Gateways.",
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         RejectedIngressesFlag,
+		Description:  fmt.Sprintf("What to do with the Ingresses NGINX Ingress Controller reported as rejected in their events, one of: %s, %s. With %s, they are not converted and reported as errors.", skipRejectedIngresses, convertRejectedIngresses, skipRejectedIngresses),
+		DefaultValue: skipRejectedIngresses,
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         VirtualServerPrecedenceFlag,
+		Description:  fmt.Sprintf("Which of an Ingress and a VirtualServer routing the same host the host is migrated from, one of: %s, %s. With %s, the rules of the Ingresses for the hosts of VirtualServers are not converted.", preferIngress, preferCRD, preferCRD),
+		DefaultValue: preferIngress,
+	})
+
+	i2gw.RegisterProviderSpecificFlag(Name, i2gw.ProviderSpecificFlag{
+		Name:         TargetImplementationFlag,
+		Description:  fmt.Sprintf("The Gateway API implementation targeted by the conversion, used to check the scale limits and defaults of the generated resources, one of: %s, %s.", gatewayAPIProfile, nginxGatewayFabricProfile),
+		DefaultValue: gatewayAPIProfile,
+	})
 }
 
 type Provider struct {
@@ -42,8 +148,8 @@ type Provider struct {
 func NewProvider(conf *i2gw.ProviderConf) i2gw.Provider {
 	return &Provider{
 		resourceReader:            newResourceReader(conf),
-		resourcesToIRConverter:    newResourcesToIRConverter(),
-		gatewayResourcesConverter: newGatewayResourcesConverter(),
+		resourcesToIRConverter:    newResourcesToIRConverter(conf),
+		gatewayResourcesConverter: newGatewayResourcesConverter(conf),
 	}
 }
 
@@ -67,6 +173,24 @@ func (p *Provider) ReadResourcesFromFile(_ context.Context, filename string) err
 	return nil
 }
 
+// ReadResourcesFromObjects reads resources from the decoded objects
+func (p *Provider) ReadResourcesFromObjects(_ context.Context, objects []*unstructured.Unstructured) error {
+	storage, err := readResourcesFromObjects(objects)
+	if err != nil {
+		return err
+	}
+	p.storage = storage
+	return nil
+}
+
+// SourceObjects returns the Ingresses and Services read by the provider
+func (p *Provider) SourceObjects() []client.Object {
+	if p.storage == nil {
+		return nil
+	}
+	return p.storage.sourceObjects()
+}
+
 // ToIR converts the provider resources to intermediate representation
 func (p *Provider) ToIR() (intermediate.IR, field.ErrorList) {
 	return p.resourcesToIRConverter.convert(p.storage)
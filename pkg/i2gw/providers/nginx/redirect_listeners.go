@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// OmitRedirectListenersFlag omits the HTTP listeners only receiving redirects to
+// HTTPS.
+const OmitRedirectListenersFlag = "omit-redirect-listeners"
+
+// omitRedirectListeners removes the HTTP listeners of the Gateways every route
+// attached to redirects to HTTPS, for the HTTP requests to be redirected in
+// front of the Gateways, e.g. by a load balancer, or by a Gateway of their own.
+// A listener is kept when a route attached to it does not redirect, or has a
+// hostname without HTTPS listener to receive the redirected requests. The
+// redirect rules of the routes of the removed listeners are removed, and their
+// parentRefs naming a removed listener are attached to the HTTPS listener of
+// their hostname, so that the routes keep serving the HTTPS requests.
+func omitRedirectListeners(ir *intermediate.IR) {
+	for _, gatewayKey := range sortedKeys(ir.Gateways) {
+		gatewayContext := ir.Gateways[gatewayKey]
+		listeners := gatewayContext.Spec.Listeners
+
+		// The HTTP listeners some route is attached to, and those a route
+		// serving HTTP requests is attached to.
+		attached := sets.New[gatewayv1.SectionName]()
+		serving := sets.New[gatewayv1.SectionName]()
+		redirectRoutes := map[types.NamespacedName]sets.Set[gatewayv1.SectionName]{}
+		for routeKey, httpRouteContext := range ir.HTTPRoutes {
+			httpRoute := httpRouteContext.HTTPRoute
+			redirects := redirectsAllToHTTPS(httpRoute.Spec.Rules) && hasHTTPSListeners(listeners, httpRoute.Spec.Hostnames)
+			httpListeners := sets.New[gatewayv1.SectionName]()
+			for _, parentRef := range httpRoute.Spec.ParentRefs {
+				if i2gw.ParentGatewayKey(routeKey.Namespace, parentRef) != gatewayKey {
+					continue
+				}
+				for _, listener := range parentRefListeners(listeners, parentRef, httpRoute.Spec.Hostnames) {
+					if listener.Protocol == gatewayv1.HTTPProtocolType {
+						httpListeners.Insert(listener.Name)
+					}
+				}
+			}
+			attached = attached.Union(httpListeners)
+			if !redirects {
+				serving = serving.Union(httpListeners)
+			} else if httpListeners.Len() > 0 {
+				redirectRoutes[routeKey] = httpListeners
+			}
+		}
+		for routeKey, grpcRoute := range ir.GRPCRoutes {
+			for _, parentRef := range grpcRoute.Spec.ParentRefs {
+				if i2gw.ParentGatewayKey(routeKey.Namespace, parentRef) != gatewayKey {
+					continue
+				}
+				for _, listener := range parentRefListeners(listeners, parentRef, grpcRoute.Spec.Hostnames) {
+					serving.Insert(listener.Name)
+				}
+			}
+		}
+
+		omitted := attached.Difference(serving)
+		if omitted.Len() == 0 {
+			continue
+		}
+		gatewayContext.Spec.Listeners = slices.DeleteFunc(slices.Clone(listeners), func(listener gatewayv1.Listener) bool {
+			return omitted.Has(listener.Name)
+		})
+		ir.Gateways[gatewayKey] = gatewayContext
+
+		for _, routeKey := range sortedKeys(redirectRoutes) {
+			// A route also attached to a kept listener keeps redirecting.
+			if omitted.IsSuperset(redirectRoutes[routeKey]) {
+				removeHTTPSRedirect(ir, routeKey, gatewayKey, gatewayContext.Spec.Listeners, omitted)
+			}
+		}
+
+		names := make([]string, 0, omitted.Len())
+		for _, name := range sets.List(omitted) {
+			names = append(names, string(name))
+		}
+		notify(notifications.InfoNotification, fmt.Sprintf("The HTTP listeners %s only redirect to HTTPS and were omitted: the HTTP requests must be redirected to HTTPS in front of the Gateway",
+			strings.Join(names, ", ")), &gatewayContext.Gateway)
+	}
+}
+
+// removeHTTPSRedirect removes the redirect rules of the route, and attaches its
+// parentRefs naming an omitted listener of the Gateway to the HTTPS listener of
+// its hostname, or to every listener accepting its hostnames when it has several.
+func removeHTTPSRedirect(ir *intermediate.IR, routeKey, gatewayKey types.NamespacedName, listeners []gatewayv1.Listener, omitted sets.Set[gatewayv1.SectionName]) {
+	httpRouteContext := ir.HTTPRoutes[routeKey]
+	httpRoute := &httpRouteContext.HTTPRoute
+	httpRoute.Spec.Rules = slices.DeleteFunc(httpRoute.Spec.Rules, isHTTPSRedirectRule)
+	for i, parentRef := range httpRoute.Spec.ParentRefs {
+		if i2gw.ParentGatewayKey(routeKey.Namespace, parentRef) != gatewayKey || parentRef.SectionName == nil || !omitted.Has(*parentRef.SectionName) {
+			continue
+		}
+		httpRoute.Spec.ParentRefs[i].SectionName = nil
+		if len(httpRoute.Spec.Hostnames) == 1 {
+			httpRoute.Spec.ParentRefs[i].SectionName = &httpsListenerFor(listeners, string(httpRoute.Spec.Hostnames[0])).Name
+		}
+	}
+	ir.HTTPRoutes[routeKey] = httpRouteContext
+}
+
+// redirectsAllToHTTPS tells whether a rule of the route redirects all its
+// requests to HTTPS.
+func redirectsAllToHTTPS(rules []gatewayv1.HTTPRouteRule) bool {
+	return slices.ContainsFunc(rules, isHTTPSRedirectRule)
+}
+
+// isHTTPSRedirectRule tells whether the rule redirects all the requests to
+// HTTPS, as the rule generated for nginx.org/redirect-to-https does.
+func isHTTPSRedirectRule(rule gatewayv1.HTTPRouteRule) bool {
+	if len(rule.Matches) > 0 || len(rule.BackendRefs) > 0 {
+		return false
+	}
+	return redirectsToHTTPS([]gatewayv1.HTTPRouteRule{rule})
+}
+
+// hasHTTPSListeners tells whether every hostname has an HTTPS listener.
+func hasHTTPSListeners(listeners []gatewayv1.Listener, hostnames []gatewayv1.Hostname) bool {
+	if len(hostnames) == 0 {
+		return httpsListenerFor(listeners, "") != nil
+	}
+	for _, hostname := range hostnames {
+		if httpsListenerFor(listeners, string(hostname)) == nil {
+			return false
+		}
+	}
+	return true
+}
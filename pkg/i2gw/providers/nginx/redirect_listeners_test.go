@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestOmitRedirectListeners(t *testing.T) {
+	ingress := func(name, host string, redirect bool) *networkingv1.Ingress {
+		annotations := map[string]string{}
+		if redirect {
+			annotations["nginx.org/redirect-to-https"] = "true"
+		}
+		return &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: ptr.To("nginx"),
+				TLS:              []networkingv1.IngressTLS{{Hosts: []string{host}, SecretName: name + "-tls"}},
+				Rules:            []networkingv1.IngressRule{ingressRule(host)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		flags            map[string]string
+		ingresses        []*networkingv1.Ingress
+		expectedHTTP     []string
+		expectedSections map[string]gatewayv1.SectionName
+		expectedRedirect map[string]bool
+	}{
+		{
+			name:             "HTTP listeners kept by default",
+			ingresses:        []*networkingv1.Ingress{ingress("a", "a.example.com", true), ingress("b", "b.example.com", true)},
+			expectedHTTP:     []string{"a-example-com-http", "b-example-com-http"},
+			expectedSections: map[string]gatewayv1.SectionName{"a-a-example-com": "a-example-com-http", "b-b-example-com": "b-example-com-http"},
+			expectedRedirect: map[string]bool{"a-a-example-com": true, "b-b-example-com": true},
+		},
+		{
+			name:             "every host redirects",
+			flags:            map[string]string{OmitRedirectListenersFlag: "true"},
+			ingresses:        []*networkingv1.Ingress{ingress("a", "a.example.com", true), ingress("b", "b.example.com", true)},
+			expectedSections: map[string]gatewayv1.SectionName{"a-a-example-com": "a-example-com-https", "b-b-example-com": "b-example-com-https"},
+			expectedRedirect: map[string]bool{"a-a-example-com": false, "b-b-example-com": false},
+		},
+		{
+			name:             "a host serves HTTP",
+			flags:            map[string]string{OmitRedirectListenersFlag: "true"},
+			ingresses:        []*networkingv1.Ingress{ingress("a", "a.example.com", true), ingress("b", "b.example.com", false)},
+			expectedHTTP:     []string{"b-example-com-http"},
+			expectedSections: map[string]gatewayv1.SectionName{"a-a-example-com": "a-example-com-https", "b-b-example-com": ""},
+			expectedRedirect: map[string]bool{"a-a-example-com": false, "b-b-example-com": false},
+		},
+		{
+			name:             "shared HTTP listener serving HTTP",
+			flags:            map[string]string{OmitRedirectListenersFlag: "true", HTTPListenerStrategyFlag: sharedHTTPListeners},
+			ingresses:        []*networkingv1.Ingress{ingress("a", "a.example.com", true), ingress("b", "b.example.com", false)},
+			expectedHTTP:     []string{"http"},
+			expectedSections: map[string]gatewayv1.SectionName{"a-a-example-com": "http", "b-b-example-com": ""},
+			expectedRedirect: map[string]bool{"a-a-example-com": true, "b-b-example-com": false},
+		},
+		{
+			name:             "shared HTTP listener only redirecting",
+			flags:            map[string]string{OmitRedirectListenersFlag: "true", HTTPListenerStrategyFlag: sharedHTTPListeners},
+			ingresses:        []*networkingv1.Ingress{ingress("a", "a.example.com", true), ingress("b", "b.example.com", true)},
+			expectedSections: map[string]gatewayv1.SectionName{"a-a-example-com": "a-example-com-https", "b-b-example-com": "b-example-com-https"},
+			expectedRedirect: map[string]bool{"a-a-example-com": false, "b-b-example-com": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			storage := newResourceStorage()
+			for _, ingress := range tt.ingresses {
+				storage.Ingresses[types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}] = ingress
+			}
+
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{ProviderSpecificFlags: map[string]map[string]string{Name: tt.flags}})
+			ir, errs := converter.convert(storage)
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var http []string
+			for _, listener := range ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Spec.Listeners {
+				if listener.Protocol == gatewayv1.HTTPProtocolType {
+					http = append(http, string(listener.Name))
+				}
+			}
+			if !reflect.DeepEqual(http, tt.expectedHTTP) {
+				t.Errorf("Expected HTTP listeners %v, got %v", tt.expectedHTTP, http)
+			}
+
+			for name, expectedSection := range tt.expectedSections {
+				httpRoute := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: name}].HTTPRoute
+				var section gatewayv1.SectionName
+				if len(httpRoute.Spec.ParentRefs) == 1 && httpRoute.Spec.ParentRefs[0].SectionName != nil {
+					section = *httpRoute.Spec.ParentRefs[0].SectionName
+				}
+				if section != expectedSection {
+					t.Errorf("Expected HTTPRoute %s attached to the listener %q, got %+v", name, expectedSection, httpRoute.Spec.ParentRefs)
+				}
+				if redirect := redirectsAllToHTTPS(httpRoute.Spec.Rules); redirect != tt.expectedRedirect[name] {
+					t.Errorf("Expected HTTPRoute %s to redirect: %t, got %t", name, tt.expectedRedirect[name], redirect)
+				}
+				if len(httpRoute.Spec.Rules) == 0 || len(httpRoute.Spec.Rules[len(httpRoute.Spec.Rules)-1].BackendRefs) == 0 {
+					t.Errorf("Expected HTTPRoute %s to keep its backend rules, got %+v", name, httpRoute.Spec.Rules)
+				}
+			}
+			for _, unmatched := range i2gw.UnmatchedSectionNames(&ir) {
+				t.Errorf("The sectionName of %s names no listener", unmatched)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// Kinds of route matches which can be of the RegularExpression type.
+const (
+	pathMatch       = "path"
+	headerMatch     = "header"
+	queryParamMatch = "query parameter"
+	grpcMethodMatch = "gRPC method"
+)
+
+// reportRegularExpressionMatches warns about the routes using RegularExpression
+// matches. Their support is implementation-specific in Gateway API, and NGINX
+// Gateway Fabric only supports Exact and PathPrefix paths and Exact headers and
+// query parameters, so every such match is reported according to the profile.
+func reportRegularExpressionMatches(gatewayResources i2gw.GatewayResources, profile targetProfile) {
+	for _, key := range sortedKeys(gatewayResources.HTTPRoutes) {
+		httpRoute := gatewayResources.HTTPRoutes[key]
+		counts := map[string]int{}
+		for _, rule := range httpRoute.Spec.Rules {
+			for _, match := range rule.Matches {
+				if match.Path != nil && match.Path.Type != nil && *match.Path.Type == gatewayv1.PathMatchRegularExpression {
+					counts[pathMatch]++
+				}
+				for _, header := range match.Headers {
+					if header.Type != nil && *header.Type == gatewayv1.HeaderMatchRegularExpression {
+						counts[headerMatch]++
+					}
+				}
+				for _, queryParam := range match.QueryParams {
+					if queryParam.Type != nil && *queryParam.Type == gatewayv1.QueryParamMatchRegularExpression {
+						counts[queryParamMatch]++
+					}
+				}
+			}
+		}
+		reportUnsupportedMatches("HTTPRoute", key.String(), counts, profile, &httpRoute)
+	}
+
+	for _, key := range sortedKeys(gatewayResources.GRPCRoutes) {
+		grpcRoute := gatewayResources.GRPCRoutes[key]
+		counts := map[string]int{}
+		for _, rule := range grpcRoute.Spec.Rules {
+			for _, match := range rule.Matches {
+				if match.Method != nil && match.Method.Type != nil && *match.Method.Type == gatewayv1.GRPCMethodMatchRegularExpression {
+					counts[grpcMethodMatch]++
+				}
+				for _, header := range match.Headers {
+					if header.Type != nil && *header.Type == gatewayv1.HeaderMatchRegularExpression {
+						counts[headerMatch]++
+					}
+				}
+			}
+		}
+		reportUnsupportedMatches("GRPCRoute", key.String(), counts, profile, &grpcRoute)
+	}
+}
+
+// reportUnsupportedMatches reports the counts of RegularExpression matches of a
+// route per kind.
+func reportUnsupportedMatches(kind, name string, counts map[string]int, profile targetProfile, route client.Object) {
+	for _, matchKind := range []string{pathMatch, headerMatch, queryParamMatch, grpcMethodMatch} {
+		count := counts[matchKind]
+		if count == 0 {
+			continue
+		}
+		if profile.implementation == "" {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s %s has %d RegularExpression %s matches, whose support is implementation-specific in Gateway API, check that the Gateway implementation supports them",
+				kind, name, count, matchKind), route)
+			continue
+		}
+		notify(notifications.WarningNotification, fmt.Sprintf("%s %s has %d RegularExpression %s matches, which %s does not support",
+			kind, name, count, matchKind, profile.implementation), route)
+	}
+}
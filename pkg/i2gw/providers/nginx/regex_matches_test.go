@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestReportRegularExpressionMatches(t *testing.T) {
+	gatewayResources := i2gw.GatewayResources{
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			{Namespace: "default", Name: "regex"}: {Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{
+					{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchRegularExpression), Value: ptr.To("/api/v[0-9]+")}},
+					{
+						Path:    &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchRegularExpression), Value: ptr.To("/(?i)app")},
+						Headers: []gatewayv1.HTTPHeaderMatch{{Type: ptr.To(gatewayv1.HeaderMatchRegularExpression), Name: "x-version", Value: "v[12]"}},
+					},
+				},
+			}}}},
+			{Namespace: "default", Name: "prefix"}: {Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{
+					{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/")}},
+				},
+			}}}},
+		},
+		GRPCRoutes: map[types.NamespacedName]gatewayv1.GRPCRoute{
+			{Namespace: "default", Name: "grpc"}: {Spec: gatewayv1.GRPCRouteSpec{Rules: []gatewayv1.GRPCRouteRule{{
+				Matches: []gatewayv1.GRPCRouteMatch{
+					{Method: &gatewayv1.GRPCMethodMatch{Type: ptr.To(gatewayv1.GRPCMethodMatchRegularExpression), Service: ptr.To("helloworld\\..*")}},
+				},
+			}}}},
+		},
+	}
+
+	tests := []struct {
+		profile          string
+		expectedWarnings []string
+	}{
+		{
+			profile: gatewayAPIProfile,
+			expectedWarnings: []string{
+				"HTTPRoute default/regex has 2 RegularExpression path matches, whose support is implementation-specific in Gateway API, check that the Gateway implementation supports them",
+				"HTTPRoute default/regex has 1 RegularExpression header matches, whose support is implementation-specific in Gateway API, check that the Gateway implementation supports them",
+				"GRPCRoute default/grpc has 1 RegularExpression gRPC method matches, whose support is implementation-specific in Gateway API, check that the Gateway implementation supports them",
+			},
+		},
+		{
+			profile: nginxGatewayFabricProfile,
+			expectedWarnings: []string{
+				"HTTPRoute default/regex has 2 RegularExpression path matches, which NGINX Gateway Fabric does not support",
+				"HTTPRoute default/regex has 1 RegularExpression header matches, which NGINX Gateway Fabric does not support",
+				"GRPCRoute default/grpc has 1 RegularExpression gRPC method matches, which NGINX Gateway Fabric does not support",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			reportRegularExpressionMatches(gatewayResources, targetProfiles[tt.profile])
+
+			var warnings []string
+			for _, notification := range notifications.NotificationAggr.Notifications[Name] {
+				warnings = append(warnings, notification.Message)
+			}
+			if strings.Join(warnings, "\n") != strings.Join(tt.expectedWarnings, "\n") {
+				t.Errorf("Expected warnings %q, got %q", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+}
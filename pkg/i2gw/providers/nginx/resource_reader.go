@@ -17,11 +17,22 @@ limitations under the License.
 package nginx
 
 import (
+	"cmp"
 	"context"
-
+	"fmt"
+	"slices"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
 	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
 )
 
@@ -30,6 +41,38 @@ var NginxIngressClasses = sets.New(
 	"nginx",
 )
 
+// ingressGroupKind and serviceGroupKind are the kinds converted by the nginx provider
+var (
+	ingressGroupKind = schema.GroupKind{Group: networkingv1.GroupName, Kind: "Ingress"}
+	serviceGroupKind = schema.GroupKind{Group: apiv1.GroupName, Kind: "Service"}
+)
+
+// eventGroupKind is the kind of the events read to detect the Ingresses NGINX
+// Ingress Controller rejected
+var eventGroupKind = schema.GroupKind{Group: apiv1.GroupName, Kind: "Event"}
+
+// processedGroupKinds contains the kinds read by the nginx provider
+var processedGroupKinds = sets.New(
+	ingressGroupKind,
+	serviceGroupKind,
+	eventGroupKind,
+)
+
+// nginxCRDGroups contains the API groups of NGINX Ingress Controller custom resources
+var nginxCRDGroups = sets.New(
+	"k8s.nginx.org",
+	"appprotect.f5.com",
+	"appprotectdos.f5.com",
+	"externaldns.nginx.org",
+)
+
+// securityCRDGroups maps the API groups of NGINX App Protect custom resources to
+// the security feature they configure
+var securityCRDGroups = map[string]string{
+	"appprotect.f5.com":    "App Protect WAF",
+	"appprotectdos.f5.com": "App Protect DoS",
+}
+
 type resourceReader struct {
 	conf *i2gw.ProviderConf
 }
@@ -56,25 +99,97 @@ func (r *resourceReader) readResourcesFromCluster(ctx context.Context) (*storage
 		return nil, err
 	}
 	storage.ServicePorts = common.GroupServicePortsByPortName(services)
+	storage.Services = services
+	storage.IngressEvents = readIngressEventsFromCluster(ctx, r.conf.Client)
+	storage.VirtualServers = readVirtualServersFromCluster(ctx, r.conf.Client)
+	storage.TransportServers = readCustomResourcesFromCluster(ctx, r.conf.Client, transportServerKind, "the TransportServers rejected by NGINX Ingress Controller are not reported")
 
 	return storage, nil
 }
 
-// readResourcesFromFile reads nginx resources from a YAML file
+// readResourcesFromFile reads nginx resources from a YAML or JSON file, or a
+// directory tree of such files
 func (r *resourceReader) readResourcesFromFile(filename string) (*storage, error) {
-	storage := newResourceStorage()
-
-	ingresses, err := common.ReadIngressesFromFile(filename, r.conf.Namespace, NginxIngressClasses)
+	objects, err := common.ExtractObjectsFromPath(filename, r.conf.Namespace)
 	if err != nil {
 		return nil, err
 	}
-	storage.Ingresses = ingresses
+	return readResourcesFromObjects(objects)
+}
 
-	services, err := common.ReadServicesFromFile(filename, r.conf.Namespace)
-	if err != nil {
-		return nil, err
+// readResourcesFromObjects sorts the objects read from the input files into a
+// storage, dispatching each object on its kind
+func readResourcesFromObjects(objects []*unstructured.Unstructured) (*storage, error) {
+	storage := newResourceStorage()
+	var events []apiv1.Event
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		switch {
+		case gvk.GroupKind() == ingressGroupKind:
+			var ingress networkingv1.Ingress
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &ingress); err != nil {
+				return nil, fmt.Errorf("failed to parse ingress %s: %w", key, err)
+			}
+			if NginxIngressClasses.Has(common.GetIngressClass(ingress)) {
+				storage.Ingresses[key] = &ingress
+			}
+		case gvk.GroupKind() == serviceGroupKind:
+			var service apiv1.Service
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &service); err != nil {
+				return nil, fmt.Errorf("failed to parse service %s: %w", key, err)
+			}
+			storage.Services[key] = &service
+		case gvk.GroupKind() == eventGroupKind:
+			var event apiv1.Event
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &event); err != nil {
+				return nil, fmt.Errorf("failed to parse event %s: %w", key, err)
+			}
+			events = append(events, event)
+		case gvk.Group == virtualServerGroup && slices.Contains(virtualServerKinds, gvk.Kind):
+			storage.VirtualServers = append(storage.VirtualServers, obj)
+		case gvk.Group == virtualServerGroup && gvk.Kind == transportServerKind:
+			storage.TransportServers = append(storage.TransportServers, obj)
+		}
 	}
-	storage.ServicePorts = common.GroupServicePortsByPortName(services)
+	storage.ServicePorts = common.GroupServicePortsByPortName(storage.Services)
+	storage.IngressEvents = groupIngressEvents(events)
+	reportUnprocessedObjects(objects)
 
 	return storage, nil
 }
+
+// reportUnprocessedObjects dispatches one notification per kind found in the
+// input which the provider does not convert. NGINX Ingress Controller custom
+// resources are reported as warnings, any other kind as info.
+func reportUnprocessedObjects(objects []*unstructured.Unstructured) {
+	objectsByGroupKind := map[schema.GroupKind][]client.Object{}
+	for _, obj := range objects {
+		gk := obj.GroupVersionKind().GroupKind()
+		if processedGroupKinds.Has(gk) {
+			continue
+		}
+		objectsByGroupKind[gk] = append(objectsByGroupKind[gk], obj)
+	}
+
+	groupKinds := make([]schema.GroupKind, 0, len(objectsByGroupKind))
+	for gk := range objectsByGroupKind {
+		groupKinds = append(groupKinds, gk)
+	}
+	slices.SortFunc(groupKinds, func(a, b schema.GroupKind) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+
+	for _, gk := range groupKinds {
+		if feature, ok := securityCRDGroups[gk.Group]; ok {
+			for _, obj := range objectsByGroupKind[gk] {
+				reportSecurityFinding(feature, fmt.Sprintf("%s %s", gk.Kind, client.ObjectKeyFromObject(obj)), obj)
+			}
+		}
+		if nginxCRDGroups.Has(gk.Group) {
+			notify(notifications.WarningNotification, fmt.Sprintf("%s resources are not supported by the nginx provider and were not converted", gk), objectsByGroupKind[gk]...)
+			continue
+		}
+		notify(notifications.InfoNotification, fmt.Sprintf("%s resources are not processed by the nginx provider and were ignored", gk), objectsByGroupKind[gk]...)
+	}
+}
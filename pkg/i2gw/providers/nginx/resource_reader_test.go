@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestReadResourcesFromObjects(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	object := func(apiVersion, kind, name string, fields map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: fields}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		return obj
+	}
+	objects := []*unstructured.Unstructured{
+		object("networking.k8s.io/v1", "Ingress", "cafe", map[string]interface{}{"spec": map[string]interface{}{"ingressClassName": "nginx"}}),
+		object("networking.k8s.io/v1", "Ingress", "other", map[string]interface{}{"spec": map[string]interface{}{"ingressClassName": "other"}}),
+		object("v1", "Service", "coffee", map[string]interface{}{"spec": map[string]interface{}{"ports": []interface{}{
+			map[string]interface{}{"name": "http", "port": int64(80)},
+		}}}),
+		object("v1", "Event", "cafe.1", map[string]interface{}{"involvedObject": map[string]interface{}{"kind": "Ingress", "name": "cafe"}}),
+		object("k8s.nginx.org/v1", "VirtualServer", "cafe", map[string]interface{}{}),
+		object("v1", "ConfigMap", "settings", map[string]interface{}{}),
+	}
+
+	storage, err := readResourcesFromObjects(objects)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	cafe := types.NamespacedName{Namespace: "default", Name: "cafe"}
+	if len(storage.Ingresses) != 1 || storage.Ingresses[cafe] == nil {
+		t.Errorf("Expected only the Ingress of the nginx class to be read, got %v", storage.Ingresses)
+	}
+	if port := storage.ServicePorts[types.NamespacedName{Namespace: "default", Name: "coffee"}]["http"]; port != 80 {
+		t.Errorf("Expected the http port of the Service to be 80, got %d", port)
+	}
+	if len(storage.IngressEvents[cafe]) != 1 {
+		t.Errorf("Expected 1 event of the Ingress, got %v", storage.IngressEvents)
+	}
+	if len(storage.VirtualServers) != 1 {
+		t.Errorf("Expected 1 VirtualServer, got %d", len(storage.VirtualServers))
+	}
+	if len(notifications.NotificationAggr.Notifications[Name]) != 2 {
+		t.Errorf("Expected the VirtualServer and the ConfigMap to be reported, got %v", notifications.NotificationAggr.Notifications[Name])
+	}
+}
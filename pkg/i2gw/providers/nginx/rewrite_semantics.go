@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// checkRewriteSemantics compares every ReplacePrefixMatch URLRewrite filter with
+// the rewrite of NGINX Ingress Controller, which passes the rewrite as the URI of
+// proxy_pass. NGINX replaces the location path textually, while Gateway API
+// replaces path elements, so a trailing slash on only one of the path and the
+// rewrite makes them differ:
+//
+//	path    rewrite   request   NGINX      Gateway API
+//	/tea    /coffee   /tea/x    /coffee/x  /coffee/x
+//	/tea/   /coffee/  /tea/x    /coffee/x  /coffee/x
+//	/tea/   /coffee   /tea/x    /coffeex   /coffee/x
+//	/tea    /coffee/  /tea/x    /coffee//x /coffee/x
+//	/       /coffee   /x        /coffeex   /coffee/x
+//	/tea    /         /tea/x    //x        /x
+//
+// Each difference is reported with the request path of the example. Rewrites of
+// matches other than PathPrefix, which Gateway API rejects, are reported too.
+func checkRewriteSemantics(ir *intermediate.IR) {
+	for _, key := range sortedKeys(ir.HTTPRoutes) {
+		httpRoute := ir.HTTPRoutes[key].HTTPRoute
+		for _, rule := range httpRoute.Spec.Rules {
+			for _, filter := range rule.Filters {
+				if filter.Type != gatewayv1.HTTPRouteFilterURLRewrite || filter.URLRewrite == nil || filter.URLRewrite.Path == nil ||
+					filter.URLRewrite.Path.Type != gatewayv1.PrefixMatchHTTPPathModifier || filter.URLRewrite.Path.ReplacePrefixMatch == nil {
+					continue
+				}
+				replacement := *filter.URLRewrite.Path.ReplacePrefixMatch
+
+				for _, match := range rule.Matches {
+					if match.Path == nil || match.Path.Value == nil {
+						continue
+					}
+					path := *match.Path.Value
+					if match.Path.Type != nil && *match.Path.Type != gatewayv1.PathMatchPathPrefix {
+						notify(notifications.WarningNotification, fmt.Sprintf("HTTPRoute %s rewrites the %s path %s to %s, but Gateway API only accepts prefix rewrites of PathPrefix matches",
+							key, *match.Path.Type, path, replacement), &httpRoute)
+						continue
+					}
+
+					request := strings.TrimSuffix(path, "/") + "/x"
+					nginxPath := nginxRewrite(path, replacement, request)
+					gatewayPath := gatewayRewrite(path, replacement, request)
+					if nginxPath != gatewayPath {
+						notify(notifications.WarningNotification, fmt.Sprintf("HTTPRoute %s rewrites the path %s to %s: NGINX proxies %s to %s, but the URLRewrite filter to %s, "+
+							"since only one of the path and the rewrite ends with /", key, path, replacement, request, nginxPath, gatewayPath), &httpRoute)
+					}
+				}
+			}
+		}
+	}
+}
+
+// nginxRewrite returns the path NGINX proxies the request to for a location path
+// and a proxy_pass URI: the URI followed by the rest of the request path.
+func nginxRewrite(location, uri, request string) string {
+	return uri + strings.TrimPrefix(request, location)
+}
+
+// gatewayRewrite returns the path the ReplacePrefixMatch rewrite of a PathPrefix
+// match sends the request to. The prefix is matched by path elements, ignoring
+// its trailing slash, and a single slash separates the replacement from the rest
+// of the request path.
+func gatewayRewrite(prefix, replacement, request string) string {
+	rest := strings.TrimPrefix(request, strings.TrimSuffix(prefix, "/"))
+	if strings.HasSuffix(replacement, "/") {
+		return replacement + strings.TrimPrefix(rest, "/")
+	}
+	return replacement + rest
+}
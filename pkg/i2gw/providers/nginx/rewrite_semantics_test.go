@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestCheckRewriteSemantics(t *testing.T) {
+	tests := []struct {
+		name            string
+		pathType        gatewayv1.PathMatchType
+		path            string
+		rewrite         string
+		expectedWarning string
+	}{
+		{
+			name:     "no trailing slashes",
+			pathType: gatewayv1.PathMatchPathPrefix,
+			path:     "/tea",
+			rewrite:  "/coffee",
+		},
+		{
+			name:     "both trailing slashes",
+			pathType: gatewayv1.PathMatchPathPrefix,
+			path:     "/tea/",
+			rewrite:  "/coffee/",
+		},
+		{
+			name:     "root path rewritten to root",
+			pathType: gatewayv1.PathMatchPathPrefix,
+			path:     "/",
+			rewrite:  "/",
+		},
+		{
+			name:            "path ends with a slash",
+			pathType:        gatewayv1.PathMatchPathPrefix,
+			path:            "/tea/",
+			rewrite:         "/coffee",
+			expectedWarning: "HTTPRoute default/app rewrites the path /tea/ to /coffee: NGINX proxies /tea/x to /coffeex, but the URLRewrite filter to /coffee/x, since only one of the path and the rewrite ends with /",
+		},
+		{
+			name:            "rewrite ends with a slash",
+			pathType:        gatewayv1.PathMatchPathPrefix,
+			path:            "/tea",
+			rewrite:         "/coffee/",
+			expectedWarning: "HTTPRoute default/app rewrites the path /tea to /coffee/: NGINX proxies /tea/x to /coffee//x, but the URLRewrite filter to /coffee/x, since only one of the path and the rewrite ends with /",
+		},
+		{
+			name:            "root path",
+			pathType:        gatewayv1.PathMatchPathPrefix,
+			path:            "/",
+			rewrite:         "/coffee",
+			expectedWarning: "HTTPRoute default/app rewrites the path / to /coffee: NGINX proxies /x to /coffeex, but the URLRewrite filter to /coffee/x, since only one of the path and the rewrite ends with /",
+		},
+		{
+			name:            "rewrite to root",
+			pathType:        gatewayv1.PathMatchPathPrefix,
+			path:            "/tea",
+			rewrite:         "/",
+			expectedWarning: "HTTPRoute default/app rewrites the path /tea to /: NGINX proxies /tea/x to //x, but the URLRewrite filter to /x, since only one of the path and the rewrite ends with /",
+		},
+		{
+			name:            "exact path",
+			pathType:        gatewayv1.PathMatchExact,
+			path:            "/tea",
+			rewrite:         "/coffee",
+			expectedWarning: "HTTPRoute default/app rewrites the Exact path /tea to /coffee, but Gateway API only accepts prefix rewrites of PathPrefix matches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			key := types.NamespacedName{Namespace: "default", Name: "app"}
+			ir := intermediate.IR{HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+				key: {HTTPRoute: gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+					Matches: []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(tt.pathType), Value: ptr.To(tt.path)}}},
+					Filters: []gatewayv1.HTTPRouteFilter{{
+						Type: gatewayv1.HTTPRouteFilterURLRewrite,
+						URLRewrite: &gatewayv1.HTTPURLRewriteFilter{Path: &gatewayv1.HTTPPathModifier{
+							Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: ptr.To(tt.rewrite),
+						}},
+					}},
+				}}}}},
+			}}
+
+			checkRewriteSemantics(&ir)
+
+			reported := notifications.NotificationAggr.Notifications[Name]
+			if tt.expectedWarning == "" {
+				if len(reported) != 0 {
+					t.Errorf("Expected no warnings, got %v", reported)
+				}
+				return
+			}
+			if len(reported) != 1 || reported[0].Message != tt.expectedWarning {
+				t.Errorf("Expected warning %q, got %v", tt.expectedWarning, reported)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// TargetImplementationFlag selects the profile of the Gateway API implementation
+// targeted by the conversion.
+const TargetImplementationFlag = "target-implementation"
+
+const (
+	gatewayAPIProfile         = "gateway-api"
+	nginxGatewayFabricProfile = "nginx-gateway-fabric"
+)
+
+// targetProfile holds the limits and defaults of a target implementation. A zero
+// limit is not checked.
+type targetProfile struct {
+	// implementation is the name of the implementation, empty for Gateway API.
+	implementation string
+	// description completes "exceeds ..." in the reported warnings.
+	description            string
+	maxListenersPerGateway int
+	maxRulesPerRoute       int
+	maxRoutes              int
+
+	// defaultLoadBalancingMethod is the NGINX load balancing method of the
+	// implementation, empty when it is not NGINX based.
+	defaultLoadBalancingMethod string
+
+	// keepaliveSetting is the setting of the implementation configuring the
+	// idle connections kept to the upstreams, empty when it has none.
+	keepaliveSetting string
+
+	// setsForwardedHeaders tells whether the implementation sets the
+	// X-Forwarded-* headers on the requests to the backends.
+	setsForwardedHeaders bool
+}
+
+// targetProfiles are the known target implementations. The Gateway API limits are
+// enforced by the CRD validation, the NGINX Gateway Fabric ones are the scale it
+// is tested with.
+var targetProfiles = map[string]targetProfile{
+	gatewayAPIProfile: {
+		description:            "the Gateway API limit",
+		maxListenersPerGateway: 64,
+		maxRulesPerRoute:       16,
+	},
+	nginxGatewayFabricProfile: {
+		implementation:             "NGINX Gateway Fabric",
+		description:                "NGINX Gateway Fabric's tested scale",
+		maxListenersPerGateway:     64,
+		maxRulesPerRoute:           16,
+		maxRoutes:                  1000,
+		defaultLoadBalancingMethod: "random two least_conn",
+		keepaliveSetting:           "keepAlive.connections of an UpstreamSettingsPolicy",
+		setsForwardedHeaders:       true,
+	},
+}
+
+// parseTargetImplementation returns the profile of the target implementation
+// selected by the flag.
+func parseTargetImplementation(value string) (targetProfile, *field.Error) {
+	if value == "" {
+		value = gatewayAPIProfile
+	}
+	profile, ok := targetProfiles[value]
+	if !ok {
+		return targetProfile{}, field.NotSupported(field.NewPath(TargetImplementationFlag), value, sets.List(sets.KeySet(targetProfiles)))
+	}
+	return profile, nil
+}
+
+// reportScale warns about the generated resources which exceed the limits of the
+// profile: listeners per Gateway, rules per route and the total number of routes.
+func reportScale(gatewayResources i2gw.GatewayResources, profile targetProfile) {
+	for _, key := range sortedKeys(gatewayResources.Gateways) {
+		gateway := gatewayResources.Gateways[key]
+		if exceeds(len(gateway.Spec.Listeners), profile.maxListenersPerGateway) {
+			notify(notifications.WarningNotification, fmt.Sprintf("Gateway %s has %d listeners, which exceeds %s of %d listeners per Gateway",
+				key, len(gateway.Spec.Listeners), profile.description, profile.maxListenersPerGateway), &gateway)
+		}
+	}
+	for _, key := range sortedKeys(gatewayResources.HTTPRoutes) {
+		httpRoute := gatewayResources.HTTPRoutes[key]
+		if exceeds(len(httpRoute.Spec.Rules), profile.maxRulesPerRoute) {
+			notify(notifications.WarningNotification, fmt.Sprintf("HTTPRoute %s has %d rules, which exceeds %s of %d rules per route",
+				key, len(httpRoute.Spec.Rules), profile.description, profile.maxRulesPerRoute), &httpRoute)
+		}
+	}
+	for _, key := range sortedKeys(gatewayResources.GRPCRoutes) {
+		grpcRoute := gatewayResources.GRPCRoutes[key]
+		if exceeds(len(grpcRoute.Spec.Rules), profile.maxRulesPerRoute) {
+			notify(notifications.WarningNotification, fmt.Sprintf("GRPCRoute %s has %d rules, which exceeds %s of %d rules per route",
+				key, len(grpcRoute.Spec.Rules), profile.description, profile.maxRulesPerRoute), &grpcRoute)
+		}
+	}
+
+	routes := len(gatewayResources.HTTPRoutes) + len(gatewayResources.GRPCRoutes)
+	if exceeds(routes, profile.maxRoutes) {
+		notify(notifications.WarningNotification, fmt.Sprintf("%d routes were generated, which exceeds %s of %d routes",
+			routes, profile.description, profile.maxRoutes))
+	}
+}
+
+func exceeds(count, limit int) bool {
+	return limit > 0 && count > limit
+}
+
+func sortedKeys[T any](objects map[types.NamespacedName]T) []types.NamespacedName {
+	keys := make([]types.NamespacedName, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+	return keys
+}
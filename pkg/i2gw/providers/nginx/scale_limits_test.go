@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestParseTargetImplementation(t *testing.T) {
+	if profile, err := parseTargetImplementation(""); err != nil || profile != targetProfiles[gatewayAPIProfile] {
+		t.Errorf("Expected the Gateway API profile by default, got %+v, %v", profile, err)
+	}
+	if _, err := parseTargetImplementation("unknown"); err == nil {
+		t.Errorf("Expected an error for an unknown implementation")
+	}
+}
+
+func TestReportScale(t *testing.T) {
+	tests := []struct {
+		name             string
+		listeners        int
+		rules            int
+		routes           int
+		profile          string
+		expectedWarnings []string
+	}{
+		{
+			name:      "within limits",
+			listeners: 64,
+			rules:     16,
+			routes:    2000,
+			profile:   gatewayAPIProfile,
+		},
+		{
+			name:      "Gateway API limits exceeded",
+			listeners: 65,
+			rules:     17,
+			routes:    1,
+			profile:   gatewayAPIProfile,
+			expectedWarnings: []string{
+				"Gateway default/nginx has 65 listeners, which exceeds the Gateway API limit of 64 listeners per Gateway",
+				"HTTPRoute default/route-0 has 17 rules, which exceeds the Gateway API limit of 16 rules per route",
+			},
+		},
+		{
+			name:             "NGINX Gateway Fabric route count exceeded",
+			listeners:        2,
+			rules:            1,
+			routes:           1001,
+			profile:          nginxGatewayFabricProfile,
+			expectedWarnings: []string{"1001 routes were generated, which exceeds NGINX Gateway Fabric's tested scale of 1000 routes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+			gatewayResources := i2gw.GatewayResources{
+				Gateways:   map[types.NamespacedName]gatewayv1.Gateway{{Namespace: "default", Name: "nginx"}: {}},
+				HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{},
+			}
+			gateway := gatewayResources.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}]
+			gateway.Spec.Listeners = make([]gatewayv1.Listener, tt.listeners)
+			gatewayResources.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}] = gateway
+			for i := 0; i < tt.routes; i++ {
+				route := gatewayv1.HTTPRoute{}
+				if i == 0 {
+					route.Spec.Rules = make([]gatewayv1.HTTPRouteRule, tt.rules)
+				}
+				gatewayResources.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: fmt.Sprintf("route-%d", i)}] = route
+			}
+
+			reportScale(gatewayResources, targetProfiles[tt.profile])
+
+			var warnings []string
+			for _, notification := range notifications.NotificationAggr.Notifications[Name] {
+				warnings = append(warnings, notification.Message)
+			}
+			if strings.Join(warnings, "\n") != strings.Join(tt.expectedWarnings, "\n") {
+				t.Errorf("Expected warnings %q, got %q", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestReportLoadBalancingMethods(t *testing.T) {
+	ir := intermediate.IR{Services: map[types.NamespacedName]intermediate.ProviderSpecificServiceIR{
+		{Namespace: "default", Name: "default-method"}: {Nginx: &intermediate.NginxServiceIR{LoadBalancingMethod: "random two least_conn"}},
+		{Namespace: "default", Name: "ip-hash"}:        {Nginx: &intermediate.NginxServiceIR{LoadBalancingMethod: "ip_hash"}},
+	}}
+
+	tests := []struct {
+		profile          string
+		expectedWarnings int
+	}{
+		{profile: gatewayAPIProfile, expectedWarnings: 2},
+		{profile: nginxGatewayFabricProfile, expectedWarnings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			reportLoadBalancingMethods(ir, targetProfiles[tt.profile])
+			if got := len(notifications.NotificationAggr.Notifications[Name]); got != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %d", tt.expectedWarnings, got)
+			}
+		})
+	}
+}
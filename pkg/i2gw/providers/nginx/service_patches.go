@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// resolveAppProtocolPorts replaces the port names of the appProtocols recorded in
+// the Service IR by their numbers, which are required to patch the Service ports.
+// Names of unknown Services or ports are reported and dropped.
+func resolveAppProtocolPorts(ir *intermediate.IR, servicePorts map[types.NamespacedName]map[string]int32) {
+	for key, serviceIR := range ir.Services {
+		if serviceIR.Nginx == nil || len(serviceIR.Nginx.AppProtocols) == 0 {
+			continue
+		}
+		appProtocols := make(map[string]string, len(serviceIR.Nginx.AppProtocols))
+		for port, appProtocol := range serviceIR.Nginx.AppProtocols {
+			if _, err := strconv.Atoi(port); err == nil {
+				appProtocols[port] = appProtocol
+				continue
+			}
+			number, ok := servicePorts[key][port]
+			if !ok {
+				notify(notifications.WarningNotification, fmt.Sprintf("Service %s port %s must declare appProtocol %s, but the port number is unknown, no Service patch was generated for it", key, port, appProtocol))
+				continue
+			}
+			appProtocols[strconv.Itoa(int(number))] = appProtocol
+		}
+		serviceIR.Nginx.AppProtocols = appProtocols
+		ir.Services[key] = serviceIR
+	}
+}
+
+// serviceAppProtocolPatches returns, for every Service with appProtocols recorded
+// in the IR, a Service manifest with only the metadata and ports to update. They
+// are meant to be applied to the existing Services, e.g. with kubectl apply
+// --server-side, which merges the ports by port and protocol.
+func serviceAppProtocolPatches(ir intermediate.IR) []unstructured.Unstructured {
+	var patches []unstructured.Unstructured
+	for _, key := range sortedKeys(ir.Services) {
+		serviceIR := ir.Services[key]
+		if serviceIR.Nginx == nil || len(serviceIR.Nginx.AppProtocols) == 0 {
+			continue
+		}
+
+		ports := make([]int64, 0, len(serviceIR.Nginx.AppProtocols))
+		for port := range serviceIR.Nginx.AppProtocols {
+			if number, err := strconv.ParseInt(port, 10, 32); err == nil {
+				ports = append(ports, number)
+			}
+		}
+		slices.Sort(ports)
+
+		var portPatches []interface{}
+		for _, port := range ports {
+			portPatches = append(portPatches, map[string]interface{}{
+				"port":        port,
+				"protocol":    "TCP",
+				"appProtocol": serviceIR.Nginx.AppProtocols[strconv.FormatInt(port, 10)],
+			})
+		}
+		if len(portPatches) == 0 {
+			continue
+		}
+
+		patch := unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":      key.Name,
+				"namespace": key.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"ports": portPatches,
+			},
+		}}
+		patches = append(patches, patch)
+	}
+	return patches
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+)
+
+func TestServiceAppProtocolPatches(t *testing.T) {
+	grpcKey := types.NamespacedName{Namespace: "default", Name: "grpc"}
+	wsKey := types.NamespacedName{Namespace: "default", Name: "ws"}
+	bufferedKey := types.NamespacedName{Namespace: "default", Name: "buffered"}
+	ir := intermediate.IR{
+		Services: map[types.NamespacedName]intermediate.ProviderSpecificServiceIR{
+			grpcKey:     {Nginx: &intermediate.NginxServiceIR{AppProtocols: map[string]string{"grpc": "kubernetes.io/h2c", "9090": "kubernetes.io/h2c"}}},
+			wsKey:       {Nginx: &intermediate.NginxServiceIR{AppProtocols: map[string]string{"unknown": "kubernetes.io/ws"}}},
+			bufferedKey: {Nginx: &intermediate.NginxServiceIR{ProxyBuffering: &intermediate.ProxyBufferingConfig{BufferSize: "4k"}}},
+		},
+	}
+	servicePorts := map[types.NamespacedName]map[string]int32{grpcKey: {"grpc": 50051}}
+
+	resolveAppProtocolPorts(&ir, servicePorts)
+	patches := serviceAppProtocolPatches(ir)
+
+	if len(patches) != 1 {
+		t.Fatalf("Expected a single Service patch, got %d", len(patches))
+	}
+	if patches[0].GetKind() != "Service" || patches[0].GetNamespace() != "default" || patches[0].GetName() != "grpc" {
+		t.Errorf("Expected a patch of Service default/grpc, got %s %s/%s", patches[0].GetKind(), patches[0].GetNamespace(), patches[0].GetName())
+	}
+	expectedPorts := []interface{}{
+		map[string]interface{}{"port": int64(9090), "protocol": "TCP", "appProtocol": "kubernetes.io/h2c"},
+		map[string]interface{}{"port": int64(50051), "protocol": "TCP", "appProtocol": "kubernetes.io/h2c"},
+	}
+	if ports := patches[0].Object["spec"].(map[string]interface{})["ports"]; !reflect.DeepEqual(ports, expectedPorts) {
+		t.Errorf("Expected ports %v, got %v", expectedPorts, ports)
+	}
+}
@@ -17,13 +17,30 @@ limitations under the License.
 package nginx
 
 import (
+	apiv1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type storage struct {
 	Ingresses    map[types.NamespacedName]*networkingv1.Ingress
 	ServicePorts map[types.NamespacedName]map[string]int32
+	Services     map[types.NamespacedName]*apiv1.Service
+
+	// IngressEvents holds the events of the Ingresses, used to detect the
+	// Ingresses NGINX Ingress Controller rejected.
+	IngressEvents map[types.NamespacedName][]apiv1.Event
+
+	// VirtualServers holds the VirtualServers and VirtualServerRoutes, whose
+	// upstream connection settings are reported and whose hosts are correlated
+	// with the Ingresses, although they are not converted.
+	VirtualServers []*unstructured.Unstructured
+
+	// TransportServers holds the TransportServers, which are not converted.
+	// Their status is read to report those NGINX Ingress Controller rejected.
+	TransportServers []*unstructured.Unstructured
 }
 
 // newResourceStorage creates a new storage instance
@@ -31,5 +48,18 @@ func newResourceStorage() *storage {
 	return &storage{
 		Ingresses:    map[types.NamespacedName]*networkingv1.Ingress{},
 		ServicePorts: map[types.NamespacedName]map[string]int32{},
+		Services:     map[types.NamespacedName]*apiv1.Service{},
+	}
+}
+
+// sourceObjects returns the Ingresses and Services of the storage.
+func (s *storage) sourceObjects() []client.Object {
+	objects := make([]client.Object, 0, len(s.Ingresses)+len(s.Services))
+	for _, ingress := range s.Ingresses {
+		objects = append(objects, ingress)
+	}
+	for _, service := range s.Services {
+		objects = append(objects, service)
 	}
+	return objects
 }
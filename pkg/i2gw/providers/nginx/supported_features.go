@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/annotations"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// SupportedFeatures returns the annotations the provider handles and how much of
+// each is converted, in the order the converter processes them, followed by the
+// community ingress-nginx annotations it translates. It is read from the feature
+// registry the converter runs, so it stays in sync with the conversion.
+func SupportedFeatures() []annotations.AnnotationSupport {
+	var supported []annotations.AnnotationSupport
+	for _, feature := range annotations.Features(annotations.FeatureOptions{}) {
+		supported = append(supported, feature.Annotations...)
+	}
+	return append(supported, annotations.CommunityAnnotations()...)
+}
+
+// AnnotationUsage returns the annotations of the Ingresses read by the provider
+// whose prefix is the one of a supported feature, e.g. nginx.org/, with their
+// support and the number of Ingresses using them, most used first. The
+// annotations which are not supported features are reported as unknown.
+func (p *Provider) AnnotationUsage() []i2gw.AnnotationUsage {
+	if p.storage == nil {
+		return nil
+	}
+	support := map[string]string{}
+	prefixes := sets.New[string]()
+	for _, feature := range SupportedFeatures() {
+		support[feature.Annotation] = string(feature.Support)
+		if prefix, _, ok := strings.Cut(feature.Annotation, "/"); ok {
+			prefixes.Insert(prefix)
+		}
+	}
+
+	objects := map[string]int{}
+	for _, ingress := range p.storage.Ingresses {
+		for annotation := range ingress.Annotations {
+			if prefix, _, ok := strings.Cut(annotation, "/"); ok && prefixes.Has(prefix) {
+				objects[annotation]++
+			}
+		}
+	}
+
+	usage := make([]i2gw.AnnotationUsage, 0, len(objects))
+	for annotation, count := range objects {
+		usage = append(usage, i2gw.AnnotationUsage{
+			Annotation: annotation,
+			Support:    cmp.Or(support[annotation], i2gw.AnnotationSupportUnknown),
+			Objects:    count,
+		})
+	}
+	slices.SortFunc(usage, func(a, b i2gw.AnnotationUsage) int {
+		return cmp.Or(cmp.Compare(b.Objects, a.Objects), cmp.Compare(a.Annotation, b.Annotation))
+	})
+	return usage
+}
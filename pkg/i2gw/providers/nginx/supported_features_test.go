@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/nginx/annotations"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSupportedFeatures(t *testing.T) {
+	supported := map[string]annotations.SupportLevel{}
+	for _, feature := range SupportedFeatures() {
+		if _, exists := supported[feature.Annotation]; exists {
+			t.Errorf("Annotation %s is listed more than once", feature.Annotation)
+		}
+		switch feature.Support {
+		case annotations.Supported, annotations.PartiallySupported, annotations.NotSupported:
+		default:
+			t.Errorf("Annotation %s has unknown support level %q", feature.Annotation, feature.Support)
+		}
+		if feature.Description == "" {
+			t.Errorf("Annotation %s has no description", feature.Annotation)
+		}
+		supported[feature.Annotation] = feature.Support
+	}
+
+	expected := map[string]annotations.SupportLevel{
+		"nginx.org/redirect-to-https":              annotations.Supported,
+		"nginx.org/lb-method":                      annotations.PartiallySupported,
+		"nginx.org/location-snippets":              annotations.PartiallySupported,
+		"appprotect.f5.com/app-protect-enable":     annotations.NotSupported,
+		"nginx.ingress.kubernetes.io/ssl-redirect": annotations.Supported,
+	}
+	for annotation, support := range expected {
+		if supported[annotation] != support {
+			t.Errorf("Expected %s to be %q, got %q", annotation, support, supported[annotation])
+		}
+	}
+}
+
+func TestAnnotationUsage(t *testing.T) {
+	provider := &Provider{storage: newResourceStorage()}
+	for i, ingressAnnotations := range []map[string]string{
+		{"nginx.org/redirect-to-https": "true", "nginx.org/lb-method": "round_robin", "example.com/team": "a"},
+		{"nginx.org/redirect-to-https": "true", "nginx.org/made-up": "x"},
+	} {
+		ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("ingress-%d", i), Annotations: ingressAnnotations}}
+		provider.storage.Ingresses[types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}] = ingress
+	}
+
+	expected := []i2gw.AnnotationUsage{
+		{Annotation: "nginx.org/redirect-to-https", Support: string(annotations.Supported), Objects: 2},
+		{Annotation: "nginx.org/lb-method", Support: string(annotations.PartiallySupported), Objects: 1},
+		{Annotation: "nginx.org/made-up", Support: i2gw.AnnotationSupportUnknown, Objects: 1},
+	}
+	if diff := cmp.Diff(expected, provider.AnnotationUsage()); diff != "" {
+		t.Errorf("Unexpected annotation usage (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testgen generates synthetic NGINX Ingress Controller clusters, made of
+// Ingresses, their Services and VirtualServers, to benchmark the conversion on
+// clusters of any size. The generated clusters are deterministic for a seed.
+package testgen
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"slices"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Feature is a feature of NGINX Ingress Controller the generated Ingresses use.
+type Feature string
+
+// The features of the generated Ingresses.
+const (
+	// FeatureTLS adds a tls entry for the hosts of the Ingress.
+	FeatureTLS Feature = "tls"
+	// FeatureRedirect redirects the requests to HTTPS.
+	FeatureRedirect Feature = "redirect"
+	// FeatureRewrite rewrites the paths of the first Service of the Ingress.
+	FeatureRewrite Feature = "rewrite"
+	// FeatureHeaders sets request headers and hides response headers.
+	FeatureHeaders Feature = "headers"
+	// FeatureHSTS sets the Strict-Transport-Security header.
+	FeatureHSTS Feature = "hsts"
+	// FeatureGRPC serves the last Service of the Ingress over gRPC.
+	FeatureGRPC Feature = "grpc"
+	// FeatureRegex matches the paths as regular expressions.
+	FeatureRegex Feature = "regex"
+	// FeatureSnippets adds a server_name alias and a health location in server
+	// snippets.
+	FeatureSnippets Feature = "snippets"
+)
+
+// Features lists the supported features.
+var Features = []Feature{FeatureTLS, FeatureRedirect, FeatureRewrite, FeatureHeaders, FeatureHSTS, FeatureGRPC, FeatureRegex, FeatureSnippets}
+
+// Options configures the generated cluster.
+type Options struct {
+	// Namespaces is the number of namespaces the Ingresses are spread over.
+	Namespaces int
+	// Ingresses is the number of Ingresses.
+	Ingresses int
+	// HostsPerIngress is the number of rules, with a host each, of an Ingress.
+	HostsPerIngress int
+	// PathsPerHost is the number of paths of a rule, each to its own Service.
+	PathsPerHost int
+	// VirtualServers is the number of VirtualServers.
+	VirtualServers int
+	// VirtualServerOverlap is the percentage of the VirtualServers routing the
+	// host of an Ingress.
+	VirtualServerOverlap int
+	// Mix is the percentage of the Ingresses using each feature.
+	Mix map[Feature]int
+	// Seed seeds the random selection of the features of the Ingresses.
+	Seed int64
+}
+
+// DefaultOptions returns the options of a cluster of n Ingresses using every
+// feature, in proportions typical of production clusters.
+func DefaultOptions(n int) Options {
+	return Options{
+		Namespaces:           max(1, n/50),
+		Ingresses:            n,
+		HostsPerIngress:      1,
+		PathsPerHost:         3,
+		VirtualServers:       n / 10,
+		VirtualServerOverlap: 10,
+		Mix: map[Feature]int{
+			FeatureTLS:      60,
+			FeatureRedirect: 40,
+			FeatureRewrite:  20,
+			FeatureHeaders:  30,
+			FeatureHSTS:     20,
+			FeatureGRPC:     5,
+			FeatureRegex:    10,
+			FeatureSnippets: 5,
+		},
+		Seed: 1,
+	}
+}
+
+// Cluster is a generated cluster.
+type Cluster struct {
+	Ingresses      []*networkingv1.Ingress
+	Services       []*apiv1.Service
+	VirtualServers []*unstructured.Unstructured
+}
+
+// Generate returns the cluster of the options.
+func Generate(options Options) Cluster {
+	r := rand.New(rand.NewSource(options.Seed)) //nolint:gosec // Synthetic data, not security sensitive.
+	namespaces := max(1, options.Namespaces)
+	hostsPerIngress := max(1, options.HostsPerIngress)
+	pathsPerHost := max(1, options.PathsPerHost)
+
+	var cluster Cluster
+	var hosts []string
+	for i := 0; i < options.Ingresses; i++ {
+		namespace := fmt.Sprintf("ns-%d", i%namespaces)
+		name := fmt.Sprintf("ingress-%d", i)
+		features := map[Feature]bool{}
+		for _, feature := range Features {
+			features[feature] = r.Intn(100) < options.Mix[feature]
+		}
+
+		ingress := &networkingv1.Ingress{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Annotations: map[string]string{}},
+			Spec:       networkingv1.IngressSpec{IngressClassName: ptr.To("nginx")},
+		}
+		var services []string
+		for h := 0; h < hostsPerIngress; h++ {
+			host := fmt.Sprintf("%s-%d.%s.example.com", name, h, namespace)
+			hosts = append(hosts, host)
+			rule := networkingv1.IngressRule{Host: host, IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{}}}
+			for p := 0; p < pathsPerHost; p++ {
+				service := fmt.Sprintf("%s-svc-%d", name, h*pathsPerHost+p)
+				services = append(services, service)
+				path := fmt.Sprintf("/app-%d", p)
+				pathType := networkingv1.PathTypePrefix
+				if features[FeatureRegex] {
+					path = fmt.Sprintf("/app-%d/[0-9]+", p)
+					pathType = networkingv1.PathTypeImplementationSpecific
+				}
+				rule.HTTP.Paths = append(rule.HTTP.Paths, networkingv1.HTTPIngressPath{
+					Path:     path,
+					PathType: ptr.To(pathType),
+					Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+						Name: service,
+						Port: networkingv1.ServiceBackendPort{Name: "http"},
+					}},
+				})
+				cluster.Services = append(cluster.Services, newService(namespace, service))
+			}
+			ingress.Spec.Rules = append(ingress.Spec.Rules, rule)
+		}
+		addFeatures(ingress, features, services)
+		cluster.Ingresses = append(cluster.Ingresses, ingress)
+	}
+
+	for i := 0; i < options.VirtualServers; i++ {
+		namespace := fmt.Sprintf("ns-%d", i%namespaces)
+		host := fmt.Sprintf("vs-%d.%s.example.com", i, namespace)
+		if len(hosts) > 0 && r.Intn(100) < options.VirtualServerOverlap {
+			host = hosts[r.Intn(len(hosts))]
+		}
+		cluster.VirtualServers = append(cluster.VirtualServers, newVirtualServer(namespace, fmt.Sprintf("vs-%d", i), host))
+	}
+	return cluster
+}
+
+// addFeatures sets the annotations and tls entries of the features.
+func addFeatures(ingress *networkingv1.Ingress, features map[Feature]bool, services []string) {
+	if features[FeatureTLS] {
+		tls := networkingv1.IngressTLS{SecretName: ingress.Name + "-tls"}
+		for _, rule := range ingress.Spec.Rules {
+			tls.Hosts = append(tls.Hosts, rule.Host)
+		}
+		ingress.Spec.TLS = []networkingv1.IngressTLS{tls}
+	}
+	if features[FeatureRedirect] {
+		ingress.Annotations["nginx.org/redirect-to-https"] = "true"
+	}
+	if features[FeatureRewrite] {
+		ingress.Annotations["nginx.org/rewrites"] = fmt.Sprintf("serviceName=%s rewrite=/", services[0])
+	}
+	if features[FeatureHeaders] {
+		ingress.Annotations["nginx.org/proxy-set-headers"] = "X-Team: " + ingress.Namespace
+		ingress.Annotations["nginx.org/proxy-hide-headers"] = "Server,X-Powered-By"
+	}
+	if features[FeatureHSTS] {
+		ingress.Annotations["nginx.org/hsts"] = "true"
+		ingress.Annotations["nginx.org/hsts-max-age"] = "63072000"
+	}
+	if features[FeatureGRPC] && len(services) > 1 {
+		ingress.Annotations["nginx.org/grpc-services"] = services[len(services)-1]
+	}
+	if features[FeatureRegex] {
+		ingress.Annotations["nginx.org/path-regex"] = "case_sensitive"
+	}
+	if features[FeatureSnippets] {
+		ingress.Annotations["nginx.org/server-snippets"] = fmt.Sprintf("server_name www.%s;\nlocation = /healthz { return 200 'ok'; }", ingress.Spec.Rules[0].Host)
+	}
+}
+
+func newService(namespace, name string) *apiv1.Service {
+	return &apiv1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: apiv1.ServiceSpec{
+			Selector: map[string]string{"app": name},
+			Ports:    []apiv1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080)}},
+		},
+	}
+}
+
+func newVirtualServer(namespace, name, host string) *unstructured.Unstructured {
+	service := name + "-svc"
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "k8s.nginx.org/v1",
+		"kind":       "VirtualServer",
+		"metadata":   map[string]interface{}{"namespace": namespace, "name": name},
+		"spec": map[string]interface{}{
+			"host": host,
+			"upstreams": []interface{}{
+				map[string]interface{}{"name": "backend", "service": service, "port": int64(80), "keepalive": int64(32)},
+			},
+			"routes": []interface{}{
+				map[string]interface{}{"path": "/", "action": map[string]interface{}{"pass": "backend"}},
+			},
+		},
+	}}
+}
+
+// Objects returns the objects of the cluster.
+func (c Cluster) Objects() []client.Object {
+	objects := make([]client.Object, 0, len(c.Ingresses)+len(c.Services)+len(c.VirtualServers))
+	for _, ingress := range c.Ingresses {
+		objects = append(objects, ingress)
+	}
+	for _, service := range c.Services {
+		objects = append(objects, service)
+	}
+	for _, virtualServer := range c.VirtualServers {
+		objects = append(objects, virtualServer)
+	}
+	return slices.Clip(objects)
+}
+
+// WriteManifests writes the objects of the cluster as a multi-document YAML
+// manifest, which the conversion reads with --input-file.
+func (c Cluster) WriteManifests(w io.Writer) error {
+	printer := &printers.YAMLPrinter{}
+	for _, object := range c.Objects() {
+		if err := printer.PrintObj(object, w); err != nil {
+			return fmt.Errorf("failed to write %s %s: %w", object.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(object), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testgen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerate(t *testing.T) {
+	options := DefaultOptions(200)
+	cluster := Generate(options)
+
+	if len(cluster.Ingresses) != 200 {
+		t.Errorf("Expected 200 Ingresses, got %d", len(cluster.Ingresses))
+	}
+	if len(cluster.Services) != 200*options.PathsPerHost {
+		t.Errorf("Expected %d Services, got %d", 200*options.PathsPerHost, len(cluster.Services))
+	}
+	if len(cluster.VirtualServers) != 20 {
+		t.Errorf("Expected 20 VirtualServers, got %d", len(cluster.VirtualServers))
+	}
+
+	annotations := map[string]bool{}
+	for _, ingress := range cluster.Ingresses {
+		for annotation := range ingress.Annotations {
+			annotations[annotation] = true
+		}
+	}
+	for _, annotation := range []string{"nginx.org/redirect-to-https", "nginx.org/rewrites", "nginx.org/proxy-set-headers", "nginx.org/hsts", "nginx.org/grpc-services", "nginx.org/path-regex", "nginx.org/server-snippets"} {
+		if !annotations[annotation] {
+			t.Errorf("Expected an Ingress annotated with %s", annotation)
+		}
+	}
+
+	if diff := cmp.Diff(cluster, Generate(options)); diff != "" {
+		t.Errorf("Expected the same cluster for the same seed, diff (-first +second):\n%s", diff)
+	}
+}
+
+func TestGenerateWithoutFeatures(t *testing.T) {
+	cluster := Generate(Options{Ingresses: 10, HostsPerIngress: 2, PathsPerHost: 2})
+	for _, ingress := range cluster.Ingresses {
+		if len(ingress.Annotations) != 0 || len(ingress.Spec.TLS) != 0 {
+			t.Errorf("Expected Ingress %s without features, got annotations %v and tls %v", ingress.Name, ingress.Annotations, ingress.Spec.TLS)
+		}
+		if len(ingress.Spec.Rules) != 2 || len(ingress.Spec.Rules[0].HTTP.Paths) != 2 {
+			t.Errorf("Expected Ingress %s with 2 rules of 2 paths, got %v", ingress.Name, ingress.Spec.Rules)
+		}
+	}
+}
+
+func TestWriteManifests(t *testing.T) {
+	cluster := Generate(DefaultOptions(10))
+	var buf bytes.Buffer
+	if err := cluster.WriteManifests(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The documents are separated by "---".
+	if documents := strings.Count(buf.String(), "---\n") + 1; documents != len(cluster.Objects()) {
+		t.Errorf("Expected %d documents, got %d", len(cluster.Objects()), documents)
+	}
+	for _, kind := range []string{"kind: Ingress", "kind: Service", "kind: VirtualServer"} {
+		if !strings.Contains(buf.String(), kind) {
+			t.Errorf("Expected the manifests to contain %q", kind)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/providers/common"
+)
+
+// maxCertificateRefs is the maximum number of certificateRefs of a Gateway listener.
+const maxCertificateRefs = 64
+
+// TLSListenerStrategyFlag selects how HTTPS listeners are generated for hosts
+// sharing a secret.
+const TLSListenerStrategyFlag = "tls-listener-strategy"
+
+const (
+	// perHostTLSListeners generates an HTTPS listener per host.
+	perHostTLSListeners = "per-host"
+	// wildcardTLSListeners merges the HTTPS listeners of sibling hosts sharing
+	// the same certificates into a single wildcard listener.
+	wildcardTLSListeners = "wildcard"
+)
+
+// parseTLSListenerStrategy validates the TLS listener strategy flag.
+func parseTLSListenerStrategy(value string) (string, field.ErrorList) {
+	switch value {
+	case "":
+		return perHostTLSListeners, nil
+	case perHostTLSListeners, wildcardTLSListeners:
+		return value, nil
+	default:
+		return "", field.ErrorList{field.NotSupported(field.NewPath(TLSListenerStrategyFlag), value, []string{perHostTLSListeners, wildcardTLSListeners})}
+	}
+}
+
+// applyHostCertificates sets the certificateRefs of the HTTPS listeners to the
+// secrets of the Ingress tls entries listing the listener hostname, in the order
+// they are declared. NGINX Ingress Controller serves every certificate configured
+// for a host, e.g. an RSA and an ECDSA certificate, so all of them are kept, but
+// secrets configured for other hosts of the same Ingress are not.
+func applyHostCertificates(ingresses []networkingv1.Ingress, ir *intermediate.IR) {
+	secretsByHost := map[types.NamespacedName][]gatewayv1.ObjectName{}
+	for _, ingress := range ingresses {
+		for _, tls := range ingress.Spec.TLS {
+			for _, host := range tls.Hosts {
+				key := types.NamespacedName{Namespace: ingress.Namespace, Name: host}
+				if !slices.Contains(secretsByHost[key], gatewayv1.ObjectName(tls.SecretName)) {
+					secretsByHost[key] = append(secretsByHost[key], gatewayv1.ObjectName(tls.SecretName))
+				}
+			}
+		}
+	}
+
+	for key, gatewayContext := range ir.Gateways {
+		for i, listener := range gatewayContext.Spec.Listeners {
+			if listener.TLS == nil || listener.Hostname == nil {
+				continue
+			}
+			secrets, ok := secretsByHost[types.NamespacedName{Namespace: key.Namespace, Name: string(*listener.Hostname)}]
+			if !ok {
+				continue
+			}
+			if len(secrets) > maxCertificateRefs {
+				notify(notifications.WarningNotification, fmt.Sprintf("listener %s has %d certificates, only the first %d are kept", listener.Name, len(secrets), maxCertificateRefs), &gatewayContext.Gateway)
+				secrets = secrets[:maxCertificateRefs]
+			}
+
+			certificateRefs := make([]gatewayv1.SecretObjectReference, 0, len(secrets))
+			for _, secret := range secrets {
+				certificateRefs = append(certificateRefs, gatewayv1.SecretObjectReference{Name: secret})
+			}
+			tlsConfig := listener.TLS.DeepCopy()
+			tlsConfig.CertificateRefs = certificateRefs
+			gatewayContext.Spec.Listeners[i].TLS = tlsConfig
+		}
+		ir.Gateways[key] = gatewayContext
+	}
+}
+
+// reportUnmatchedTLSHosts warns about the hosts of the Ingress tls entries which are
+// not the host of any rule of the Ingress. No listener is generated for them.
+func reportUnmatchedTLSHosts(ingresses []networkingv1.Ingress) {
+	for i := range ingresses {
+		ingress := &ingresses[i]
+		ruleHosts := map[string]bool{}
+		for _, rule := range ingress.Spec.Rules {
+			ruleHosts[rule.Host] = true
+		}
+		if ruleHosts[""] {
+			// Rules without a host serve every TLS host.
+			continue
+		}
+		for _, tls := range ingress.Spec.TLS {
+			for _, host := range tls.Hosts {
+				if !ruleHosts[host] {
+					notify(notifications.WarningNotification, fmt.Sprintf("tls host %s has no matching rule, no listener was generated for it", host), ingress)
+				}
+			}
+		}
+	}
+}
+
+// wildcardListenerGroup identifies HTTPS listeners which can be merged into a
+// single wildcard listener.
+type wildcardListenerGroup struct {
+	wildcard        gatewayv1.Hostname
+	port            gatewayv1.PortNumber
+	certificateRefs string
+}
+
+// mergeWildcardListeners replaces the HTTPS listeners of sibling hosts, e.g.
+// a.example.com and b.example.com, which use the same certificates with a single
+// listener for the wildcard of their parent domain, e.g. *.example.com. The
+// wildcard listener also accepts other subdomains, which is reported.
+func mergeWildcardListeners(ir *intermediate.IR) {
+	for key, gatewayContext := range ir.Gateways {
+		groups := map[wildcardListenerGroup][]int{}
+		hostnames := map[gatewayv1.Hostname]bool{}
+		for i, listener := range gatewayContext.Spec.Listeners {
+			if listener.Hostname == nil {
+				continue
+			}
+			hostnames[*listener.Hostname] = true
+			if listener.Protocol != gatewayv1.HTTPSProtocolType || listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0 {
+				continue
+			}
+			hostname := string(*listener.Hostname)
+			_, parent, found := strings.Cut(hostname, ".")
+			if strings.HasPrefix(hostname, "*") || !found || !strings.Contains(parent, ".") {
+				continue
+			}
+			group := wildcardListenerGroup{
+				wildcard:        gatewayv1.Hostname("*." + parent),
+				port:            listener.Port,
+				certificateRefs: fmt.Sprint(listener.TLS.CertificateRefs),
+			}
+			groups[group] = append(groups[group], i)
+		}
+
+		mergedGroups := make([]wildcardListenerGroup, 0, len(groups))
+		for group, indexes := range groups {
+			if len(indexes) > 1 && !hostnames[group.wildcard] {
+				mergedGroups = append(mergedGroups, group)
+			}
+		}
+		if len(mergedGroups) == 0 {
+			continue
+		}
+		slices.SortFunc(mergedGroups, func(a, b wildcardListenerGroup) int {
+			return cmp.Or(cmp.Compare(a.wildcard, b.wildcard), cmp.Compare(a.port, b.port))
+		})
+
+		removed := map[int]bool{}
+		var wildcardListeners []gatewayv1.Listener
+		for _, group := range mergedGroups {
+			indexes := groups[group]
+			var merged []string
+			for _, i := range indexes {
+				removed[i] = true
+				merged = append(merged, string(*gatewayContext.Spec.Listeners[i].Hostname))
+			}
+			wildcard := group.wildcard
+			wildcardListeners = append(wildcardListeners, gatewayv1.Listener{
+				Name:     common.ListenerName(string(wildcard), gatewayv1.HTTPSProtocolType),
+				Hostname: &wildcard,
+				Port:     group.port,
+				Protocol: gatewayv1.HTTPSProtocolType,
+				TLS:      gatewayContext.Spec.Listeners[indexes[0]].TLS.DeepCopy(),
+			})
+			notify(notifications.InfoNotification, fmt.Sprintf("HTTPS listeners for %s were merged into a %s listener, which also accepts TLS connections for other subdomains", strings.Join(merged, ", "), wildcard), &gatewayContext.Gateway)
+		}
+
+		listeners := make([]gatewayv1.Listener, 0, len(gatewayContext.Spec.Listeners)-len(removed)+len(wildcardListeners))
+		for i, listener := range gatewayContext.Spec.Listeners {
+			if !removed[i] {
+				listeners = append(listeners, listener)
+			}
+		}
+		gatewayContext.Spec.Listeners = append(listeners, wildcardListeners...)
+		common.DisambiguateListenerNames(&gatewayContext.Gateway)
+		ir.Gateways[key] = gatewayContext
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestApplyHostCertificates(t *testing.T) {
+	ingress := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ptr.To("nginx"),
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"foo.example.com"}, SecretName: "foo-rsa"},
+				{Hosts: []string{"foo.example.com"}, SecretName: "foo-ecdsa"},
+				{Hosts: []string{"bar.example.com", "foo.example.com"}, SecretName: "foo-rsa"},
+				{Hosts: []string{"bar.example.com"}, SecretName: "bar"},
+			},
+			Rules: []networkingv1.IngressRule{
+				ingressRule("foo.example.com"),
+				ingressRule("bar.example.com"),
+			},
+		},
+	}
+
+	converter := newResourcesToIRConverter(&i2gw.ProviderConf{})
+	ir, errs := converter.convert(&storage{Ingresses: map[types.NamespacedName]*networkingv1.Ingress{
+		{Namespace: "default", Name: "app"}: &ingress,
+	}})
+	if len(errs) > 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
+	expectedRefs := map[string][]gatewayv1.SecretObjectReference{
+		"foo-example-com-https": {{Name: "foo-rsa"}, {Name: "foo-ecdsa"}},
+		"bar-example-com-https": {{Name: "foo-rsa"}, {Name: "bar"}},
+	}
+	gateway := ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}]
+	for _, listener := range gateway.Spec.Listeners {
+		expected, ok := expectedRefs[string(listener.Name)]
+		if !ok {
+			continue
+		}
+		delete(expectedRefs, string(listener.Name))
+		if !reflect.DeepEqual(listener.TLS.CertificateRefs, expected) {
+			t.Errorf("Expected listener %s certificateRefs %v, got %v", listener.Name, expected, listener.TLS.CertificateRefs)
+		}
+	}
+	if len(expectedRefs) > 0 {
+		t.Errorf("Expected listeners not found: %v", expectedRefs)
+	}
+}
+
+func TestTLSListenerStrategy(t *testing.T) {
+	tests := []struct {
+		name             string
+		strategy         string
+		expectErrors     bool
+		expectedHTTPS    []string
+		expectedWarnings int
+	}{
+		{
+			name:             "per-host listeners by default",
+			expectedHTTPS:    []string{"a.example.com", "b.example.com", "c.example.com"},
+			expectedWarnings: 1,
+		},
+		{
+			name:             "wildcard listener for hosts sharing a secret",
+			strategy:         wildcardTLSListeners,
+			expectedHTTPS:    []string{"c.example.com", "*.example.com"},
+			expectedWarnings: 1,
+		},
+		{
+			name:         "unknown strategy",
+			strategy:     "single",
+			expectErrors: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			ingress := networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					TLS: []networkingv1.IngressTLS{
+						{Hosts: []string{"a.example.com", "b.example.com", "unused.example.com"}, SecretName: "shared"},
+						{Hosts: []string{"c.example.com"}, SecretName: "c"},
+					},
+					Rules: []networkingv1.IngressRule{
+						ingressRule("a.example.com"),
+						ingressRule("b.example.com"),
+						ingressRule("c.example.com"),
+					},
+				},
+			}
+
+			flags := map[string]map[string]string{Name: {TLSListenerStrategyFlag: tt.strategy}}
+			converter := newResourcesToIRConverter(&i2gw.ProviderConf{ProviderSpecificFlags: flags})
+			ir, errs := converter.convert(&storage{Ingresses: map[types.NamespacedName]*networkingv1.Ingress{
+				{Namespace: "default", Name: "app"}: &ingress,
+			}})
+			if tt.expectErrors {
+				if len(errs) == 0 {
+					t.Errorf("Expected errors, got none")
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatalf("Expected no errors, got %v", errs)
+			}
+
+			var https []string
+			for _, listener := range ir.Gateways[types.NamespacedName{Namespace: "default", Name: "nginx"}].Spec.Listeners {
+				if listener.Protocol == gatewayv1.HTTPSProtocolType {
+					https = append(https, string(*listener.Hostname))
+				}
+			}
+			if !reflect.DeepEqual(https, tt.expectedHTTPS) {
+				t.Errorf("Expected HTTPS listeners %v, got %v", tt.expectedHTTPS, https)
+			}
+
+			warnings := 0
+			for _, notification := range notifications.NotificationAggr.Notifications[Name] {
+				if notification.Type == notifications.WarningNotification {
+					warnings++
+				}
+			}
+			if warnings != tt.expectedWarnings {
+				t.Errorf("Expected %d warnings, got %d", tt.expectedWarnings, warnings)
+			}
+		})
+	}
+}
+
+func ingressRule(host string) networkingv1.IngressRule {
+	return networkingv1.IngressRule{
+		Host: host,
+		IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+			Paths: []networkingv1.HTTPIngressPath{{
+				Path:     "/",
+				PathType: ptr.To(networkingv1.PathTypePrefix),
+				Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+					Name: "app",
+					Port: networkingv1.ServiceBackendPort{Number: 80},
+				}},
+			}},
+		}},
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// VirtualServerPrecedenceFlag selects which of an Ingress and a VirtualServer
+// routing the same host the host is migrated from.
+const VirtualServerPrecedenceFlag = "virtual-server-precedence"
+
+const (
+	// preferIngress converts the hosts of the Ingresses, and reports the
+	// VirtualServers routing them.
+	preferIngress = "prefer-ingress"
+	// preferCRD does not convert the rules of the Ingresses for the hosts a
+	// VirtualServer routes.
+	preferCRD = "prefer-crd"
+)
+
+// parseVirtualServerPrecedence validates the VirtualServer precedence flag.
+func parseVirtualServerPrecedence(value string) (string, field.ErrorList) {
+	switch value {
+	case "":
+		return preferIngress, nil
+	case preferIngress, preferCRD:
+		return value, nil
+	default:
+		return "", field.ErrorList{field.NotSupported(field.NewPath(VirtualServerPrecedenceFlag), value, []string{preferIngress, preferCRD})}
+	}
+}
+
+// virtualServerHosts returns the sorted VirtualServers routing each host.
+// VirtualServerRoutes are ignored, since their host is the one of the
+// VirtualServer referencing them.
+func virtualServerHosts(virtualServers []*unstructured.Unstructured) map[string][]types.NamespacedName {
+	hosts := map[string][]types.NamespacedName{}
+	for _, virtualServer := range virtualServers {
+		if virtualServer.GetKind() != "VirtualServer" {
+			continue
+		}
+		host, _, _ := unstructured.NestedString(virtualServer.Object, "spec", "host")
+		if host == "" {
+			continue
+		}
+		hosts[host] = append(hosts[host], types.NamespacedName{Namespace: virtualServer.GetNamespace(), Name: virtualServer.GetName()})
+	}
+	for _, keys := range hosts {
+		slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+			return strings.Compare(a.String(), b.String())
+		})
+	}
+	return hosts
+}
+
+// correlateVirtualServerHosts reports the hosts of the Ingresses a VirtualServer
+// routes as well, which NGINX Ingress Controller serves from only one of them
+// and which would be claimed twice once both are migrated. With preferCRD, the
+// rules and tls hosts of the Ingresses for those hosts are removed, as well as
+// the Ingresses left without rule nor default backend, so that the hosts are
+// migrated from the VirtualServers. With preferIngress, the Ingresses are
+// converted unchanged.
+func correlateVirtualServerHosts(ingresses []networkingv1.Ingress, virtualServers []*unstructured.Unstructured, precedence string) []networkingv1.Ingress {
+	vsHosts := virtualServerHosts(virtualServers)
+	if len(vsHosts) == 0 {
+		return ingresses
+	}
+
+	correlated := make([]networkingv1.Ingress, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		var overlapping []string
+		for _, rule := range ingress.Spec.Rules {
+			if _, ok := vsHosts[rule.Host]; ok && !slices.Contains(overlapping, rule.Host) {
+				overlapping = append(overlapping, rule.Host)
+			}
+		}
+		if len(overlapping) == 0 {
+			correlated = append(correlated, ingress)
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+		for _, host := range overlapping {
+			if precedence == preferCRD {
+				notify(notifications.WarningNotification, fmt.Sprintf("The rules of Ingress %s for host %s were not converted since VirtualServer %s routes the same host: migrate the host from the VirtualServer, which is not converted",
+					key, host, joinNamespacedNames(vsHosts[host])), &ingress)
+				continue
+			}
+			notify(notifications.WarningNotification, fmt.Sprintf("Ingress %s and VirtualServer %s both route host %s: the host is converted from the Ingress, do not migrate it from the VirtualServer as well or both would claim the hostname. Set --%s-%s=%s to leave the host to the VirtualServer",
+				key, joinNamespacedNames(vsHosts[host]), host, Name, VirtualServerPrecedenceFlag, preferCRD), &ingress)
+		}
+		if precedence != preferCRD {
+			correlated = append(correlated, ingress)
+			continue
+		}
+
+		overlaps := func(host string) bool { return slices.Contains(overlapping, host) }
+		filtered := ingress.DeepCopy()
+		filtered.Spec.Rules = slices.DeleteFunc(filtered.Spec.Rules, func(rule networkingv1.IngressRule) bool {
+			return overlaps(rule.Host)
+		})
+		tls := filtered.Spec.TLS[:0]
+		for _, entry := range filtered.Spec.TLS {
+			hosts := slices.DeleteFunc(slices.Clone(entry.Hosts), overlaps)
+			if len(entry.Hosts) > 0 && len(hosts) == 0 {
+				continue
+			}
+			entry.Hosts = hosts
+			tls = append(tls, entry)
+		}
+		filtered.Spec.TLS = tls
+		if len(filtered.Spec.Rules) == 0 && filtered.Spec.DefaultBackend == nil {
+			notify(notifications.WarningNotification, fmt.Sprintf("Ingress %s was not converted since VirtualServers route all its hosts", key), &ingress)
+			continue
+		}
+		correlated = append(correlated, *filtered)
+	}
+	return correlated
+}
+
+// joinNamespacedNames returns the comma-separated names.
+func joinNamespacedNames(keys []types.NamespacedName) string {
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, key.String())
+	}
+	return strings.Join(names, ", ")
+}
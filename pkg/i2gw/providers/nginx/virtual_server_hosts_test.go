@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestCorrelateVirtualServerHosts(t *testing.T) {
+	virtualServer := func(kind, name, host string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k8s.nginx.org/v1",
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+			"spec":       map[string]interface{}{"host": host},
+		}}
+	}
+	virtualServers := []*unstructured.Unstructured{
+		virtualServer("VirtualServer", "cafe", "cafe.example.com"),
+		virtualServer("VirtualServerRoute", "tea", "tea.example.com"),
+	}
+	ingresses := []networkingv1.Ingress{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mixed"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "cafe.example.com"}, {Host: "tea.example.com"}},
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"cafe.example.com"}, SecretName: "cafe"},
+				{Hosts: []string{"cafe.example.com", "tea.example.com"}, SecretName: "shared"},
+			},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"},
+		Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "cafe.example.com"}}},
+	}}
+
+	testCases := []struct {
+		precedence       string
+		expectedRules    map[string][]string
+		expectedTLS      map[string][]networkingv1.IngressTLS
+		expectedMessages []string
+	}{{
+		precedence:    preferIngress,
+		expectedRules: map[string][]string{"mixed": {"cafe.example.com", "tea.example.com"}, "cafe": {"cafe.example.com"}},
+		expectedTLS:   map[string][]networkingv1.IngressTLS{"mixed": ingresses[0].Spec.TLS, "cafe": nil},
+		expectedMessages: []string{
+			"Ingress default/mixed and VirtualServer default/cafe both route host cafe.example.com",
+			"Ingress default/cafe and VirtualServer default/cafe both route host cafe.example.com",
+		},
+	}, {
+		precedence:    preferCRD,
+		expectedRules: map[string][]string{"mixed": {"tea.example.com"}},
+		expectedTLS:   map[string][]networkingv1.IngressTLS{"mixed": {{Hosts: []string{"tea.example.com"}, SecretName: "shared"}}},
+		expectedMessages: []string{
+			"The rules of Ingress default/mixed for host cafe.example.com were not converted since VirtualServer default/cafe routes the same host",
+			"The rules of Ingress default/cafe for host cafe.example.com were not converted",
+			"Ingress default/cafe was not converted since VirtualServers route all its hosts",
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.precedence, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+			correlated := correlateVirtualServerHosts(ingresses, virtualServers, tc.precedence)
+
+			rules := map[string][]string{}
+			tls := map[string][]networkingv1.IngressTLS{}
+			for _, ingress := range correlated {
+				for _, rule := range ingress.Spec.Rules {
+					rules[ingress.Name] = append(rules[ingress.Name], rule.Host)
+				}
+				tls[ingress.Name] = ingress.Spec.TLS
+			}
+			if diff := cmp.Diff(tc.expectedRules, rules); diff != "" {
+				t.Errorf("Unexpected rules (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedTLS, tls); diff != "" {
+				t.Errorf("Unexpected tls entries (-want +got):\n%s", diff)
+			}
+
+			dispatched := notifications.NotificationAggr.Notifications[Name]
+			if len(dispatched) != len(tc.expectedMessages) {
+				t.Fatalf("Expected %d notifications, got %d: %v", len(tc.expectedMessages), len(dispatched), dispatched)
+			}
+			for i, message := range tc.expectedMessages {
+				if dispatched[i].Type != notifications.WarningNotification || !strings.HasPrefix(dispatched[i].Message, message) {
+					t.Errorf("Expected warning %q, got %s %q", message, dispatched[i].Type, dispatched[i].Message)
+				}
+			}
+		})
+	}
+
+	if len(ingresses[0].Spec.Rules) != 2 || len(ingresses[0].Spec.TLS[1].Hosts) != 2 {
+		t.Errorf("Expected the source Ingresses to be left unchanged, got %v", ingresses[0].Spec)
+	}
+}
+
+func TestParseVirtualServerPrecedence(t *testing.T) {
+	if precedence, errs := parseVirtualServerPrecedence(""); precedence != preferIngress || len(errs) > 0 {
+		t.Errorf("Expected %s by default, got %q, %v", preferIngress, precedence, errs)
+	}
+	if _, errs := parseVirtualServerPrecedence("prefer-virtualserver"); len(errs) != 1 {
+		t.Errorf("Expected an error for an unsupported precedence, got %v", errs)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// virtualServerRouteReference is a route of a VirtualServer delegating its path
+// to a VirtualServerRoute.
+type virtualServerRouteReference struct {
+	virtualServer *unstructured.Unstructured
+	path          string
+}
+
+func (r virtualServerRouteReference) String() string {
+	return fmt.Sprintf("VirtualServer %s/%s route %s", r.virtualServer.GetNamespace(), r.virtualServer.GetName(), r.path)
+}
+
+// checkVirtualServerRoutes checks the references of the routes of the
+// VirtualServers to VirtualServerRoutes as NGINX Ingress Controller does, so
+// that the routes it rejects are reported before their migration: references
+// to missing VirtualServerRoutes, VirtualServerRoutes referenced by several
+// routes, whose host is not the one of the VirtualServer, whose subroutes
+// reference other VirtualServerRoutes, which NGINX Ingress Controller does not
+// support, and whose subroute paths are outside the path of the route: a
+// subroute path must begin with the prefix of the route, or be the same regex
+// or exact path. Since subroutes cannot delegate further, the references
+// cannot chain nor cycle. VirtualServerRoutes no route references are reported
+// as not served.
+func checkVirtualServerRoutes(virtualServers []*unstructured.Unstructured) {
+	virtualServerRoutes := map[types.NamespacedName]*unstructured.Unstructured{}
+	for _, virtualServer := range virtualServers {
+		if virtualServer.GetKind() == "VirtualServerRoute" {
+			virtualServerRoutes[types.NamespacedName{Namespace: virtualServer.GetNamespace(), Name: virtualServer.GetName()}] = virtualServer
+		}
+	}
+
+	references := map[types.NamespacedName][]virtualServerRouteReference{}
+	for _, virtualServer := range virtualServers {
+		if virtualServer.GetKind() != "VirtualServer" {
+			continue
+		}
+		routes, _, _ := unstructured.NestedSlice(virtualServer.Object, "spec", "routes")
+		paths := sets.New[string]()
+		for _, r := range routes {
+			route, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			path, _, _ := unstructured.NestedString(route, "path")
+			if paths.Has(path) {
+				notify(notifications.ErrorNotification, fmt.Sprintf("VirtualServer %s/%s has several routes with the path %s, NGINX Ingress Controller rejects it",
+					virtualServer.GetNamespace(), virtualServer.GetName(), path), virtualServer)
+			}
+			paths.Insert(path)
+
+			ref, _, _ := unstructured.NestedString(route, "route")
+			if ref == "" {
+				continue
+			}
+			key := virtualServerRouteKey(ref, virtualServer.GetNamespace())
+			reference := virtualServerRouteReference{virtualServer: virtualServer, path: path}
+			if _, ok := virtualServerRoutes[key]; !ok {
+				notify(notifications.ErrorNotification, fmt.Sprintf("%s references the VirtualServerRoute %s, which does not exist", reference, key), virtualServer)
+				continue
+			}
+			references[key] = append(references[key], reference)
+		}
+	}
+
+	for _, key := range sortedKeys(virtualServerRoutes) {
+		virtualServerRoute := virtualServerRoutes[key]
+		routeReferences := references[key]
+		if len(routeReferences) == 0 {
+			notify(notifications.WarningNotification, fmt.Sprintf("VirtualServerRoute %s is not referenced by any VirtualServer route, NGINX Ingress Controller does not serve it", key), virtualServerRoute)
+			continue
+		}
+		if len(routeReferences) > 1 {
+			descriptions := make([]string, 0, len(routeReferences))
+			for _, reference := range routeReferences {
+				descriptions = append(descriptions, reference.String())
+			}
+			notify(notifications.ErrorNotification, fmt.Sprintf("VirtualServerRoute %s is referenced by several routes, %s, NGINX Ingress Controller serves it for a single route",
+				key, strings.Join(descriptions, ", ")), virtualServerRoute)
+		}
+		for _, reference := range routeReferences {
+			checkVirtualServerRoute(key, virtualServerRoute, reference)
+		}
+	}
+}
+
+// checkVirtualServerRoute checks the host and subroutes of the
+// VirtualServerRoute against the route referencing it.
+func checkVirtualServerRoute(key types.NamespacedName, virtualServerRoute *unstructured.Unstructured, reference virtualServerRouteReference) {
+	host, _, _ := unstructured.NestedString(virtualServerRoute.Object, "spec", "host")
+	virtualServerHost, _, _ := unstructured.NestedString(reference.virtualServer.Object, "spec", "host")
+	if host != virtualServerHost {
+		notify(notifications.ErrorNotification, fmt.Sprintf("VirtualServerRoute %s has the host %s, but %s has the host %s, NGINX Ingress Controller rejects it",
+			key, host, reference, virtualServerHost), virtualServerRoute)
+	}
+
+	subroutes, _, _ := unstructured.NestedSlice(virtualServerRoute.Object, "spec", "subroutes")
+	paths := sets.New[string]()
+	for _, s := range subroutes {
+		subroute, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path, _, _ := unstructured.NestedString(subroute, "path")
+		if paths.Has(path) {
+			notify(notifications.ErrorNotification, fmt.Sprintf("VirtualServerRoute %s has several subroutes with the path %s, NGINX Ingress Controller rejects it", key, path), virtualServerRoute)
+		}
+		paths.Insert(path)
+
+		if ref, _, _ := unstructured.NestedString(subroute, "route"); ref != "" {
+			notify(notifications.ErrorNotification, fmt.Sprintf("VirtualServerRoute %s subroute %s references the VirtualServerRoute %s, NGINX Ingress Controller does not support nested VirtualServerRoutes",
+				key, path, virtualServerRouteKey(ref, key.Namespace)), virtualServerRoute)
+		}
+		if !subroutePathAllowed(reference.path, path) {
+			notify(notifications.ErrorNotification, fmt.Sprintf("VirtualServerRoute %s subroute %s is outside the path %s of %s, NGINX Ingress Controller rejects it: the subroute path must be changed to %s before the migration",
+				key, path, reference.path, reference, prefixedSubroutePath(reference.path, path)), virtualServerRoute)
+		}
+	}
+}
+
+// subroutePathAllowed tells whether NGINX Ingress Controller accepts the path of
+// a subroute of the route path: the same path for regex (~) and exact (=)
+// paths, a path beginning with the route path otherwise.
+func subroutePathAllowed(routePath, subroutePath string) bool {
+	if strings.HasPrefix(routePath, "~") || strings.HasPrefix(routePath, "=") {
+		return subroutePath == routePath
+	}
+	return strings.HasPrefix(subroutePath, routePath)
+}
+
+// prefixedSubroutePath returns the path NGINX Ingress Controller accepts for the
+// subroute path of the route path: the route path itself for regex and exact
+// paths, the subroute path under the route path otherwise, e.g. /tea/green for
+// the subroute /green of the route /tea.
+func prefixedSubroutePath(routePath, subroutePath string) string {
+	if strings.HasPrefix(routePath, "~") || strings.HasPrefix(routePath, "=") {
+		return routePath
+	}
+	if strings.HasPrefix(subroutePath, "~") || strings.HasPrefix(subroutePath, "=") {
+		// A regex or exact subroute of a prefix route cannot be prefixed.
+		return routePath
+	}
+	return strings.TrimSuffix(routePath, "/") + "/" + strings.TrimPrefix(subroutePath, "/")
+}
+
+// virtualServerRouteKey returns the VirtualServerRoute a route references as
+// <namespace>/<name>, or <name> in the namespace of the VirtualServer.
+func virtualServerRouteKey(ref, namespace string) types.NamespacedName {
+	if before, after, found := strings.Cut(ref, "/"); found {
+		return types.NamespacedName{Namespace: before, Name: after}
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref}
+}
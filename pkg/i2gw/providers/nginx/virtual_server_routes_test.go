@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestCheckVirtualServerRoutes(t *testing.T) {
+	virtualServer := func(name, host string, routes ...map[string]interface{}) *unstructured.Unstructured {
+		var list []interface{}
+		for _, route := range routes {
+			list = append(list, route)
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k8s.nginx.org/v1",
+			"kind":       "VirtualServer",
+			"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+			"spec":       map[string]interface{}{"host": host, "routes": list},
+		}}
+	}
+	virtualServerRoute := func(name, host string, subroutes ...map[string]interface{}) *unstructured.Unstructured {
+		var list []interface{}
+		for _, subroute := range subroutes {
+			list = append(list, subroute)
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k8s.nginx.org/v1",
+			"kind":       "VirtualServerRoute",
+			"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+			"spec":       map[string]interface{}{"host": host, "subroutes": list},
+		}}
+	}
+	route := func(path, ref string) map[string]interface{} {
+		return map[string]interface{}{"path": path, "route": ref}
+	}
+	subroute := func(path string) map[string]interface{} {
+		return map[string]interface{}{"path": path, "action": map[string]interface{}{"pass": "tea"}}
+	}
+
+	testCases := []struct {
+		name             string
+		virtualServers   []*unstructured.Unstructured
+		expectedMessages []string
+	}{{
+		name: "valid references",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea"), route("~ ^/coffee", "default/coffee")),
+			virtualServerRoute("tea", "cafe.example.com", subroute("/tea"), subroute("/tea/green")),
+			virtualServerRoute("coffee", "cafe.example.com", subroute("~ ^/coffee")),
+		},
+	}, {
+		name: "missing and unreferenced VirtualServerRoutes",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea")),
+			virtualServerRoute("coffee", "cafe.example.com", subroute("/coffee")),
+		},
+		expectedMessages: []string{
+			"VirtualServer default/cafe route /tea references the VirtualServerRoute default/tea, which does not exist",
+			"VirtualServerRoute default/coffee is not referenced by any VirtualServer route",
+		},
+	}, {
+		name: "VirtualServerRoute referenced by several routes with different prefixes",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea")),
+			virtualServer("shop", "cafe.example.com", route("/shop", "tea")),
+			virtualServerRoute("tea", "cafe.example.com", subroute("/tea/green")),
+		},
+		expectedMessages: []string{
+			"VirtualServerRoute default/tea is referenced by several routes, VirtualServer default/cafe route /tea, VirtualServer default/shop route /shop",
+			"VirtualServerRoute default/tea subroute /tea/green is outside the path /shop of VirtualServer default/shop route /shop",
+		},
+	}, {
+		name: "duplicate route paths",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea"), route("/tea", "")),
+			virtualServerRoute("tea", "cafe.example.com", subroute("/tea"), subroute("/tea")),
+		},
+		expectedMessages: []string{
+			"VirtualServer default/cafe has several routes with the path /tea",
+			"VirtualServerRoute default/tea has several subroutes with the path /tea",
+		},
+	}, {
+		name: "host mismatch",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea")),
+			virtualServerRoute("tea", "tea.example.com", subroute("/tea")),
+		},
+		expectedMessages: []string{"VirtualServerRoute default/tea has the host tea.example.com, but VirtualServer default/cafe route /tea has the host cafe.example.com"},
+	}, {
+		name: "subroute paths outside the route path",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea"), route("= /coffee", "coffee")),
+			virtualServerRoute("tea", "cafe.example.com", subroute("/coffee"), subroute("~ ^/tea")),
+			virtualServerRoute("coffee", "cafe.example.com", subroute("/coffee")),
+		},
+		expectedMessages: []string{
+			"VirtualServerRoute default/coffee subroute /coffee is outside the path = /coffee of VirtualServer default/cafe route = /coffee, NGINX Ingress Controller rejects it: the subroute path must be changed to = /coffee",
+			"VirtualServerRoute default/tea subroute /coffee is outside the path /tea of VirtualServer default/cafe route /tea, NGINX Ingress Controller rejects it: the subroute path must be changed to /tea/coffee",
+			"VirtualServerRoute default/tea subroute ~ ^/tea is outside the path /tea of VirtualServer default/cafe route /tea, NGINX Ingress Controller rejects it: the subroute path must be changed to /tea",
+		},
+	}, {
+		name: "nested VirtualServerRoute",
+		virtualServers: []*unstructured.Unstructured{
+			virtualServer("cafe", "cafe.example.com", route("/tea", "tea")),
+			virtualServerRoute("tea", "cafe.example.com", route("/tea/green", "tea")),
+		},
+		expectedMessages: []string{"VirtualServerRoute default/tea subroute /tea/green references the VirtualServerRoute default/tea, NGINX Ingress Controller does not support nested VirtualServerRoutes"},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			checkVirtualServerRoutes(tc.virtualServers)
+
+			messages := notifications.NotificationAggr.Notifications[Name]
+			if len(messages) != len(tc.expectedMessages) {
+				t.Fatalf("Expected %d notifications, got %v", len(tc.expectedMessages), messages)
+			}
+			for i, expected := range tc.expectedMessages {
+				if !strings.Contains(messages[i].Message, expected) {
+					t.Errorf("Expected notification %d to contain %q, got %q", i, expected, messages[i].Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+// virtualServerGroup is the API group of the NGINX Ingress Controller custom
+// resources.
+const virtualServerGroup = "k8s.nginx.org"
+
+// virtualServerKinds are the NGINX Ingress Controller custom resources whose
+// upstreams are read for their connection settings. The resources themselves
+// are not converted.
+var virtualServerKinds = []string{"VirtualServer", "VirtualServerRoute"}
+
+// readVirtualServersFromCluster reads the VirtualServers and VirtualServerRoutes
+// from the cluster.
+func readVirtualServersFromCluster(ctx context.Context, c client.Client) []*unstructured.Unstructured {
+	var virtualServers []*unstructured.Unstructured
+	for _, kind := range virtualServerKinds {
+		virtualServers = append(virtualServers, readCustomResourcesFromCluster(ctx, c, kind, "the connection settings of their upstreams are not reported")...)
+	}
+	return virtualServers
+}
+
+// readCustomResourcesFromCluster reads the NGINX Ingress Controller custom
+// resources of the kind from the cluster. Clusters without the custom resource
+// definition have none, and a failure to list them is reported with its impact
+// instead of failing the conversion.
+func readCustomResourcesFromCluster(ctx context.Context, c client.Client, kind, impact string) []*unstructured.Unstructured {
+	gk := schema.GroupKind{Group: virtualServerGroup, Kind: kind}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gk.WithVersion("v1").GroupVersion().WithKind(kind + "List"))
+	if err := c.List(ctx, list); err != nil {
+		if !meta.IsNoMatchError(err) {
+			notify(notifications.WarningNotification, fmt.Sprintf("failed to read the %s resources, %s: %v", gk, impact, err))
+		}
+		return nil
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		resources = append(resources, &list.Items[i])
+	}
+	return resources
+}
+
+// captureVirtualServerUpstreams captures the keepalive and ntlm fields of the
+// upstreams of the VirtualServers and VirtualServerRoutes in the provider-specific
+// IR of their Services, so that they are reported with the settings of the
+// Ingress annotations.
+func captureVirtualServerUpstreams(virtualServers []*unstructured.Unstructured, ir *intermediate.IR) {
+	for _, virtualServer := range virtualServers {
+		// Malformed upstreams are returned as errors by validateVirtualServers.
+		upstreams, _, _ := unstructured.NestedSlice(virtualServer.Object, "spec", "upstreams")
+		for _, u := range upstreams {
+			upstream, ok := u.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			service, _, _ := unstructured.NestedString(upstream, "service")
+			keepalive, _, _ := unstructured.NestedInt64(upstream, "keepalive")
+			ntlm, _, _ := unstructured.NestedBool(upstream, "ntlm")
+			if service == "" || (keepalive <= 0 && !ntlm) {
+				continue
+			}
+
+			if ir.Services == nil {
+				ir.Services = make(map[types.NamespacedName]intermediate.ProviderSpecificServiceIR)
+			}
+			key := types.NamespacedName{Namespace: virtualServer.GetNamespace(), Name: service}
+			serviceIR := ir.Services[key]
+			if serviceIR.Nginx == nil {
+				serviceIR.Nginx = &intermediate.NginxServiceIR{}
+			}
+			if keepalive > 0 {
+				serviceIR.Nginx.Keepalive = int(keepalive)
+			}
+			serviceIR.Nginx.NTLM = serviceIR.Nginx.NTLM || ntlm
+			ir.Services[key] = serviceIR
+		}
+	}
+}
+
+// reportInternalRoutes reports the VirtualServers with spec.internalRoute set as
+// migration blockers. Those VirtualServers only serve the traffic NGINX Service
+// Mesh routes to NGINX Ingress Controller, which Gateway API cannot express.
+func reportInternalRoutes(virtualServers []*unstructured.Unstructured) {
+	for _, virtualServer := range virtualServers {
+		if virtualServer.GetKind() != "VirtualServer" {
+			continue
+		}
+		if internalRoute, _, _ := unstructured.NestedBool(virtualServer.Object, "spec", "internalRoute"); internalRoute {
+			notify(notifications.BlockerNotification, fmt.Sprintf("VirtualServer %s/%s is an internal route of NGINX Service Mesh, which Gateway API cannot express: the traffic of the mesh to it is not migrated",
+				virtualServer.GetNamespace(), virtualServer.GetName()), virtualServer)
+		}
+	}
+}
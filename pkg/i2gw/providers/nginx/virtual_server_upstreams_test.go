@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestCaptureVirtualServerUpstreams(t *testing.T) {
+	virtualServer := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "k8s.nginx.org/v1",
+		"kind":       "VirtualServer",
+		"metadata":   map[string]interface{}{"name": "intranet", "namespace": "default"},
+		"spec": map[string]interface{}{"upstreams": []interface{}{
+			map[string]interface{}{"name": "sharepoint", "service": "sharepoint", "port": int64(80), "keepalive": int64(16), "ntlm": true},
+			map[string]interface{}{"name": "portal", "service": "portal", "port": int64(80)},
+		}},
+	}}
+	ingresses := map[string]interface{}{"apiVersion": "networking.k8s.io/v1", "kind": "Ingress"}
+	storage, err := readResourcesFromObjects([]*unstructured.Unstructured{virtualServer, {Object: ingresses}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(storage.VirtualServers) != 1 {
+		t.Fatalf("Expected 1 VirtualServer to be read, got %d", len(storage.VirtualServers))
+	}
+
+	ir := intermediate.IR{}
+	captureVirtualServerUpstreams([]*unstructured.Unstructured{virtualServer}, &ir)
+
+	serviceIR := ir.Services[types.NamespacedName{Namespace: "default", Name: "sharepoint"}]
+	if serviceIR.Nginx == nil || serviceIR.Nginx.Keepalive != 16 || !serviceIR.Nginx.NTLM {
+		t.Fatalf("Expected keepalive 16 and NTLM to be captured, got %+v", serviceIR.Nginx)
+	}
+	if _, ok := ir.Services[types.NamespacedName{Namespace: "default", Name: "portal"}]; ok {
+		t.Errorf("Expected no settings to be captured for the upstream without connection settings")
+	}
+}
+
+func TestReportConnectionReuse(t *testing.T) {
+	ir := intermediate.IR{Services: map[types.NamespacedName]intermediate.ProviderSpecificServiceIR{
+		{Namespace: "default", Name: "sharepoint"}: {Nginx: &intermediate.NginxServiceIR{Keepalive: 16, NTLM: true}},
+		{Namespace: "default", Name: "web"}:        {Nginx: &intermediate.NginxServiceIR{Keepalive: 32}},
+	}}
+
+	tests := []struct {
+		target            string
+		expectedKeepalive string
+		expectedBlocker   string
+	}{
+		{
+			target:            gatewayAPIProfile,
+			expectedKeepalive: "Service default/web keeps up to 32 idle connections to its upstream servers for reuse, which Gateway API cannot express",
+			expectedBlocker:   "Service default/sharepoint requires NTLM authentication, which needs every client connection to keep its own upstream connection, but Gateway API has no affinity",
+		},
+		{
+			target:            nginxGatewayFabricProfile,
+			expectedKeepalive: "Service default/web keeps up to 32 idle connections to its upstream servers for reuse, set it with keepAlive.connections of an UpstreamSettingsPolicy targeting the Service",
+			expectedBlocker:   "but NGINX Gateway Fabric does not support NTLM upstreams",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+			profile, err := parseTargetImplementation(tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reportConnectionReuse(ir, profile)
+
+			var keepalive, blockers []string
+			for _, n := range notifications.NotificationAggr.Notifications[Name] {
+				switch n.Type {
+				case notifications.WarningNotification:
+					keepalive = append(keepalive, n.Message)
+				case notifications.BlockerNotification:
+					blockers = append(blockers, n.Message)
+				}
+			}
+			if len(keepalive) != 2 || !strings.Contains(keepalive[1], tt.expectedKeepalive) {
+				t.Errorf("Expected 2 keepalive warnings containing %q, got %v", tt.expectedKeepalive, keepalive)
+			}
+			if len(blockers) != 1 || !strings.Contains(blockers[0], tt.expectedBlocker) {
+				t.Errorf("Expected a blocker containing %q, got %v", tt.expectedBlocker, blockers)
+			}
+		})
+	}
+}
+
+func TestReportInternalRoutes(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	virtualServer := func(name string, internalRoute bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k8s.nginx.org/v1",
+			"kind":       "VirtualServer",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+			"spec":       map[string]interface{}{"internalRoute": internalRoute},
+		}}
+	}
+
+	reportInternalRoutes([]*unstructured.Unstructured{virtualServer("mesh", true), virtualServer("cafe", false)})
+
+	blockers := notifications.NotificationAggr.Notifications[Name]
+	if len(blockers) != 1 || blockers[0].Type != notifications.BlockerNotification ||
+		!strings.Contains(blockers[0].Message, "VirtualServer default/mesh is an internal route of NGINX Service Mesh") {
+		t.Errorf("Expected a blocker for VirtualServer mesh, got %v", blockers)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// upstreamTypes are the protocols of the upstreams of the VirtualServers, http
+// when empty.
+var upstreamTypes = []string{"http", "grpc"}
+
+// validateVirtualServers returns an error for every field of the VirtualServers
+// and VirtualServerRoutes holding data NGINX Ingress Controller rejects. The
+// conversion goes on, so that all the invalid fields are reported together in
+// the failure of the run.
+func validateVirtualServers(virtualServers []*unstructured.Unstructured) field.ErrorList {
+	var errs field.ErrorList
+	for _, virtualServer := range virtualServers {
+		errs = append(errs, validateVirtualServer(virtualServer)...)
+	}
+	return errs
+}
+
+// validateVirtualServer returns an error for every field of the VirtualServer
+// or VirtualServerRoute holding data NGINX Ingress Controller rejects: a
+// malformed list, an upstream without name or service, with a port out of
+// range or an unsupported type, and a route or subroute without path. The path
+// of each error starts with the kind and key of the resource, e.g.
+// VirtualServer.default/cafe.spec.upstreams[0].port.
+func validateVirtualServer(virtualServer *unstructured.Unstructured) field.ErrorList {
+	key := types.NamespacedName{Namespace: virtualServer.GetNamespace(), Name: virtualServer.GetName()}
+	specPath := field.NewPath(virtualServer.GetKind(), key.String(), "spec")
+
+	upstreams, errs := nestedObjects(virtualServer, specPath, "upstreams")
+	for i, upstream := range upstreams {
+		if upstream != nil {
+			errs = append(errs, validateUpstream(upstream, specPath.Child("upstreams").Index(i))...)
+		}
+	}
+
+	routesField := "routes"
+	if virtualServer.GetKind() == "VirtualServerRoute" {
+		routesField = "subroutes"
+	}
+	routes, errList := nestedObjects(virtualServer, specPath, routesField)
+	errs = append(errs, errList...)
+	for i, route := range routes {
+		if route == nil {
+			continue
+		}
+		if path, _, _ := unstructured.NestedString(route, "path"); path == "" {
+			errs = append(errs, field.Required(specPath.Child(routesField).Index(i).Child("path"), "the path of the route is required"))
+		}
+	}
+	return errs
+}
+
+// validateUpstream validates the fields of the upstream the conversion reads.
+func validateUpstream(upstream map[string]interface{}, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for _, name := range []string{"name", "service"} {
+		value, found, err := unstructured.NestedString(upstream, name)
+		switch {
+		case err != nil:
+			errs = append(errs, field.Invalid(path.Child(name), upstream[name], err.Error()))
+		case !found || value == "":
+			errs = append(errs, field.Required(path.Child(name), fmt.Sprintf("the %s of the upstream is required", name)))
+		}
+	}
+
+	port, found, err := unstructured.NestedInt64(upstream, "port")
+	switch {
+	case err != nil:
+		errs = append(errs, field.Invalid(path.Child("port"), upstream["port"], err.Error()))
+	case !found:
+		errs = append(errs, field.Required(path.Child("port"), "the port of the upstream is required"))
+	case port < 1 || port > 65535:
+		errs = append(errs, field.Invalid(path.Child("port"), port, "must be between 1 and 65535"))
+	}
+
+	upstreamType, _, err := unstructured.NestedString(upstream, "type")
+	switch {
+	case err != nil:
+		errs = append(errs, field.Invalid(path.Child("type"), upstream["type"], err.Error()))
+	case upstreamType != "" && !slices.Contains(upstreamTypes, upstreamType):
+		errs = append(errs, field.NotSupported(path.Child("type"), upstreamType, upstreamTypes))
+	}
+	return errs
+}
+
+// nestedObjects returns the objects of the list of the spec field of the
+// resource, and an error when the field is not a list or one of its items is
+// not an object, which is nil in the returned list.
+func nestedObjects(obj *unstructured.Unstructured, specPath *field.Path, name string) ([]map[string]interface{}, field.ErrorList) {
+	list, _, err := unstructured.NestedSlice(obj.Object, "spec", name)
+	if err != nil {
+		value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", name)
+		return nil, field.ErrorList{field.Invalid(specPath.Child(name), value, "must be a list")}
+	}
+	objects := make([]map[string]interface{}, len(list))
+	var errs field.ErrorList
+	for i, item := range list {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			errs = append(errs, field.Invalid(specPath.Child(name).Index(i), item, "must be an object"))
+			continue
+		}
+		objects[i] = object
+	}
+	return objects, errs
+}
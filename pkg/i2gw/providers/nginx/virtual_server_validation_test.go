@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+)
+
+func TestValidateVirtualServer(t *testing.T) {
+	virtualServer := func(kind string, spec map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "k8s.nginx.org/v1",
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"namespace": "default", "name": "cafe"},
+			"spec":       spec,
+		}}
+	}
+	upstream := func(fields map[string]interface{}) map[string]interface{} {
+		u := map[string]interface{}{"name": "tea", "service": "tea-svc", "port": int64(80)}
+		for name, value := range fields {
+			if value == nil {
+				delete(u, name)
+				continue
+			}
+			u[name] = value
+		}
+		return u
+	}
+
+	testCases := []struct {
+		name           string
+		virtualServer  *unstructured.Unstructured
+		expectedErrors []string
+	}{{
+		name: "valid",
+		virtualServer: virtualServer("VirtualServer", map[string]interface{}{
+			"upstreams": []interface{}{upstream(nil), upstream(map[string]interface{}{"type": "grpc"})},
+			"routes":    []interface{}{map[string]interface{}{"path": "/tea"}},
+		}),
+	}, {
+		name: "invalid upstreams",
+		virtualServer: virtualServer("VirtualServer", map[string]interface{}{
+			"upstreams": []interface{}{
+				upstream(map[string]interface{}{"port": int64(70000)}),
+				upstream(map[string]interface{}{"name": nil, "service": "", "port": nil}),
+				upstream(map[string]interface{}{"port": "http", "type": "tcp"}),
+				"tea",
+			},
+		}),
+		expectedErrors: []string{
+			`VirtualServer.default/cafe.spec.upstreams[3]: Invalid value: "tea": must be an object`,
+			`VirtualServer.default/cafe.spec.upstreams[0].port: Invalid value: 70000: must be between 1 and 65535`,
+			`VirtualServer.default/cafe.spec.upstreams[1].name: Required value: the name of the upstream is required`,
+			`VirtualServer.default/cafe.spec.upstreams[1].service: Required value: the service of the upstream is required`,
+			`VirtualServer.default/cafe.spec.upstreams[1].port: Required value: the port of the upstream is required`,
+			`VirtualServer.default/cafe.spec.upstreams[2].port: Invalid value: "http": .port accessor error: http is of the type string, expected int64`,
+			`VirtualServer.default/cafe.spec.upstreams[2].type: Unsupported value: "tcp": supported values: "http", "grpc"`,
+		},
+	}, {
+		name: "malformed routes",
+		virtualServer: virtualServer("VirtualServer", map[string]interface{}{
+			"routes": "/tea",
+		}),
+		expectedErrors: []string{`VirtualServer.default/cafe.spec.routes: Invalid value: "/tea": must be a list`},
+	}, {
+		name: "subroute without path",
+		virtualServer: virtualServer("VirtualServerRoute", map[string]interface{}{
+			"subroutes": []interface{}{map[string]interface{}{"path": "/tea"}, map[string]interface{}{}},
+		}),
+		expectedErrors: []string{`VirtualServerRoute.default/cafe.spec.subroutes[1].path: Required value: the path of the route is required`},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var errs []string
+			for _, err := range validateVirtualServer(tc.virtualServer) {
+				errs = append(errs, err.Error())
+			}
+			if diff := cmp.Diff(tc.expectedErrors, errs); diff != "" {
+				t.Errorf("Unexpected errors (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertReturnsInvalidVirtualServerErrors(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+	storage := newResourceStorage()
+	storage.Ingresses[types.NamespacedName{Namespace: "default", Name: "cafe"}] = &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ptr.To("nginx"),
+			Rules:            []networkingv1.IngressRule{ingressRule("cafe.example.com")},
+		},
+	}
+	storage.VirtualServers = []*unstructured.Unstructured{{Object: map[string]interface{}{
+		"apiVersion": "k8s.nginx.org/v1",
+		"kind":       "VirtualServer",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "tea"},
+		"spec": map[string]interface{}{
+			"host": "tea.example.com",
+			"upstreams": []interface{}{
+				map[string]interface{}{"name": "tea", "service": "tea-svc", "port": int64(0), "keepalive": int64(16)},
+				map[string]interface{}{"name": "coffee", "service": "coffee-svc", "port": int64(80), "type": "udp"},
+			},
+		},
+	}}}
+
+	ir, errs := newResourcesToIRConverter(&i2gw.ProviderConf{}).convert(storage)
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	expected := []string{
+		`VirtualServer.default/tea.spec.upstreams[0].port: Invalid value: 0: must be between 1 and 65535`,
+		`VirtualServer.default/tea.spec.upstreams[1].type: Unsupported value: "udp": supported values: "http", "grpc"`,
+	}
+	if diff := cmp.Diff(expected, messages); diff != "" {
+		t.Errorf("Unexpected errors (-want +got):\n%s", diff)
+	}
+
+	// The conversion goes on, so that the errors of every provider are
+	// reported together.
+	if _, ok := ir.HTTPRoutes[types.NamespacedName{Namespace: "default", Name: "cafe-cafe-example-com"}]; !ok {
+		t.Errorf("Expected the Ingress to be converted, got the HTTPRoutes %v", ir.HTTPRoutes)
+	}
+	if nginxIR := ir.Services[types.NamespacedName{Namespace: "default", Name: "tea-svc"}].Nginx; nginxIR == nil || nginxIR.Keepalive != 16 {
+		t.Errorf("Expected the upstreams to be captured despite the errors, got %v", ir.Services)
+	}
+}
@@ -323,12 +323,12 @@ func toListenerName(protocolAndHostname string) (listenerName gatewayv1.SectionN
 		}
 	}
 
-	var listenerNamePrefix string
-	if hostname != HostWildcard {
-		listenerNamePrefix = fmt.Sprintf("%s-", common.NameFromHost(hostname))
+	listenerHostname := hostname
+	if hostname == HostWildcard {
+		listenerHostname = ""
 	}
 
-	return gatewayv1.SectionName(listenerNamePrefix + protocol), protocol, hostname
+	return common.ListenerName(listenerHostname, gatewayv1.ProtocolType(protocol)), protocol, hostname
 }
 
 // toHTTPRoute builds a Gateway API HTTPRoute object with a given name, for a given gateway parent, set of hostnames,
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ExtractObjectsFromPath extracts all objects from a YAML or JSON file. When
+// the path is a directory, every .yaml, .yml and .json file found in the
+// directory tree is read, in lexical order.
+func ExtractObjectsFromPath(path, namespace string) ([]*unstructured.Unstructured, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %v: %w", path, err)
+	}
+
+	filenames := []string{path}
+	if info.IsDir() {
+		filenames = nil
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			switch strings.ToLower(filepath.Ext(p)) {
+			case ".yaml", ".yml", ".json":
+				filenames = append(filenames, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk directory %v: %w", path, err)
+		}
+	}
+
+	var objs []*unstructured.Unstructured
+	for _, filename := range filenames {
+		stream, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %v: %w", filename, err)
+		}
+
+		fileObjs, err := ExtractObjectsFromReader(bytes.NewReader(stream), namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract objects from %v: %w", filename, err)
+		}
+		objs = append(objs, fileObjs...)
+	}
+	return objs, nil
+}
+
+// ExtractObjectsFromReader extracts all objects from a reader,
+// which is created from YAML or JSON input files.
+// It retrieves all objects, including nested ones if they are contained within a list.
+// The function takes a namespace parameter to optionally return only namespaced resources.
+func ExtractObjectsFromReader(reader io.Reader, namespace string) ([]*unstructured.Unstructured, error) {
+	d := kubeyaml.NewYAMLOrJSONDecoder(reader, 4096)
+	var objs []*unstructured.Unstructured
+	for {
+		u := &unstructured.Unstructured{}
+		if err := d.Decode(&u); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return objs, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		if u == nil {
+			continue
+		}
+		objs = append(objs, u)
+	}
+
+	finalObjs := []*unstructured.Unstructured{}
+	for _, obj := range objs {
+		tmpObjs := []*unstructured.Unstructured{}
+		if obj.IsList() {
+			err := obj.EachListItem(func(object runtime.Object) error {
+				unstructuredObj, ok := object.(*unstructured.Unstructured)
+				if ok {
+					tmpObjs = append(tmpObjs, unstructuredObj)
+					return nil
+				}
+				return fmt.Errorf("resource list item has unexpected type")
+			})
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			tmpObjs = append(tmpObjs, obj)
+		}
+		// The namespace filter is applied after expanding lists, as the list
+		// itself is not namespaced.
+		for _, tmpObj := range tmpObjs {
+			if namespace != "" && tmpObj.GetNamespace() != namespace {
+				continue
+			}
+			finalObjs = append(finalObjs, tmpObj)
+		}
+	}
+
+	return finalObjs, nil
+}
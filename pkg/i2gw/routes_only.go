@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// routeProtocols lists the listener protocols the routes of each kind attach
+// to.
+var routeProtocols = map[string]sets.Set[gatewayv1.ProtocolType]{
+	"HTTPRoute": sets.New(gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType),
+	"GRPCRoute": sets.New(gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType),
+	"TLSRoute":  sets.New(gatewayv1.TLSProtocolType),
+	"TCPRoute":  sets.New(gatewayv1.TCPProtocolType),
+	"UDPRoute":  sets.New(gatewayv1.UDPProtocolType),
+}
+
+// irRoute holds what the attachment of a route of the IR depends on.
+type irRoute struct {
+	kind       string
+	key        types.NamespacedName
+	object     client.Object
+	parentRefs []gatewayv1.ParentReference
+	hostnames  []gatewayv1.Hostname
+}
+
+// listenerRequirement is a protocol and port a route needs a listener for.
+type listenerRequirement struct {
+	protocol gatewayv1.ProtocolType
+	port     gatewayv1.PortNumber
+}
+
+// checkGatewayCoverage reports the routes of the IR the attachments do not
+// cover, before their parentRefs are replaced: an error is reported for the
+// routes of the namespaces without attachment, and for the routes whose
+// attached Gateways have no listener for the protocol, port and hostnames of a
+// generated listener the route attached to. The Gateways are the pre-existing
+// ones, read from the input or the cluster; the routes attached to Gateways
+// which are not found are not validated, which is reported in a warning.
+func checkGatewayCoverage(ir *intermediate.IR, attachments []GatewayAttachment, gateways map[types.NamespacedName]gatewayv1.Gateway, providerName string) error {
+	targetsByNamespace, err := parseGatewayAttachments(attachments)
+	if err != nil {
+		return err
+	}
+
+	notFound := sets.New[string]()
+	for _, route := range irRoutes(ir) {
+		targets, ok := attachmentTargets(targetsByNamespace, route.key.Namespace)
+		if !ok {
+			notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.ErrorNotification,
+				fmt.Sprintf("%s %s is not attached to any existing Gateway: no Gateway attachment covers namespace %s", route.kind, route.key, route.key.Namespace),
+				route.object), providerName)
+			continue
+		}
+
+		var (
+			listeners    []gatewayv1.Listener
+			targetNames  []string
+			allTargetsOK = true
+		)
+		for _, target := range targets {
+			targetNames = append(targetNames, target.NamespacedName.String())
+			gateway, ok := gateways[target.NamespacedName]
+			if !ok {
+				notFound.Insert(target.NamespacedName.String())
+				allTargetsOK = false
+				continue
+			}
+			for _, listener := range gateway.Spec.Listeners {
+				if target.Listener == "" || string(listener.Name) == target.Listener {
+					listeners = append(listeners, listener)
+				}
+			}
+		}
+		if !allTargetsOK {
+			continue
+		}
+
+		var uncovered []string
+		for _, requirement := range requiredListeners(ir, route) {
+			if !slices.ContainsFunc(listeners, func(listener gatewayv1.Listener) bool {
+				return listener.Protocol == requirement.protocol && listener.Port == requirement.port && ListenerAcceptsHostnames(listener, route.hostnames)
+			}) {
+				uncovered = append(uncovered, fmt.Sprintf("%s on port %d", requirement.protocol, requirement.port))
+			}
+		}
+		if len(uncovered) == 0 {
+			continue
+		}
+		message := fmt.Sprintf("%s %s needs listeners its Gateways %s do not provide: %s", route.kind, route.key, strings.Join(targetNames, ", "), strings.Join(uncovered, ", "))
+		if len(route.hostnames) > 0 {
+			message += fmt.Sprintf(" for the hostnames %s", joinHostnames(route.hostnames))
+		}
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.ErrorNotification, message, route.object), providerName)
+	}
+
+	for _, gateway := range sets.List(notFound) {
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification,
+			fmt.Sprintf("Gateway %s was not found in the input or the cluster, the listeners of the routes attached to it are not validated", gateway)), providerName)
+	}
+	return nil
+}
+
+// requiredListeners returns the protocols and ports of the listeners of the
+// Gateways of the IR the route attaches to, sorted by port.
+func requiredListeners(ir *intermediate.IR, route irRoute) []listenerRequirement {
+	protocols := routeProtocols[route.kind]
+	required := sets.New[listenerRequirement]()
+	for _, parentRef := range route.parentRefs {
+		gatewayContext, ok := ir.Gateways[ParentGatewayKey(route.key.Namespace, parentRef)]
+		if !ok {
+			continue
+		}
+		for _, listener := range gatewayContext.Spec.Listeners {
+			if !protocols.Has(listener.Protocol) || !ListenerAcceptsHostnames(listener, route.hostnames) {
+				continue
+			}
+			if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+				continue
+			}
+			if parentRef.Port != nil && *parentRef.Port != listener.Port {
+				continue
+			}
+			required.Insert(listenerRequirement{protocol: listener.Protocol, port: listener.Port})
+		}
+	}
+	requirements := required.UnsortedList()
+	slices.SortFunc(requirements, func(a, b listenerRequirement) int {
+		return cmp.Or(cmp.Compare(a.port, b.port), cmp.Compare(a.protocol, b.protocol))
+	})
+	return requirements
+}
+
+// irRoutes returns the routes of the IR, sorted by kind and key.
+func irRoutes(ir *intermediate.IR) []irRoute {
+	var routes []irRoute
+	for key, routeContext := range ir.HTTPRoutes {
+		route := routeContext.HTTPRoute
+		routes = append(routes, irRoute{kind: "HTTPRoute", key: key, object: &route, parentRefs: route.Spec.ParentRefs, hostnames: route.Spec.Hostnames})
+	}
+	for key, route := range ir.GRPCRoutes {
+		route := route
+		routes = append(routes, irRoute{kind: "GRPCRoute", key: key, object: &route, parentRefs: route.Spec.ParentRefs, hostnames: route.Spec.Hostnames})
+	}
+	for key, route := range ir.TLSRoutes {
+		route := route
+		routes = append(routes, irRoute{kind: "TLSRoute", key: key, object: &route, parentRefs: route.Spec.ParentRefs, hostnames: route.Spec.Hostnames})
+	}
+	for key, route := range ir.TCPRoutes {
+		route := route
+		routes = append(routes, irRoute{kind: "TCPRoute", key: key, object: &route, parentRefs: route.Spec.ParentRefs})
+	}
+	for key, route := range ir.UDPRoutes {
+		route := route
+		routes = append(routes, irRoute{kind: "UDPRoute", key: key, object: &route, parentRefs: route.Spec.ParentRefs})
+	}
+	slices.SortFunc(routes, func(a, b irRoute) int {
+		return cmp.Or(cmp.Compare(a.kind, b.kind), cmp.Compare(a.key.String(), b.key.String()))
+	})
+	return routes
+}
+
+// removeGateways removes the Gateways and GatewayClasses of the resources in
+// routes-only mode.
+func removeGateways(gatewayResources *GatewayResources) {
+	gatewayResources.Gateways = map[types.NamespacedName]gatewayv1.Gateway{}
+	gatewayResources.GatewayClasses = map[types.NamespacedName]gatewayv1.GatewayClass{}
+}
+
+// readGatewaysFromFile returns the Gateways found in the input file, or in the
+// directory tree of input files.
+func readGatewaysFromFile(path string) (map[types.NamespacedName]gatewayv1.Gateway, error) {
+	objects, err := ExtractObjectsFromPath(path, "")
+	if err != nil {
+		return nil, err
+	}
+	return gatewaysFromObjects(objects)
+}
+
+// readGatewaysFromCluster returns the Gateways of the cluster. No Gateway is
+// returned when the Gateway API CRDs are not installed.
+func readGatewaysFromCluster(ctx context.Context, cl client.Client) (map[types.NamespacedName]gatewayv1.Gateway, error) {
+	gatewayList := &unstructured.UnstructuredList{}
+	gatewayList.SetGroupVersionKind(gatewayv1.SchemeGroupVersion.WithKind("GatewayList"))
+	if err := cl.List(ctx, gatewayList); err != nil {
+		if meta.IsNoMatchError(err) {
+			return map[types.NamespacedName]gatewayv1.Gateway{}, nil
+		}
+		return nil, fmt.Errorf("failed to get gateways from the cluster: %w", err)
+	}
+	objects := make([]*unstructured.Unstructured, 0, len(gatewayList.Items))
+	for i := range gatewayList.Items {
+		objects = append(objects, &gatewayList.Items[i])
+	}
+	return gatewaysFromObjects(objects)
+}
+
+// gatewaysFromObjects converts the Gateways of the objects.
+func gatewaysFromObjects(objects []*unstructured.Unstructured) (map[types.NamespacedName]gatewayv1.Gateway, error) {
+	gateways := map[types.NamespacedName]gatewayv1.Gateway{}
+	for _, object := range objects {
+		gvk := object.GroupVersionKind()
+		if gvk.Group != gatewayv1.GroupName || gvk.Kind != "Gateway" {
+			continue
+		}
+		var gateway gatewayv1.Gateway
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, &gateway); err != nil {
+			return nil, fmt.Errorf("failed to parse Gateway %s/%s: %w", object.GetNamespace(), object.GetName(), err)
+		}
+		gateways[types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}] = gateway
+	}
+	return gateways, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestCheckGatewayCoverage(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	hostname := gatewayv1.Hostname("www.example.com")
+	generatedRef := []gatewayv1.ParentReference{{Name: "nginx"}}
+	generatedListeners := []gatewayv1.Listener{
+		{Name: "www-example-com-http", Hostname: &hostname, Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+		{Name: "www-example-com-https", Hostname: &hostname, Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+	}
+	httpRoute := func(namespace string) intermediate.HTTPRouteContext {
+		return intermediate.HTTPRouteContext{HTTPRoute: gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "web"},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef},
+				Hostnames:       []gatewayv1.Hostname{hostname},
+			},
+		}}
+	}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			{Namespace: "covered", Name: "nginx"}:   {Gateway: gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: generatedListeners}}},
+			{Namespace: "uncovered", Name: "nginx"}: {Gateway: gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: generatedListeners}}},
+			{Namespace: "tcp", Name: "nginx"}: {Gateway: gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "tcp-5432", Port: 5432, Protocol: gatewayv1.TCPProtocolType},
+			}}}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "covered", Name: "web"}:   httpRoute("covered"),
+			{Namespace: "uncovered", Name: "web"}: httpRoute("uncovered"),
+			{Namespace: "unknown", Name: "web"}:   httpRoute("unknown"),
+			{Namespace: "orphan", Name: "web"}:    httpRoute("orphan"),
+		},
+		TCPRoutes: map[types.NamespacedName]gatewayv1alpha2.TCPRoute{
+			{Namespace: "tcp", Name: "postgres"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tcp", Name: "postgres"},
+				Spec:       gatewayv1alpha2.TCPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: generatedRef}},
+			},
+		},
+	}
+	gateways := map[types.NamespacedName]gatewayv1.Gateway{
+		{Namespace: "infra", Name: "shared"}: {Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+			{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+			{Name: "https", Hostname: ptr.To(gatewayv1.Hostname("*.example.com")), Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+			{Name: "tcp", Port: 5432, Protocol: gatewayv1.TCPProtocolType},
+		}}},
+	}
+	attachments := []GatewayAttachment{
+		{Namespace: "covered", Gateway: "infra/shared"},
+		{Namespace: "uncovered", Gateway: "infra/shared:http"},
+		{Namespace: "unknown", Gateway: "infra/missing"},
+		{Namespace: "tcp", Gateway: "infra/shared:tcp"},
+	}
+
+	if err := checkGatewayCoverage(&ir, attachments, gateways, "test"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var messages []string
+	for _, n := range notifications.NotificationAggr.Notifications["test"] {
+		messages = append(messages, n.Message)
+	}
+	expected := []string{
+		"HTTPRoute orphan/web is not attached to any existing Gateway: no Gateway attachment covers namespace orphan",
+		"HTTPRoute uncovered/web needs listeners its Gateways infra/shared do not provide: HTTPS on port 443 for the hostnames www.example.com",
+		"Gateway infra/missing was not found in the input or the cluster, the listeners of the routes attached to it are not validated",
+	}
+	if diff := cmp.Diff(expected, messages); diff != "" {
+		t.Errorf("Unexpected notifications (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadGatewaysFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.yaml")
+	content := `
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: shared
+  namespace: infra
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: shared
+  namespace: infra
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write input file: %v", err)
+	}
+
+	gateways, err := readGatewaysFromFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	gateway, ok := gateways[types.NamespacedName{Namespace: "infra", Name: "shared"}]
+	if len(gateways) != 1 || !ok {
+		t.Fatalf("Expected the Gateway infra/shared only, got %v", gateways)
+	}
+	if len(gateway.Spec.Listeners) != 1 || gateway.Spec.Listeners[0].Port != 80 {
+		t.Errorf("Unexpected listeners %+v", gateway.Spec.Listeners)
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// Bounds of the numeric fields validated by the Gateway API CRDs.
+const (
+	minPortNumber    = 1
+	maxPortNumber    = 65535
+	maxBackendWeight = 1000000
+)
+
+// redirectStatusCodes are the status codes Gateway API accepts for the
+// RequestRedirect filter.
+var redirectStatusCodes = []int{301, 302}
+
+// numericSanitizer reports the numeric fields of the resources of a provider
+// which it rejects or clamps.
+type numericSanitizer struct {
+	providerName string
+}
+
+// SanitizeNumericFields validates the ports, backend weights and redirect status
+// codes the providers copied from the source resources, so that bad source data
+// is reported instead of producing resources the Gateway API CRDs reject:
+//   - listeners with a port out of 1-65535 are removed, and reported as errors.
+//   - backendRefs and RequestMirror filters with such a port are removed, and
+//     reported as errors.
+//   - parentRef and RequestRedirect ports out of range are unset, and reported.
+//   - backend weights are clamped to 0-1,000,000, and reported.
+//   - RequestRedirect status codes other than 301 and 302 are replaced with 301
+//     for the permanent 308, and 302 otherwise, and reported.
+func SanitizeNumericFields(ir *intermediate.IR, providerName string) {
+	s := numericSanitizer{providerName: providerName}
+
+	_ = intermediate.WalkGateways(ir, func(key types.NamespacedName, gatewayContext *intermediate.GatewayContext) error {
+		gatewayContext.Spec.Listeners = slices.DeleteFunc(gatewayContext.Spec.Listeners, func(listener gatewayv1.Listener) bool {
+			if validPort(listener.Port) {
+				return false
+			}
+			s.notify(notifications.ErrorNotification, fmt.Sprintf("Gateway %s: listener %s has invalid port %d, must be between %d and %d; the listener is removed",
+				key, listener.Name, listener.Port, minPortNumber, maxPortNumber), &gatewayContext.Gateway)
+			return true
+		})
+		return nil
+	})
+
+	_ = intermediate.WalkHTTPRoutes(ir, func(key types.NamespacedName, routeContext *intermediate.HTTPRouteContext) error {
+		route := &routeContext.HTTPRoute
+		s.sanitizeParentRefs("HTTPRoute", key, route.Spec.ParentRefs, route)
+		for i := range route.Spec.Rules {
+			rule := &route.Spec.Rules[i]
+			rule.Filters = s.sanitizeHTTPFilters("HTTPRoute", key, rule.Filters, route)
+			rule.BackendRefs = sanitizeBackendRefs(s, "HTTPRoute", key, rule.BackendRefs, func(ref *gatewayv1.HTTPBackendRef) *gatewayv1.BackendRef { return &ref.BackendRef }, route)
+			for j := range rule.BackendRefs {
+				rule.BackendRefs[j].Filters = s.sanitizeHTTPFilters("HTTPRoute", key, rule.BackendRefs[j].Filters, route)
+			}
+		}
+		return nil
+	})
+
+	_ = intermediate.WalkGRPCRoutes(ir, func(key types.NamespacedName, route *gatewayv1.GRPCRoute) error {
+		s.sanitizeParentRefs("GRPCRoute", key, route.Spec.ParentRefs, route)
+		for i := range route.Spec.Rules {
+			rule := &route.Spec.Rules[i]
+			rule.BackendRefs = sanitizeBackendRefs(s, "GRPCRoute", key, rule.BackendRefs, func(ref *gatewayv1.GRPCBackendRef) *gatewayv1.BackendRef { return &ref.BackendRef }, route)
+		}
+		return nil
+	})
+
+	backendRef := func(ref *gatewayv1.BackendRef) *gatewayv1.BackendRef { return ref }
+	_ = intermediate.WalkTLSRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.TLSRoute) error {
+		s.sanitizeParentRefs("TLSRoute", key, route.Spec.ParentRefs, route)
+		for i := range route.Spec.Rules {
+			route.Spec.Rules[i].BackendRefs = sanitizeBackendRefs(s, "TLSRoute", key, route.Spec.Rules[i].BackendRefs, backendRef, route)
+		}
+		return nil
+	})
+	_ = intermediate.WalkTCPRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.TCPRoute) error {
+		s.sanitizeParentRefs("TCPRoute", key, route.Spec.ParentRefs, route)
+		for i := range route.Spec.Rules {
+			route.Spec.Rules[i].BackendRefs = sanitizeBackendRefs(s, "TCPRoute", key, route.Spec.Rules[i].BackendRefs, backendRef, route)
+		}
+		return nil
+	})
+	_ = intermediate.WalkUDPRoutes(ir, func(key types.NamespacedName, route *gatewayv1alpha2.UDPRoute) error {
+		s.sanitizeParentRefs("UDPRoute", key, route.Spec.ParentRefs, route)
+		for i := range route.Spec.Rules {
+			route.Spec.Rules[i].BackendRefs = sanitizeBackendRefs(s, "UDPRoute", key, route.Spec.Rules[i].BackendRefs, backendRef, route)
+		}
+		return nil
+	})
+}
+
+// sanitizeParentRefs unsets the invalid ports of the parentRefs, so that they
+// select the listeners of the parent regardless of their port.
+func (s numericSanitizer) sanitizeParentRefs(kind string, key types.NamespacedName, parentRefs []gatewayv1.ParentReference, object client.Object) {
+	for i := range parentRefs {
+		if parentRefs[i].Port == nil || validPort(*parentRefs[i].Port) {
+			continue
+		}
+		s.notify(notifications.WarningNotification, fmt.Sprintf("%s %s: parentRef %s has invalid port %d, must be between %d and %d; the port is unset",
+			kind, key, parentRefs[i].Name, *parentRefs[i].Port, minPortNumber, maxPortNumber), object)
+		parentRefs[i].Port = nil
+	}
+}
+
+// sanitizeBackendRefs removes the backendRefs with an invalid port and clamps
+// the weights of the others.
+func sanitizeBackendRefs[T any](s numericSanitizer, kind string, key types.NamespacedName, refs []T, backendRef func(*T) *gatewayv1.BackendRef, object client.Object) []T {
+	sanitized := refs[:0]
+	for i := range refs {
+		b := backendRef(&refs[i])
+		if b.Port != nil && !validPort(*b.Port) {
+			s.notify(notifications.ErrorNotification, fmt.Sprintf("%s %s: backendRef %s has invalid port %d, must be between %d and %d; the backendRef is removed",
+				kind, key, b.Name, *b.Port, minPortNumber, maxPortNumber), object)
+			continue
+		}
+		if b.Weight != nil && (*b.Weight < 0 || *b.Weight > maxBackendWeight) {
+			weight := min(max(*b.Weight, 0), maxBackendWeight)
+			s.notify(notifications.WarningNotification, fmt.Sprintf("%s %s: backendRef %s has invalid weight %d, must be between 0 and %d; the weight is set to %d",
+				kind, key, b.Name, *b.Weight, maxBackendWeight, weight), object)
+			b.Weight = ptr.To(weight)
+		}
+		sanitized = append(sanitized, refs[i])
+	}
+	return sanitized
+}
+
+// sanitizeHTTPFilters replaces the unsupported status codes and unsets the
+// invalid ports of the RequestRedirect filters, and removes the RequestMirror
+// filters whose backend has an invalid port.
+func (s numericSanitizer) sanitizeHTTPFilters(kind string, key types.NamespacedName, filters []gatewayv1.HTTPRouteFilter, object client.Object) []gatewayv1.HTTPRouteFilter {
+	sanitized := filters[:0]
+	for _, filter := range filters {
+		if redirect := filter.RequestRedirect; redirect != nil {
+			if redirect.StatusCode != nil && !slices.Contains(redirectStatusCodes, *redirect.StatusCode) {
+				statusCode := 302
+				if *redirect.StatusCode == 308 {
+					statusCode = 301
+				}
+				s.notify(notifications.WarningNotification, fmt.Sprintf("%s %s: RequestRedirect filter has status code %d, Gateway API only supports %v; the status code is set to %d",
+					kind, key, *redirect.StatusCode, redirectStatusCodes, statusCode), object)
+				redirect.StatusCode = ptr.To(statusCode)
+			}
+			if redirect.Port != nil && !validPort(*redirect.Port) {
+				s.notify(notifications.WarningNotification, fmt.Sprintf("%s %s: RequestRedirect filter has invalid port %d, must be between %d and %d; the port is unset",
+					kind, key, *redirect.Port, minPortNumber, maxPortNumber), object)
+				redirect.Port = nil
+			}
+		}
+		if mirror := filter.RequestMirror; mirror != nil && mirror.BackendRef.Port != nil && !validPort(*mirror.BackendRef.Port) {
+			s.notify(notifications.ErrorNotification, fmt.Sprintf("%s %s: RequestMirror filter backend %s has invalid port %d, must be between %d and %d; the filter is removed",
+				kind, key, mirror.BackendRef.Name, *mirror.BackendRef.Port, minPortNumber, maxPortNumber), object)
+			continue
+		}
+		sanitized = append(sanitized, filter)
+	}
+	return sanitized
+}
+
+// notify dispatches a notification of the provider.
+func (s numericSanitizer) notify(mType notifications.MessageType, message string, object client.Object) {
+	notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(mType, message, object), s.providerName)
+}
+
+// validPort reports whether the port is a valid Gateway API port number.
+func validPort(port gatewayv1.PortNumber) bool {
+	return port >= minPortNumber && port <= maxPortNumber
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func Test_SanitizeNumericFields(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	key := types.NamespacedName{Namespace: "default", Name: "cafe"}
+	meta := metav1.ObjectMeta{Namespace: "default", Name: "cafe"}
+	backendRef := func(name string, port gatewayv1.PortNumber, weight int32) gatewayv1.BackendRef {
+		return gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(name), Port: ptr.To(port)}, Weight: ptr.To(weight)}
+	}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			key: {Gateway: gatewayv1.Gateway{ObjectMeta: meta, Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				{Name: "invalid", Port: 70000, Protocol: gatewayv1.HTTPProtocolType},
+			}}}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			key: {HTTPRoute: gatewayv1.HTTPRoute{ObjectMeta: meta, Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "cafe", Port: ptr.To(gatewayv1.PortNumber(0))}}},
+				Rules: []gatewayv1.HTTPRouteRule{{
+					Filters: []gatewayv1.HTTPRouteFilter{
+						{Type: gatewayv1.HTTPRouteFilterRequestRedirect, RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{StatusCode: ptr.To(308), Port: ptr.To(gatewayv1.PortNumber(-1))}},
+						{Type: gatewayv1.HTTPRouteFilterRequestMirror, RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{BackendRef: gatewayv1.BackendObjectReference{Name: "mirror", Port: ptr.To(gatewayv1.PortNumber(99999))}}},
+					},
+					BackendRefs: []gatewayv1.HTTPBackendRef{
+						{BackendRef: backendRef("coffee", 80, 2000000)},
+						{BackendRef: backendRef("tea", 0, 1)},
+						{BackendRef: backendRef("water", 8080, -5), Filters: []gatewayv1.HTTPRouteFilter{
+							{Type: gatewayv1.HTTPRouteFilterRequestRedirect, RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{StatusCode: ptr.To(307)}},
+						}},
+					},
+				}},
+			}}},
+		},
+		TCPRoutes: map[types.NamespacedName]gatewayv1alpha2.TCPRoute{
+			key: {ObjectMeta: meta, Spec: gatewayv1alpha2.TCPRouteSpec{Rules: []gatewayv1alpha2.TCPRouteRule{{
+				BackendRefs: []gatewayv1alpha2.BackendRef{backendRef("db", 5432, 1), backendRef("cache", 65536, 1)},
+			}}}},
+		},
+	}
+
+	SanitizeNumericFields(&ir, "nginx")
+
+	if listeners := ir.Gateways[key].Spec.Listeners; len(listeners) != 1 || listeners[0].Name != "http" {
+		t.Errorf("Expected the listener with an invalid port to be removed, got %v", listeners)
+	}
+	route := ir.HTTPRoutes[key].Spec
+	if route.ParentRefs[0].Port != nil {
+		t.Errorf("Expected the invalid parentRef port to be unset, got %d", *route.ParentRefs[0].Port)
+	}
+	rule := route.Rules[0]
+	expectedFilters := []gatewayv1.HTTPRouteFilter{
+		{Type: gatewayv1.HTTPRouteFilterRequestRedirect, RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{StatusCode: ptr.To(301)}},
+	}
+	if !reflect.DeepEqual(rule.Filters, expectedFilters) {
+		t.Errorf("Expected filters %+v, got %+v", expectedFilters, rule.Filters)
+	}
+	expectedBackendRefs := []gatewayv1.HTTPBackendRef{
+		{BackendRef: backendRef("coffee", 80, 1000000)},
+		{BackendRef: backendRef("water", 8080, 0), Filters: []gatewayv1.HTTPRouteFilter{
+			{Type: gatewayv1.HTTPRouteFilterRequestRedirect, RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{StatusCode: ptr.To(302)}},
+		}},
+	}
+	if !reflect.DeepEqual(rule.BackendRefs, expectedBackendRefs) {
+		t.Errorf("Expected backendRefs %+v, got %+v", expectedBackendRefs, rule.BackendRefs)
+	}
+	if backendRefs := ir.TCPRoutes[key].Spec.Rules[0].BackendRefs; len(backendRefs) != 1 || backendRefs[0].Name != "db" {
+		t.Errorf("Expected the TCPRoute backendRef with an invalid port to be removed, got %v", backendRefs)
+	}
+
+	counts := map[notifications.MessageType]int{}
+	for _, n := range notifications.NotificationAggr.Notifications["nginx"] {
+		counts[n.Type]++
+	}
+	if counts[notifications.ErrorNotification] != 4 || counts[notifications.WarningNotification] != 6 {
+		t.Errorf("Expected 4 errors and 6 warnings, got %v", notifications.NotificationAggr.Notifications["nginx"])
+	}
+}
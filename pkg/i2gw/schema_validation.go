@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//go:generate go run gateway_api_schemas/generate.go gateway_api_schemas
+
+// gatewayAPISchemaFiles contains the OpenAPI schemas of the Gateway API CRDs,
+// generated from the CRDs of the sigs.k8s.io/gateway-api module.
+//
+//go:embed gateway_api_schemas/*.json
+var gatewayAPISchemaFiles embed.FS
+
+var (
+	gatewayAPISchemas     map[string]*openapi3.Schema
+	gatewayAPISchemasOnce sync.Once
+)
+
+// gatewayAPISchema returns the schema of the given kind and version, or nil
+// when there is none.
+func gatewayAPISchema(kind, version string) *openapi3.Schema {
+	gatewayAPISchemasOnce.Do(func() {
+		gatewayAPISchemas = map[string]*openapi3.Schema{}
+		entries, _ := gatewayAPISchemaFiles.ReadDir("gateway_api_schemas")
+		for _, entry := range entries {
+			data, err := gatewayAPISchemaFiles.ReadFile("gateway_api_schemas/" + entry.Name())
+			if err != nil {
+				continue
+			}
+			schema := &openapi3.Schema{}
+			if err = json.Unmarshal(data, schema); err != nil {
+				continue
+			}
+			gatewayAPISchemas[strings.TrimSuffix(entry.Name(), ".json")] = schema
+		}
+	})
+	return gatewayAPISchemas[strings.ToLower(kind)+"."+version]
+}
+
+// validateGatewayAPISchemas validates the generated resources against the
+// schemas of the Gateway API CRDs, and reports the invalid resources the API
+// server would reject. The CEL validation rules of the CRDs are not evaluated.
+func validateGatewayAPISchemas(gatewayResources *GatewayResources, providerName string) {
+	for _, violation := range gatewayAPISchemaViolations(gatewayResources) {
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.ErrorNotification,
+			violation.message, violation.object), providerName)
+	}
+}
+
+// GatewayAPISchemaViolations returns a message for every generated resource
+// which does not match the schema of its Gateway API CRD, so that the providers
+// can check their output in their tests.
+func GatewayAPISchemaViolations(gatewayResources *GatewayResources) []string {
+	var messages []string
+	for _, violation := range gatewayAPISchemaViolations(gatewayResources) {
+		messages = append(messages, violation.message)
+	}
+	return messages
+}
+
+// schemaViolation is a generated resource the API server would reject.
+type schemaViolation struct {
+	message string
+	object  client.Object
+}
+
+// gatewayAPISchemaViolations validates the generated resources against the
+// schemas of the Gateway API CRDs.
+func gatewayAPISchemaViolations(gatewayResources *GatewayResources) []schemaViolation {
+	var violations []schemaViolation
+	violations = appendViolations(violations, gatewayResources.GatewayClasses, "GatewayClass", "v1")
+	violations = appendViolations(violations, gatewayResources.Gateways, "Gateway", "v1")
+	violations = appendViolations(violations, gatewayResources.HTTPRoutes, "HTTPRoute", "v1")
+	violations = appendViolations(violations, gatewayResources.GRPCRoutes, "GRPCRoute", "v1")
+	violations = appendViolations(violations, gatewayResources.TLSRoutes, "TLSRoute", "v1alpha2")
+	violations = appendViolations(violations, gatewayResources.TCPRoutes, "TCPRoute", "v1alpha2")
+	violations = appendViolations(violations, gatewayResources.UDPRoutes, "UDPRoute", "v1alpha2")
+	violations = appendViolations(violations, gatewayResources.BackendTLSPolicies, "BackendTLSPolicy", "v1alpha3")
+	violations = appendViolations(violations, gatewayResources.ReferenceGrants, "ReferenceGrant", "v1beta1")
+	return violations
+}
+
+// appendViolations appends a violation for every resource of the map which
+// does not match the schema of its kind, in the order of their keys.
+func appendViolations[T any, PT interface {
+	*T
+	client.Object
+}](violations []schemaViolation, resources map[types.NamespacedName]T, kind, version string) []schemaViolation {
+	schema := gatewayAPISchema(kind, version)
+	if schema == nil {
+		return violations
+	}
+	keys := make([]types.NamespacedName, 0, len(resources))
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, key := range keys {
+		resource := resources[key]
+		fields, err := schemaViolations(schema, &resource)
+		if err != nil {
+			violations = append(violations, schemaViolation{
+				message: fmt.Sprintf("%s %s could not be validated against the Gateway API schema: %v", kind, key, err),
+				object:  PT(&resource),
+			})
+			continue
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		violations = append(violations, schemaViolation{
+			message: fmt.Sprintf("%s %s does not match the Gateway API schema and would be rejected: %s", kind, key, strings.Join(fields, "; ")),
+			object:  PT(&resource),
+		})
+	}
+	return violations
+}
+
+// schemaViolations returns the sorted list of the fields of the resource which
+// do not match the schema, with the reason.
+func schemaViolations(schema *openapi3.Schema, resource interface{}) ([]string, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	// The API server drops the null fields, which are optional fields the
+	// types do not omit when empty.
+	value = dropNulls(value)
+
+	err = schema.VisitJSON(value, openapi3.MultiErrors())
+	if err == nil {
+		return nil, nil
+	}
+	violations := flattenSchemaErrors(err, nil)
+	slices.Sort(violations)
+	return slices.Compact(violations), nil
+}
+
+// flattenSchemaErrors appends to violations a "<field>: <reason>" entry for
+// every schema error of err.
+func flattenSchemaErrors(err error, violations []string) []string {
+	var multiErr openapi3.MultiError
+	if errors.As(err, &multiErr) {
+		for _, e := range multiErr {
+			violations = flattenSchemaErrors(e, violations)
+		}
+		return violations
+	}
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return append(violations, err.Error())
+	}
+	reason := schemaErr.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("does not match %q", schemaErr.SchemaField)
+	}
+	return append(violations, fmt.Sprintf("%s: %s", strings.Join(schemaErr.JSONPointer(), "."), reason))
+}
+
+// dropNulls removes the null values from the maps of value.
+func dropNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if child == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = dropNulls(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = dropNulls(child)
+		}
+	}
+	return value
+}
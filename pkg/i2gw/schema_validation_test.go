@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestValidateGatewayAPISchemas(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	gatewayKey := types.NamespacedName{Namespace: "default", Name: "nginx"}
+	invalidGatewayKey := types.NamespacedName{Namespace: "default", Name: "invalid"}
+	routeKey := types.NamespacedName{Namespace: "default", Name: "web"}
+	gatewayResources := GatewayResources{
+		Gateways: map[types.NamespacedName]gatewayv1.Gateway{
+			gatewayKey: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: gatewayKey.Namespace, Name: gatewayKey.Name},
+				Spec: gatewayv1.GatewaySpec{
+					GatewayClassName: "nginx",
+					Listeners: []gatewayv1.Listener{{
+						Name:     "example-com-http",
+						Hostname: ptr.To(gatewayv1.Hostname("*.example.com")),
+						Port:     80,
+						Protocol: gatewayv1.HTTPProtocolType,
+					}},
+				},
+			},
+			invalidGatewayKey: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: invalidGatewayKey.Namespace, Name: invalidGatewayKey.Name},
+				Spec: gatewayv1.GatewaySpec{
+					GatewayClassName: "nginx",
+					Listeners: []gatewayv1.Listener{{
+						Name:     gatewayv1.SectionName(strings.Repeat("a", 254)),
+						Hostname: ptr.To(gatewayv1.Hostname("Example_com")),
+						Port:     80,
+						Protocol: gatewayv1.HTTPProtocolType,
+					}},
+				},
+			},
+		},
+		HTTPRoutes: map[types.NamespacedName]gatewayv1.HTTPRoute{
+			routeKey: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: routeKey.Namespace, Name: routeKey.Name},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{
+						ParentRefs: []gatewayv1.ParentReference{{Name: gatewayv1.ObjectName(gatewayKey.Name)}},
+					},
+					Hostnames: []gatewayv1.Hostname{"www.example.com"},
+					Rules: []gatewayv1.HTTPRouteRule{{
+						Matches: []gatewayv1.HTTPRouteMatch{{
+							Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/")},
+						}},
+						BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{Name: "web", Port: ptr.To(gatewayv1.PortNumber(80))},
+						}}},
+					}},
+				},
+			},
+		},
+	}
+
+	validateGatewayAPISchemas(&gatewayResources, "test")
+
+	notificationsList := notifications.NotificationAggr.Notifications["test"]
+	if len(notificationsList) != 1 {
+		t.Fatalf("Expected a single notification for the invalid Gateway, got %+v", notificationsList)
+	}
+	n := notificationsList[0]
+	if n.Type != notifications.ErrorNotification {
+		t.Errorf("Expected an error notification, got %s", n.Type)
+	}
+	for _, expected := range []string{
+		"Gateway default/invalid does not match the Gateway API schema",
+		"spec.listeners.0.name: maximum string length is 253",
+		"spec.listeners.0.hostname: string doesn't match the regular expression",
+	} {
+		if !strings.Contains(n.Message, expected) {
+			t.Errorf("Expected the notification to contain %q, got %q", expected, n.Message)
+		}
+	}
+}
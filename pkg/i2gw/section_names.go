@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// UnmatchedSectionName is a parentRef of a route of the IR whose sectionName
+// names no listener of its Gateway.
+type UnmatchedSectionName struct {
+	Kind      string
+	Route     string
+	ParentRef string
+
+	object client.Object
+}
+
+func (u UnmatchedSectionName) String() string {
+	return fmt.Sprintf("%s %s: %s", u.Kind, u.Route, u.ParentRef)
+}
+
+// UnmatchedSectionNames returns the parentRefs of the routes of the IR whose
+// sectionName names no listener of their Gateway, sorted by route. Every
+// sectionName of the generated routes must name a generated listener, which the
+// converters guarantee by naming the listeners with the functions of the common
+// package. The parentRefs of Gateways which are not in the IR, e.g. the
+// pre-existing Gateways the routes are attached to, are not checked.
+func UnmatchedSectionNames(ir *intermediate.IR) []UnmatchedSectionName {
+	var unmatched []UnmatchedSectionName
+	for _, route := range irRoutes(ir) {
+		for _, parentRef := range route.parentRefs {
+			if parentRef.SectionName == nil || (parentRef.Group != nil && *parentRef.Group != gatewayv1.GroupName) || (parentRef.Kind != nil && *parentRef.Kind != "Gateway") {
+				continue
+			}
+			gatewayContext, ok := ir.Gateways[ParentGatewayKey(route.key.Namespace, parentRef)]
+			if !ok {
+				continue
+			}
+			if hasListener(gatewayContext.Gateway, *parentRef.SectionName) {
+				continue
+			}
+			unmatched = append(unmatched, UnmatchedSectionName{Kind: route.kind, Route: route.key.String(), ParentRef: parentRefString(route.key.Namespace, parentRef), object: route.object})
+		}
+	}
+	return unmatched
+}
+
+// reportUnmatchedSectionNames reports an error for every parentRef of the IR
+// whose sectionName names no listener of its Gateway, since the route would not
+// attach to it.
+func reportUnmatchedSectionNames(ir *intermediate.IR, providerName string) {
+	for _, u := range UnmatchedSectionNames(ir) {
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.ErrorNotification,
+			fmt.Sprintf("%s %s references the listener of parent %s, which its Gateway does not have: the route will not attach to it", u.Kind, u.Route, u.ParentRef),
+			u.object), providerName)
+	}
+}
+
+func hasListener(gateway gatewayv1.Gateway, name gatewayv1.SectionName) bool {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Name == name {
+			return true
+		}
+	}
+	return false
+}
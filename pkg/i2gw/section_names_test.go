@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"testing"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/intermediate"
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func Test_UnmatchedSectionNames(t *testing.T) {
+	notifications.NotificationAggr.Notifications = map[string][]notifications.Notification{}
+
+	parentRef := func(gateway, sectionName string) gatewayv1.ParentReference {
+		ref := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(gateway)}
+		if sectionName != "" {
+			ref.SectionName = ptr.To(gatewayv1.SectionName(sectionName))
+		}
+		return ref
+	}
+	ir := intermediate.IR{
+		Gateways: map[types.NamespacedName]intermediate.GatewayContext{
+			{Namespace: "default", Name: "nginx"}: {Gateway: gatewayv1.Gateway{Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "cafe-example-com-http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+				{Name: "tcp-5432", Protocol: gatewayv1.TCPProtocolType, Port: 5432},
+			}}}},
+		},
+		HTTPRoutes: map[types.NamespacedName]intermediate.HTTPRouteContext{
+			{Namespace: "default", Name: "cafe"}: {HTTPRoute: gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cafe"},
+				Spec: gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{
+					parentRef("nginx", "cafe-example-com-http"),
+					parentRef("nginx", "cafe-example-com-http-8080"),
+					parentRef("nginx", ""),
+					parentRef("existing", "https"),
+				}}},
+			}},
+		},
+		TCPRoutes: map[types.NamespacedName]gatewayv1alpha2.TCPRoute{
+			{Namespace: "default", Name: "postgres"}: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "postgres"},
+				Spec: gatewayv1alpha2.TCPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{
+					parentRef("nginx", "tcp"),
+				}}},
+			},
+		},
+	}
+
+	unmatched := UnmatchedSectionNames(&ir)
+	expected := []string{
+		"HTTPRoute default/cafe: default/nginx/cafe-example-com-http-8080",
+		"TCPRoute default/postgres: default/nginx/tcp",
+	}
+	if len(unmatched) != len(expected) {
+		t.Fatalf("Expected %d unmatched sectionNames, got %v", len(expected), unmatched)
+	}
+	for i, u := range unmatched {
+		if u.String() != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], u.String())
+		}
+	}
+
+	reportUnmatchedSectionNames(&ir, "nginx")
+	dispatched := notifications.NotificationAggr.Notifications["nginx"]
+	if len(dispatched) != 2 || dispatched[0].Type != notifications.ErrorNotification || len(dispatched[0].CallingObjects) != 1 {
+		t.Errorf("Expected an error per unmatched sectionName with its route, got %v", dispatched)
+	}
+}
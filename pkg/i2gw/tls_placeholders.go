@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"cmp"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/kubernetes-sigs/ingress2gateway/pkg/i2gw/notifications"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// TLSPlaceholderMode selects which kind of placeholder is generated for HTTPS
+// listeners referencing a certificate Secret which cannot be found.
+type TLSPlaceholderMode string
+
+const (
+	// TLSPlaceholderNone disables placeholder generation.
+	TLSPlaceholderNone TLSPlaceholderMode = ""
+	// TLSPlaceholderSelfSigned generates a Secret holding a self-signed certificate.
+	TLSPlaceholderSelfSigned TLSPlaceholderMode = "self-signed"
+	// TLSPlaceholderCertManager generates a cert-manager Certificate.
+	TLSPlaceholderCertManager TLSPlaceholderMode = "cert-manager"
+
+	// TLSPlaceholderLabelKey labels every generated placeholder resource.
+	TLSPlaceholderLabelKey = "ingress2gateway.kubernetes.io/tls-placeholder"
+
+	// TLSPlaceholderIssuerName is the name of the cert-manager ClusterIssuer
+	// referenced by the generated Certificates.
+	TLSPlaceholderIssuerName = "ingress2gateway-placeholder"
+)
+
+// ValidateTLSPlaceholderMode returns an error for unknown placeholder modes.
+func ValidateTLSPlaceholderMode(mode string) error {
+	switch TLSPlaceholderMode(mode) {
+	case TLSPlaceholderNone, TLSPlaceholderSelfSigned, TLSPlaceholderCertManager:
+		return nil
+	default:
+		return fmt.Errorf("%s is not a supported TLS placeholder mode, supported values are %q and %q", mode, TLSPlaceholderSelfSigned, TLSPlaceholderCertManager)
+	}
+}
+
+// missingCertificate is a certificateRef that cannot be resolved, together
+// with the hostnames of the listeners referencing it.
+type missingCertificate struct {
+	secret    types.NamespacedName
+	hostnames []string
+}
+
+// generateTLSPlaceholders returns a placeholder resource for each Secret
+// referenced by an HTTPS listener of the gateways which does not exist in
+// existingSecrets.
+func generateTLSPlaceholders(gateways map[types.NamespacedName]gatewayv1.Gateway, existingSecrets sets.Set[types.NamespacedName], mode TLSPlaceholderMode, providerName string) ([]unstructured.Unstructured, error) {
+	if mode == TLSPlaceholderNone {
+		return nil, nil
+	}
+
+	missing := map[types.NamespacedName]*missingCertificate{}
+	for _, gateway := range gateways {
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.TLS == nil || (listener.TLS.Mode != nil && *listener.TLS.Mode != gatewayv1.TLSModeTerminate) {
+				continue
+			}
+			for _, ref := range listener.TLS.CertificateRefs {
+				if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Secret") {
+					continue
+				}
+				secret := types.NamespacedName{Namespace: gateway.Namespace, Name: string(ref.Name)}
+				if ref.Namespace != nil {
+					secret.Namespace = string(*ref.Namespace)
+				}
+				if existingSecrets.Has(secret) || existingSecrets.Has(types.NamespacedName{Name: secret.Name}) {
+					continue
+				}
+				if missing[secret] == nil {
+					missing[secret] = &missingCertificate{secret: secret}
+				}
+				if listener.Hostname != nil && !slices.Contains(missing[secret].hostnames, string(*listener.Hostname)) {
+					missing[secret].hostnames = append(missing[secret].hostnames, string(*listener.Hostname))
+				}
+			}
+		}
+	}
+
+	keys := make([]types.NamespacedName, 0, len(missing))
+	for key := range missing {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+
+	var placeholders []unstructured.Unstructured
+	for _, key := range keys {
+		var (
+			placeholder *unstructured.Unstructured
+			err         error
+		)
+		switch mode {
+		case TLSPlaceholderSelfSigned:
+			placeholder, err = selfSignedSecret(*missing[key])
+		case TLSPlaceholderCertManager:
+			placeholder = certManagerCertificate(*missing[key])
+		}
+		if err != nil {
+			return nil, err
+		}
+		placeholders = append(placeholders, *placeholder)
+
+		message := fmt.Sprintf("TLS secret %s was not found, a placeholder %s was generated. Replace it with a real certificate before production use", key, placeholder.GetKind())
+		if mode == TLSPlaceholderCertManager {
+			message = fmt.Sprintf("%s. The Certificate references the %s ClusterIssuer, which must be created separately", message, TLSPlaceholderIssuerName)
+		}
+		notifications.NotificationAggr.DispatchNotification(notifications.NewNotification(notifications.WarningNotification, message, placeholder), providerName)
+	}
+	return placeholders, nil
+}
+
+// selfSignedSecret generates a kubernetes.io/tls Secret holding a freshly
+// generated self-signed certificate for the hostnames.
+func selfSignedSecret(mc missingCertificate) (*unstructured.Unstructured, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate placeholder key for %s: %w", mc.secret, err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate placeholder serial number for %s: %w", mc.secret, err)
+	}
+
+	commonName := "ingress2gateway-placeholder"
+	if len(mc.hostnames) > 0 {
+		commonName = mc.hostnames[0]
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName, Organization: []string{"ingress2gateway placeholder"}},
+		DNSNames:              mc.hostnames,
+		NotBefore:             now,
+		NotAfter:              now.Add(90 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate placeholder certificate for %s: %w", mc.secret, err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode placeholder key for %s: %w", mc.secret, err)
+	}
+
+	secret := apiv1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: mc.secret.Namespace,
+			Name:      mc.secret.Name,
+			Labels:    map[string]string{TLSPlaceholderLabelKey: string(TLSPlaceholderSelfSigned)},
+		},
+		Type: apiv1.SecretTypeTLS,
+		Data: map[string][]byte{
+			apiv1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			apiv1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		},
+	}
+	return CastToUnstructured(&secret)
+}
+
+// certManagerCertificate generates a cert-manager Certificate issuing the
+// missing Secret for the hostnames.
+func certManagerCertificate(mc missingCertificate) *unstructured.Unstructured {
+	dnsNames := make([]interface{}, 0, len(mc.hostnames))
+	for _, hostname := range mc.hostnames {
+		dnsNames = append(dnsNames, hostname)
+	}
+
+	certificate := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]interface{}{
+			"namespace": mc.secret.Namespace,
+			"name":      mc.secret.Name,
+			"labels":    map[string]interface{}{TLSPlaceholderLabelKey: string(TLSPlaceholderCertManager)},
+		},
+		"spec": map[string]interface{}{
+			"secretName": mc.secret.Name,
+			"issuerRef": map[string]interface{}{
+				"name": TLSPlaceholderIssuerName,
+				"kind": "ClusterIssuer",
+			},
+		},
+	}}
+	if len(dnsNames) > 0 {
+		_ = unstructured.SetNestedSlice(certificate.Object, dnsNames, "spec", "dnsNames")
+	}
+	return certificate
+}
+
+// readSecretKeysFromCluster returns the keys of the Secrets in the cluster.
+// Only the Secrets metadata is read.
+func readSecretKeysFromCluster(ctx context.Context, cl client.Client) (sets.Set[types.NamespacedName], error) {
+	secretList := &metav1.PartialObjectMetadataList{}
+	secretList.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("SecretList"))
+	if err := cl.List(ctx, secretList); err != nil {
+		return nil, fmt.Errorf("failed to get secrets from the cluster: %w", err)
+	}
+
+	keys := sets.New[types.NamespacedName]()
+	for _, secret := range secretList.Items {
+		keys.Insert(types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name})
+	}
+	return keys, nil
+}
+
+// readSecretKeysFromFile returns the keys of the Secrets found in the input
+// file, or in the directory tree of input files.
+func readSecretKeysFromFile(path string) (sets.Set[types.NamespacedName], error) {
+	objects, err := ExtractObjectsFromPath(path, "")
+	if err != nil {
+		return nil, err
+	}
+	return secretKeysFromObjects(objects), nil
+}
+
+// secretKeysFromObjects returns the keys of the Secrets among the objects.
+func secretKeysFromObjects(objects []*unstructured.Unstructured) sets.Set[types.NamespacedName] {
+	keys := sets.New[types.NamespacedName]()
+	for _, object := range objects {
+		if object.GetKind() == "Secret" {
+			keys.Insert(types.NamespacedName{Namespace: object.GetNamespace(), Name: object.GetName()})
+		}
+	}
+	return keys
+}
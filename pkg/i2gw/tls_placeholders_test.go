@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i2gw
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_generateTLSPlaceholders(t *testing.T) {
+	hostname := gatewayv1.Hostname("foo.example.com")
+	gateways := map[types.NamespacedName]gatewayv1.Gateway{
+		{Namespace: "default", Name: "nginx"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+			Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "foo-http", Hostname: &hostname, Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				{
+					Name: "foo-https", Hostname: &hostname, Port: 443, Protocol: gatewayv1.HTTPSProtocolType,
+					TLS: &gatewayv1.GatewayTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{{Name: "foo-cert"}, {Name: "existing-cert"}}},
+				},
+			}},
+		},
+	}
+	existingSecrets := sets.New(types.NamespacedName{Namespace: "default", Name: "existing-cert"})
+
+	testCases := []struct {
+		name         string
+		mode         TLSPlaceholderMode
+		expectedKind string
+	}{{
+		name: "disabled",
+		mode: TLSPlaceholderNone,
+	}, {
+		name:         "self-signed secret",
+		mode:         TLSPlaceholderSelfSigned,
+		expectedKind: "Secret",
+	}, {
+		name:         "cert-manager certificate",
+		mode:         TLSPlaceholderCertManager,
+		expectedKind: "Certificate",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			placeholders, err := generateTLSPlaceholders(gateways, existingSecrets, tc.mode, "test")
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if tc.expectedKind == "" {
+				if len(placeholders) != 0 {
+					t.Errorf("Expected no placeholders, got %d", len(placeholders))
+				}
+				return
+			}
+			if len(placeholders) != 1 {
+				t.Fatalf("Expected 1 placeholder, got %d", len(placeholders))
+			}
+			placeholder := placeholders[0]
+			if placeholder.GetKind() != tc.expectedKind {
+				t.Errorf("Expected placeholder kind %s, got %s", tc.expectedKind, placeholder.GetKind())
+			}
+			if placeholder.GetNamespace() != "default" || placeholder.GetName() != "foo-cert" {
+				t.Errorf("Expected placeholder default/foo-cert, got %s/%s", placeholder.GetNamespace(), placeholder.GetName())
+			}
+			if placeholder.GetLabels()[TLSPlaceholderLabelKey] != string(tc.mode) {
+				t.Errorf("Expected placeholder to be labeled with %s=%s, got %v", TLSPlaceholderLabelKey, tc.mode, placeholder.GetLabels())
+			}
+			if tc.mode == TLSPlaceholderSelfSigned {
+				assertSelfSignedCertificate(t, placeholder, string(hostname))
+			}
+		})
+	}
+}
+
+func assertSelfSignedCertificate(t *testing.T, secret unstructured.Unstructured, hostname string) {
+	t.Helper()
+	encoded, _, _ := unstructured.NestedString(secret.Object, "data", "tls.crt")
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("Expected base64 encoded certificate but got %v", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("Expected PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("Expected valid certificate but got %v", err)
+	}
+	if err = cert.VerifyHostname(hostname); err != nil {
+		t.Errorf("Expected certificate to be valid for %s: %v", hostname, err)
+	}
+}
+
+func Test_readSecretKeysFromFile(t *testing.T) {
+	content := `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: cert-a
+  namespace: default
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Secret
+  metadata:
+    name: cert-b
+    namespace: other
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: svc
+    namespace: default
+`
+	filename := filepath.Join(t.TempDir(), "input.yaml")
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write input file: %v", err)
+	}
+
+	keys, err := readSecretKeysFromFile(filename)
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	expected := sets.New(
+		types.NamespacedName{Namespace: "default", Name: "cert-a"},
+		types.NamespacedName{Namespace: "other", Name: "cert-b"},
+	)
+	if !keys.Equal(expected) {
+		t.Errorf("Expected secrets %v, got %v", expected.UnsortedList(), keys.UnsortedList())
+	}
+}